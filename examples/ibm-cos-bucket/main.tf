@@ -297,4 +297,58 @@ resource "ibm_cos_bucket" "cos_bucket_sat" {
     days    = 20
     prefix  = "logs/"
   }
-}
\ No newline at end of file
+}
+//Static website
+resource "ibm_cos_bucket" "cos_bucket_website" {
+  bucket_name          = var.website_bucket_name
+  resource_instance_id = ibm_resource_instance.cos_instance.id
+  region_location      = var.regional_loc
+  storage_class        = var.storage
+}
+
+data "ibm_iam_access_group" "public_access_group" {
+  access_group_name = "Public Access"
+}
+
+resource "ibm_iam_access_group_policy" "website_policy" {
+  access_group_id = data.ibm_iam_access_group.public_access_group.groups[0].id
+  roles           = ["Content Reader"]
+
+  resources {
+    service              = "cloud-object-storage"
+    resource_type        = "bucket"
+    resource_instance_id = ibm_resource_instance.cos_instance.guid
+    resource             = ibm_cos_bucket.cos_bucket_website.bucket_name
+  }
+}
+
+resource "ibm_cos_bucket_website_configuration" "website" {
+  bucket_crn      = ibm_cos_bucket.cos_bucket_website.crn
+  bucket_location = ibm_cos_bucket.cos_bucket_website.region_location
+
+  website_configuration {
+    index_document {
+      suffix = "index.html"
+    }
+    error_document {
+      key = "error.html"
+    }
+  }
+}
+
+resource "ibm_cos_bucket_cors_configuration" "website_cors" {
+  bucket_crn      = ibm_cos_bucket.cos_bucket_website.crn
+  bucket_location = ibm_cos_bucket.cos_bucket_website.region_location
+
+  cors_rule {
+    allowed_methods = ["GET", "HEAD"]
+    allowed_origins = ["*"]
+  }
+}
+
+resource "ibm_cos_bucket_object" "index" {
+  bucket_crn      = ibm_cos_bucket.cos_bucket_website.crn
+  bucket_location = ibm_cos_bucket.cos_bucket_website.region_location
+  content         = "<html><body>Hello World</body></html>"
+  key             = "index.html"
+}
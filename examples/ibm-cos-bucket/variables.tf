@@ -112,4 +112,8 @@ variable "delmarkerrep_status" {
 
 variable "dest_rep_bkt_crn" {
   default = ""
-}
\ No newline at end of file
+}
+
+variable "website_bucket_name" {
+  default = "a-static-website-bucket"
+}
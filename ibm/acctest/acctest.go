@@ -84,6 +84,11 @@ var HpcsInstanceID string
 var SecretsManagerInstanceID string
 var SecretsManagerSecretType string
 var SecretsManagerSecretID string
+var SecretsManagerPrivateCertificateTemplate string
+var SecretsManagerPublicCertificateLetsEncryptPrivateKey string
+var SecretsManagerPublicCertificateCisCrn string
+var SecretsManagerPublicCertificateClassicUsername string
+var SecretsManagerPublicCertificateClassicPassword string
 var HpcsAdmin1 string
 var HpcsToken1 string
 var HpcsAdmin2 string
@@ -121,6 +126,11 @@ var PiSAPProfileID string
 var Pi_placement_group_name string
 var PiStoragePool string
 var PiStorageType string
+var Pi_shared_processor_pool_id string
+var Pi_spp_placement_group_id string
+var Pi_auxiliary_volume_name string
+var Pi_replication_source_crn string
+var Pi_snapshot_id string
 
 var Pi_capture_storage_image_path string
 var Pi_capture_cloud_storage_access_key string
@@ -670,6 +680,31 @@ func init() {
 		PiStorageType = "terraform-test-power"
 		fmt.Println("[INFO] Set the environment variable PI_STORAGE_TYPE for testing ibm_pi_storage_type_capacity else it is set to default value 'terraform-test-power'")
 	}
+	Pi_shared_processor_pool_id = os.Getenv("PI_SHARED_PROCESSOR_POOL_ID")
+	if Pi_shared_processor_pool_id == "" {
+		Pi_shared_processor_pool_id = "tf-pi-shared-processor-pool"
+		fmt.Println("[INFO] Set the environment variable PI_SHARED_PROCESSOR_POOL_ID for testing ibm_pi_shared_processor_pool data source else it is set to default value 'tf-pi-shared-processor-pool'")
+	}
+	Pi_spp_placement_group_id = os.Getenv("PI_SPP_PLACEMENT_GROUP_ID")
+	if Pi_spp_placement_group_id == "" {
+		Pi_spp_placement_group_id = "tf-pi-spp-placement-group"
+		fmt.Println("[INFO] Set the environment variable PI_SPP_PLACEMENT_GROUP_ID for testing ibm_pi_spp_placement_group data source else it is set to default value 'tf-pi-spp-placement-group'")
+	}
+	Pi_auxiliary_volume_name = os.Getenv("PI_AUXILIARY_VOLUME_NAME")
+	if Pi_auxiliary_volume_name == "" {
+		Pi_auxiliary_volume_name = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_AUXILIARY_VOLUME_NAME for testing ibm_pi_volume_onboarding resource else it is set to default value 'terraform-test-power'")
+	}
+	Pi_replication_source_crn = os.Getenv("PI_REPLICATION_SOURCE_CRN")
+	if Pi_replication_source_crn == "" {
+		Pi_replication_source_crn = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_REPLICATION_SOURCE_CRN for testing ibm_pi_volume_onboarding resource else it is set to default value 'terraform-test-power'")
+	}
+	Pi_snapshot_id = os.Getenv("PI_SNAPSHOT_ID")
+	if Pi_snapshot_id == "" {
+		Pi_snapshot_id = "terraform-test-power"
+		fmt.Println("[INFO] Set the environment variable PI_SNAPSHOT_ID for testing ibm_pi_snapshot_restore resource else it is set to default value 'terraform-test-power'")
+	}
 	// Added for resource capture instance testing
 	Pi_capture_storage_image_path = os.Getenv("PI_CAPTURE_STORAGE_IMAGE_PATH")
 	if Pi_capture_storage_image_path == "" {
@@ -782,6 +817,31 @@ func init() {
 		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_SECRET_ID for testing data_source_ibm_secrets_manager_secret_test else tests will fail if this is not set correctly")
 	}
 
+	SecretsManagerPrivateCertificateTemplate = os.Getenv("SECRETS_MANAGER_PRIVATE_CERTIFICATE_TEMPLATE")
+	if SecretsManagerPrivateCertificateTemplate == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_PRIVATE_CERTIFICATE_TEMPLATE for testing ibm_sm_private_certificate resource else tests will fail if this is not set correctly")
+	}
+
+	SecretsManagerPublicCertificateLetsEncryptPrivateKey = os.Getenv("SECRETS_MANAGER_PUBLIC_CERTIFICATE_LETS_ENCRYPT_PRIVATE_KEY")
+	if SecretsManagerPublicCertificateLetsEncryptPrivateKey == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_PUBLIC_CERTIFICATE_LETS_ENCRYPT_PRIVATE_KEY for testing ibm_sm_public_certificate_configuration_ca_lets_encrypt resource else tests will fail if this is not set correctly")
+	}
+
+	SecretsManagerPublicCertificateCisCrn = os.Getenv("SECRETS_MANAGER_PUBLIC_CERTIFICATE_CIS_CRN")
+	if SecretsManagerPublicCertificateCisCrn == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_PUBLIC_CERTIFICATE_CIS_CRN for testing ibm_sm_public_certificate_configuration_dns_cis resource else tests will fail if this is not set correctly")
+	}
+
+	SecretsManagerPublicCertificateClassicUsername = os.Getenv("SECRETS_MANAGER_PUBLIC_CERTIFICATE_CLASSIC_USERNAME")
+	if SecretsManagerPublicCertificateClassicUsername == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_PUBLIC_CERTIFICATE_CLASSIC_USERNAME for testing ibm_sm_public_certificate_configuration_dns_classic_infrastructure resource else tests will fail if this is not set correctly")
+	}
+
+	SecretsManagerPublicCertificateClassicPassword = os.Getenv("SECRETS_MANAGER_PUBLIC_CERTIFICATE_CLASSIC_PASSWORD")
+	if SecretsManagerPublicCertificateClassicPassword == "" {
+		fmt.Println("[WARN] Set the environment variable SECRETS_MANAGER_PUBLIC_CERTIFICATE_CLASSIC_PASSWORD for testing ibm_sm_public_certificate_configuration_dns_classic_infrastructure resource else tests will fail if this is not set correctly")
+	}
+
 	Tg_cross_network_account_id = os.Getenv("IBM_TG_CROSS_ACCOUNT_ID")
 	if Tg_cross_network_account_id == "" {
 		fmt.Println("[INFO] Set the environment variable IBM_TG_CROSS_ACCOUNT_ID for testing ibm_tg_connection resource else  tests will fail if this is not set correctly")
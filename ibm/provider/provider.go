@@ -217,6 +217,7 @@ func Provider() *schema.Provider {
 		DataSourcesMap: map[string]*schema.Resource{
 			"ibm_sm_secret_groups":   secretsmanager.DataSourceIbmSmSecretGroups(),
 			"ibm_sm_secrets":         secretsmanager.DataSourceIbmSmSecrets(),
+			"ibm_sm_secret_versions": secretsmanager.DataSourceIbmSmSecretVersions(),
 			"ibm_api_gateway":        apigateway.DataSourceIBMApiGateway(),
 			"ibm_account":            cloudfoundry.DataSourceIBMAccount(),
 			"ibm_app":                cloudfoundry.DataSourceIBMApp(),
@@ -297,6 +298,7 @@ func Provider() *schema.Provider {
 			"ibm_database_tasks":                    database.DataSourceIBMDatabaseTasks(),
 			"ibm_database_backup":                   database.DataSourceIBMDatabaseBackup(),
 			"ibm_database_backups":                  database.DataSourceIBMDatabaseBackups(),
+			"ibm_database_restore_check":            database.DataSourceIBMDatabaseRestoreCheck(),
 			"ibm_compute_bare_metal":                classicinfrastructure.DataSourceIBMComputeBareMetal(),
 			"ibm_compute_image_template":            classicinfrastructure.DataSourceIBMComputeImageTemplate(),
 			"ibm_compute_placement_group":           classicinfrastructure.DataSourceIBMComputePlacementGroup(),
@@ -333,6 +335,7 @@ func Provider() *schema.Provider {
 			"ibm_dns_secondary":                     classicinfrastructure.DataSourceIBMDNSSecondary(),
 			"ibm_event_streams_topic":               eventstreams.DataSourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":              eventstreams.DataSourceIBMEventStreamsSchema(),
+			"ibm_event_streams_consumer_groups":     eventstreams.DataSourceIBMEventStreamsConsumerGroups(),
 			"ibm_hpcs":                              hpcs.DataSourceIBMHPCS(),
 			"ibm_hpcs_managed_key":                  hpcs.DataSourceIbmManagedKey(),
 			"ibm_hpcs_key_template":                 hpcs.DataSourceIbmKeyTemplate(),
@@ -358,6 +361,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profile_links":         iamidentity.DataSourceIBMIamTrustedProfileLinks(),
 			"ibm_iam_trusted_profiles":              iamidentity.DataSourceIBMIamTrustedProfiles(),
 			"ibm_iam_trusted_profile_policy":        iampolicy.DataSourceIBMIAMTrustedProfilePolicy(),
+			"ibm_iam_effective_access":              iampolicy.DataSourceIBMIAMEffectiveAccess(),
 
 			//backup as Service
 			"ibm_is_backup_policy":       vpc.DataSourceIBMIsBackupPolicy(),
@@ -500,11 +504,19 @@ func Provider() *schema.Provider {
 			"ibm_kms_key_policies":                   kms.DataSourceIBMKMSkeyPolicies(),
 			"ibm_kms_keys":                           kms.DataSourceIBMKMSkeys(),
 			"ibm_kms_key":                            kms.DataSourceIBMKMSkey(),
+			"ibm_kms_key_registrations":              kms.DataSourceIBMKMSKeyRegistrations(),
+			"ibm_kms_key_versions":                   kms.DataSourceIBMKMSKeyVersions(),
 			"ibm_pn_application_chrome":              pushnotification.DataSourceIBMPNApplicationChrome(),
 			"ibm_app_config_environment":             appconfiguration.DataSourceIBMAppConfigEnvironment(),
 			"ibm_app_config_environments":            appconfiguration.DataSourceIBMAppConfigEnvironments(),
 			"ibm_app_config_feature":                 appconfiguration.DataSourceIBMAppConfigFeature(),
 			"ibm_app_config_features":                appconfiguration.DataSourceIBMAppConfigFeatures(),
+			"ibm_app_config_collection":              appconfiguration.DataSourceIBMAppConfigCollection(),
+			"ibm_app_config_collections":             appconfiguration.DataSourceIBMAppConfigCollections(),
+			"ibm_app_config_property":                appconfiguration.DataSourceIBMAppConfigProperty(),
+			"ibm_app_config_properties":              appconfiguration.DataSourceIBMAppConfigProperties(),
+			"ibm_app_config_segment":                 appconfiguration.DataSourceIBMAppConfigSegment(),
+			"ibm_app_config_segments":                appconfiguration.DataSourceIBMAppConfigSegments(),
 
 			"ibm_resource_quota": resourcecontroller.DataSourceIBMResourceQuota(),
 			//"ibm_resource_group":    resourcemanager.DataSourceIBMResourceGroup(),
@@ -551,6 +563,10 @@ func Provider() *schema.Provider {
 			"ibm_pi_pvm_snapshots":          power.DataSourceIBMPISnapshot(),
 			"ibm_pi_sap_profile":            power.DataSourceIBMPISAPProfile(),
 			"ibm_pi_sap_profiles":           power.DataSourceIBMPISAPProfiles(),
+			"ibm_pi_shared_processor_pool":  power.DataSourceIBMPISharedProcessorPool(),
+			"ibm_pi_shared_processor_pools": power.DataSourceIBMPISharedProcessorPools(),
+			"ibm_pi_spp_placement_group":    power.DataSourceIBMPISPPPlacementGroup(),
+			"ibm_pi_spp_placement_groups":   power.DataSourceIBMPISPPPlacementGroups(),
 			"ibm_pi_storage_pool_capacity":  power.DataSourceIBMPIStoragePoolCapacity(),
 			"ibm_pi_storage_pools_capacity": power.DataSourceIBMPIStoragePoolsCapacity(),
 			"ibm_pi_storage_type_capacity":  power.DataSourceIBMPIStorageTypeCapacity(),
@@ -602,6 +618,7 @@ func Provider() *schema.Provider {
 
 			// //Added for Satellite
 			"ibm_satellite_location":                            satellite.DataSourceIBMSatelliteLocation(),
+			"ibm_satellite_location_capacity":                   satellite.DataSourceIBMSatelliteLocationCapacity(),
 			"ibm_satellite_location_nlb_dns":                    satellite.DataSourceIBMSatelliteLocationNLBDNS(),
 			"ibm_satellite_attach_host_script":                  satellite.DataSourceIBMSatelliteAttachHostScript(),
 			"ibm_satellite_cluster":                             satellite.DataSourceIBMSatelliteCluster(),
@@ -711,8 +728,21 @@ func Provider() *schema.Provider {
 		},
 
 		ResourcesMap: map[string]*schema.Resource{
+			"ibm_sm_private_certificate_configuration_root_ca":                   secretsmanager.ResourceIbmSmPrivateCertificateConfigurationRootCA(),
+			"ibm_sm_private_certificate_configuration_intermediate_ca":           secretsmanager.ResourceIbmSmPrivateCertificateConfigurationIntermediateCA(),
+			"ibm_sm_private_certificate_configuration_template":                  secretsmanager.ResourceIbmSmPrivateCertificateConfigurationTemplate(),
+			"ibm_sm_public_certificate_configuration_ca_lets_encrypt":            secretsmanager.ResourceIbmSmPublicCertificateConfigurationCALetsEncrypt(),
+			"ibm_sm_public_certificate_configuration_dns_cis":                    secretsmanager.ResourceIbmSmPublicCertificateConfigurationDNSCIS(),
+			"ibm_sm_public_certificate_configuration_dns_classic_infrastructure": secretsmanager.ResourceIbmSmPublicCertificateConfigurationDNSClassicInfrastructure(),
+
 			"ibm_sm_secret_group":                   secretsmanager.ResourceIbmSmSecretGroup(),
 			"ibm_sm_secret":                         secretsmanager.ResourceIbmSmSecret(),
+			"ibm_sm_arbitrary_secret":               secretsmanager.ResourceIbmSmArbitrarySecret(),
+			"ibm_sm_username_password_secret":       secretsmanager.ResourceIbmSmUsernamePasswordSecret(),
+			"ibm_sm_kv_secret":                      secretsmanager.ResourceIbmSmKvSecret(),
+			"ibm_sm_iam_credentials_secret":         secretsmanager.ResourceIbmSmIAMCredentialsSecret(),
+			"ibm_sm_private_certificate":            secretsmanager.ResourceIbmSmPrivateCertificate(),
+			"ibm_sm_secret_rotation":                secretsmanager.ResourceIbmSmSecretRotation(),
 			"ibm_api_gateway_endpoint":              apigateway.ResourceIBMApiGatewayEndPoint(),
 			"ibm_api_gateway_endpoint_subscription": apigateway.ResourceIBMApiGatewayEndpointSubscription(),
 			"ibm_app":                               cloudfoundry.ResourceIBMApp(),
@@ -752,6 +782,10 @@ func Provider() *schema.Provider {
 			"ibm_function_namespace":                    functions.ResourceIBMFunctionNamespace(),
 			"ibm_cis":                                   cis.ResourceIBMCISInstance(),
 			"ibm_database":                              database.ResourceIBMDatabaseInstance(),
+			"ibm_database_backup":                       database.ResourceIBMDatabaseBackup(),
+			"ibm_database_configuration":                database.ResourceIBMDatabaseConfiguration(),
+			"ibm_database_replica_promotion":            database.ResourceIBMDatabaseReplicaPromotion(),
+			"ibm_database_user":                         database.ResourceIBMDatabaseUser(),
 			"ibm_certificate_manager_import":            certificatemanager.ResourceIBMCertificateManagerImport(),
 			"ibm_certificate_manager_order":             certificatemanager.ResourceIBMCertificateManagerOrder(),
 			"ibm_cis_domain":                            cis.ResourceIBMCISDomain(),
@@ -786,6 +820,9 @@ func Provider() *schema.Provider {
 			"ibm_cis_firewall_rule":                     cis.ResourceIBMCISFirewallrules(),
 			"ibm_cloudant":                              cloudant.ResourceIBMCloudant(),
 			"ibm_cloudant_database":                     cloudant.ResourceIBMCloudantDatabase(),
+			"ibm_cloudant_design_document":              cloudant.ResourceIBMCloudantDesignDocument(),
+			"ibm_cloudant_replication":                  cloudant.ResourceIBMCloudantReplication(),
+			"ibm_cloudant_security":                     cloudant.ResourceIBMCloudantSecurity(),
 			"ibm_cloud_shell_account_settings":          cloudshell.ResourceIBMCloudShellAccountSettings(),
 			"ibm_compute_autoscale_group":               classicinfrastructure.ResourceIBMComputeAutoScaleGroup(),
 			"ibm_compute_autoscale_policy":              classicinfrastructure.ResourceIBMComputeAutoScalePolicy(),
@@ -823,13 +860,19 @@ func Provider() *schema.Provider {
 			"ibm_ob_monitoring":                         kubernetes.ResourceIBMObMonitoring(),
 			"ibm_cos_bucket":                            cos.ResourceIBMCOSBucket(),
 			"ibm_cos_bucket_replication_rule":           cos.ResourceIBMCOSBucketReplicationConfiguration(),
+			"ibm_cos_bucket_cors_configuration":         cos.ResourceIBMCOSBucketCorsConfiguration(),
+			"ibm_cos_bucket_public_access_block":        cos.ResourceIBMCOSBucketPublicAccessBlock(),
+			"ibm_cos_bucket_website_configuration":      cos.ResourceIBMCOSBucketWebsiteConfiguration(),
 			"ibm_cos_bucket_object":                     cos.ResourceIBMCOSBucketObject(),
+			"ibm_cos_bucket_objects_sync":               cos.ResourceIBMCOSBucketObjectsSync(),
 			"ibm_dns_domain":                            classicinfrastructure.ResourceIBMDNSDomain(),
 			"ibm_dns_domain_registration_nameservers":   classicinfrastructure.ResourceIBMDNSDomainRegistrationNameservers(),
 			"ibm_dns_secondary":                         classicinfrastructure.ResourceIBMDNSSecondary(),
 			"ibm_dns_record":                            classicinfrastructure.ResourceIBMDNSRecord(),
 			"ibm_event_streams_topic":                   eventstreams.ResourceIBMEventStreamsTopic(),
 			"ibm_event_streams_schema":                  eventstreams.ResourceIBMEventStreamsSchema(),
+			"ibm_event_streams_quota":                   eventstreams.ResourceIBMEventStreamsQuota(),
+			"ibm_event_streams_mirroring_config":        eventstreams.ResourceIBMEventStreamsMirroringConfig(),
 			"ibm_firewall":                              classicinfrastructure.ResourceIBMFirewall(),
 			"ibm_firewall_policy":                       classicinfrastructure.ResourceIBMFirewallPolicy(),
 			"ibm_hpcs":                                  hpcs.ResourceIBMHPCS(),
@@ -856,6 +899,7 @@ func Provider() *schema.Provider {
 			"ibm_iam_trusted_profile_claim_rule":        iamidentity.ResourceIBMIAMTrustedProfileClaimRule(),
 			"ibm_iam_trusted_profile_link":              iamidentity.ResourceIBMIAMTrustedProfileLink(),
 			"ibm_iam_trusted_profile_policy":            iampolicy.ResourceIBMIAMTrustedProfilePolicy(),
+			"ibm_iam_policy_v2":                         iampolicy.ResourceIBMIAMPolicyV2(),
 			"ibm_ipsec_vpn":                             classicinfrastructure.ResourceIBMIPSecVPN(),
 
 			"ibm_is_backup_policy":      vpc.ResourceIBMIsBackupPolicy(),
@@ -944,10 +988,17 @@ func Provider() *schema.Provider {
 			"ibm_pn_application_chrome":                          pushnotification.ResourceIBMPNApplicationChrome(),
 			"ibm_app_config_environment":                         appconfiguration.ResourceIBMAppConfigEnvironment(),
 			"ibm_app_config_feature":                             appconfiguration.ResourceIBMIbmAppConfigFeature(),
+			"ibm_app_config_collection":                          appconfiguration.ResourceIBMAppConfigCollection(),
+			"ibm_app_config_property":                            appconfiguration.ResourceIBMAppConfigProperty(),
+			"ibm_app_config_segment":                             appconfiguration.ResourceIBMAppConfigSegment(),
 			"ibm_kms_key":                                        kms.ResourceIBMKmskey(),
+			"ibm_kms_key_action":                                 kms.ResourceIBMKmsKeyAction(),
 			"ibm_kms_key_alias":                                  kms.ResourceIBMKmskeyAlias(),
 			"ibm_kms_key_rings":                                  kms.ResourceIBMKmskeyRings(),
 			"ibm_kms_key_policies":                               kms.ResourceIBMKmskeyPolicies(),
+			"ibm_kms_instance_policies":                          kms.ResourceIBMKmsInstancePolicies(),
+			"ibm_kms_kmip_adapter":                               kms.ResourceIBMKmsKMIPAdapter(),
+			"ibm_kms_kmip_client_cert":                           kms.ResourceIBMKmsKMIPClientCertificate(),
 			"ibm_kp_key":                                         kms.ResourceIBMkey(),
 			//"ibm_resource_group":                                 resourcemanager.ResourceIBMResourceGroup(),
 			"ibm_resource_instance":        resourcecontroller.ResourceIBMResourceInstance(),
@@ -979,6 +1030,7 @@ func Provider() *schema.Provider {
 			"ibm_pi_image_export":                    power.ResourceIBMPIImageExport(),
 			"ibm_pi_network_port":                    power.ResourceIBMPINetworkPort(),
 			"ibm_pi_snapshot":                        power.ResourceIBMPISnapshot(),
+			"ibm_pi_snapshot_restore":                power.ResourceIBMPISnapshotRestore(),
 			"ibm_pi_network_port_attach":             power.ResourceIBMPINetworkPortAttach(),
 			"ibm_pi_dhcp":                            power.ResourceIBMPIDhcp(),
 			"ibm_pi_cloud_connection":                power.ResourceIBMPICloudConnection(),
@@ -988,6 +1040,12 @@ func Provider() *schema.Provider {
 			"ibm_pi_vpn_connection":                  power.ResourceIBMPIVPNConnection(),
 			"ibm_pi_console_language":                power.ResourceIBMPIInstanceConsoleLanguage(),
 			"ibm_pi_placement_group":                 power.ResourceIBMPIPlacementGroup(),
+			"ibm_pi_shared_processor_pool":           power.ResourceIBMPISharedProcessorPool(),
+			"ibm_pi_spp_placement_group":             power.ResourceIBMPISPPPlacementGroup(),
+			"ibm_pi_volume_group":                    power.ResourceIBMPIVolumeGroup(),
+			"ibm_pi_volume_group_action":             power.ResourceIBMPIVolumeGroupAction(),
+			"ibm_pi_volume_onboarding":               power.ResourceIBMPIVolumeOnboarding(),
+			"ibm_pi_volume_clone":                    power.ResourceIBMPIVolumeClone(),
 
 			// //Private DNS related resources
 			"ibm_dns_zone":              dnsservices.ResourceIBMPrivateDNSZone(),
@@ -1166,6 +1224,8 @@ func Validator() validate.ValidatorDict {
 				"ibm_cr_namespace":                registry.ResourceIBMCrNamespaceValidator(),
 				"ibm_tg_gateway":                  transitgateway.ResourceIBMTGValidator(),
 				"ibm_app_config_feature":          appconfiguration.ResourceIBMAppConfigFeatureValidator(),
+				"ibm_app_config_property":         appconfiguration.ResourceIBMAppConfigPropertyValidator(),
+				"ibm_app_config_segment":          appconfiguration.ResourceIBMAppConfigSegmentValidator(),
 				"ibm_tg_connection":               transitgateway.ResourceIBMTransitGatewayConnectionValidator(),
 				"ibm_tg_connection_prefix_filter": transitgateway.ResourceIBMTransitGatewayConnectionPrefixFilterValidator(),
 				"ibm_dl_virtual_connection":       directlink.ResourceIBMDLGatewayVCValidator(),
@@ -1238,6 +1298,7 @@ func Validator() validate.ValidatorDict {
 				"ibm_is_vpn_server":                       vpc.ResourceIBMIsVPNServerValidator(),
 				"ibm_is_vpn_server_route":                 vpc.ResourceIBMIsVPNServerRouteValidator(),
 				"ibm_kms_key_rings":                       kms.ResourceIBMKeyRingValidator(),
+				"ibm_kms_kmip_adapter":                    kms.ResourceIBMKmsKMIPAdapterValidator(),
 				"ibm_dns_glb_monitor":                     dnsservices.ResourceIBMPrivateDNSGLBMonitorValidator(),
 				"ibm_dns_custom_resolver_forwarding_rule": dnsservices.ResourceIBMPrivateDNSForwardingRuleValidator(),
 				"ibm_schematics_action":                   schematics.ResourceIBMSchematicsActionValidator(),
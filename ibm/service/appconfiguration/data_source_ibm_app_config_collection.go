@@ -0,0 +1,199 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+func DataSourceIBMAppConfigCollection() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIbmAppConfigCollectionRead,
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"collection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Collection Id.",
+			},
+			"expand": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set to `true`, returns expanded view of the resource details.",
+			},
+			"includes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Include the associated features or properties in the response.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Collection name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Collection description.",
+			},
+			"tags": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Tags associated with the collection.",
+			},
+			"features_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of features associated with the collection.",
+			},
+			"properties_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of properties associated with the collection.",
+			},
+			"created_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the collection.",
+			},
+			"updated_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modified time of the collection data.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Collection URL.",
+			},
+			"features": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of features associated with the collection.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"feature_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Feature id.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Feature name.",
+						},
+					},
+				},
+			},
+			"properties": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of properties associated with the collection.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Property id.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Property name.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmAppConfigCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.GetCollectionOptions{}
+	options.SetCollectionID(d.Get("collection_id").(string))
+	if _, ok := d.GetOk("expand"); ok {
+		options.SetExpand(d.Get("expand").(bool))
+	}
+	if _, ok := d.GetOk("includes"); ok {
+		options.SetInclude(flex.ExpandStringList(d.Get("includes").([]interface{})))
+	}
+
+	result, response, err := appconfigClient.GetCollection(options)
+	if err != nil {
+		log.Printf("[DEBUG] GetCollection failed %s\n%s", err, response)
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", guid, *result.CollectionID))
+	if result.Name != nil {
+		if err = d.Set("name", result.Name); err != nil {
+			return fmt.Errorf("[ERROR] Error setting name: %s", err)
+		}
+	}
+	if result.Description != nil {
+		if err = d.Set("description", result.Description); err != nil {
+			return fmt.Errorf("[ERROR] Error setting description: %s", err)
+		}
+	}
+	if result.Tags != nil {
+		if err = d.Set("tags", result.Tags); err != nil {
+			return fmt.Errorf("[ERROR] Error setting tags: %s", err)
+		}
+	}
+	if result.FeaturesCount != nil {
+		if err = d.Set("features_count", result.FeaturesCount); err != nil {
+			return fmt.Errorf("[ERROR] Error setting features_count: %s", err)
+		}
+	}
+	if result.PropertiesCount != nil {
+		if err = d.Set("properties_count", result.PropertiesCount); err != nil {
+			return fmt.Errorf("[ERROR] Error setting properties_count: %s", err)
+		}
+	}
+	if result.CreatedTime != nil {
+		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting created_time: %s", err)
+		}
+	}
+	if result.UpdatedTime != nil {
+		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting updated_time: %s", err)
+		}
+	}
+	if result.Href != nil {
+		if err = d.Set("href", result.Href); err != nil {
+			return fmt.Errorf("[ERROR] Error setting href: %s", err)
+		}
+	}
+	if result.Features != nil {
+		if err = d.Set("features", dataSourceAppConfigFlattenFeatureOutputs(result.Features)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting features: %s", err)
+		}
+	}
+	if result.Properties != nil {
+		if err = d.Set("properties", dataSourceAppConfigFlattenPropertyOutputs(result.Properties)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting properties: %s", err)
+		}
+	}
+	return nil
+}
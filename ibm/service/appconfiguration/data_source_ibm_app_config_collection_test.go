@@ -0,0 +1,61 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmAppConfigCollectionDataSource(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	collectionID := fmt.Sprintf("tf_collection_id_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigCollectionDataSourceConfigBasic(instanceName, name, collectionID, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_collection.app_config_collection_data1", "id"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_collection.app_config_collection_data1", "name", name),
+					resource.TestCheckResourceAttr("data.ibm_app_config_collection.app_config_collection_data1", "description", description),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_collection.app_config_collection_data1", "features_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_collection.app_config_collection_data1", "properties_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_collection.app_config_collection_data1", "created_time"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_collection.app_config_collection_data1", "href"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigCollectionDataSourceConfigBasic(instanceName, name, collectionID, description string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test485" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "lite"
+		}
+		resource "ibm_app_config_collection" "app_config_collection_resource3" {
+			guid          = ibm_resource_instance.app_config_terraform_test485.guid
+			name          = "%s"
+			collection_id = "%s"
+			description   = "%s"
+		}
+		data "ibm_app_config_collection" "app_config_collection_data1" {
+			guid          = ibm_app_config_collection.app_config_collection_resource3.guid
+			collection_id = ibm_app_config_collection.app_config_collection_resource3.collection_id
+			expand        = true
+		}`, instanceName, name, collectionID, description)
+}
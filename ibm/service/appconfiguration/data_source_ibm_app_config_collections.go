@@ -0,0 +1,342 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+func DataSourceIBMAppConfigCollections() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIbmAppConfigCollectionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"sort": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Sort the collection details based on the specified attribute.",
+			},
+			"tags": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Filter the resources to be returned based on the associated tags. Specify the parameter as a list of comma separated tags. Returns resources associated with any of the specified tags.",
+			},
+			"features": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Filter collections by a list of comma separated features.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"properties": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Filter collections by a list of comma separated properties.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"expand": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "If set to `true`, returns expanded view of the resource details.",
+			},
+			"includes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Include feature and property details in the response.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"limit": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of records to retrieve. By default, the list operation return the first 10 records. To retrieve different set of records, use `limit` with `offset` to page through the available records.",
+			},
+			"offset": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The number of records to skip. By specifying `offset`, you retrieve a subset of items that starts with the `offset` value. Use `offset` with `limit` to page through the available records.",
+			},
+			"collections": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Array of collections.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Collection name.",
+						},
+						"collection_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Collection id.",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Collection description.",
+						},
+						"tags": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Tags associated with the collection.",
+						},
+						"features_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of features associated with the collection.",
+						},
+						"properties_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Number of properties associated with the collection.",
+						},
+						"created_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Creation time of the collection.",
+						},
+						"updated_time": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Last modified time of the collection data.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Collection URL.",
+						},
+					},
+				},
+			},
+			"total_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Total number of records.",
+			},
+			"next": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "URL to navigate to the next list of records.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL of the response.",
+						},
+					},
+				},
+			},
+			"first": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "URL to navigate to the first page of records.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL of the response.",
+						},
+					},
+				},
+			},
+			"previous": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "URL to navigate to the previous list of records.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL of the response.",
+						},
+					},
+				},
+			},
+			"last": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "URL to navigate to the last page of records.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"href": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "URL of the response.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmAppConfigCollectionsRead(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.ListCollectionsOptions{}
+	if _, ok := d.GetOk("expand"); ok {
+		options.SetExpand(d.Get("expand").(bool))
+	}
+	if _, ok := d.GetOk("sort"); ok {
+		options.SetSort(d.Get("sort").(string))
+	}
+	if _, ok := d.GetOk("tags"); ok {
+		options.SetTags(d.Get("tags").(string))
+	}
+	if _, ok := d.GetOk("features"); ok {
+		options.SetFeatures(flex.ExpandStringList(d.Get("features").([]interface{})))
+	}
+	if _, ok := d.GetOk("properties"); ok {
+		options.SetProperties(flex.ExpandStringList(d.Get("properties").([]interface{})))
+	}
+	if _, ok := d.GetOk("includes"); ok {
+		options.SetInclude(flex.ExpandStringList(d.Get("includes").([]interface{})))
+	}
+
+	var collectionList *appconfigurationv1.CollectionList
+	var offset int64
+	var limit int64 = 10
+	var isLimit bool
+	finalList := []appconfigurationv1.Collection{}
+
+	if _, ok := d.GetOk("limit"); ok {
+		isLimit = true
+		limit = int64(d.Get("limit").(int))
+	}
+	options.SetLimit(limit)
+	if _, ok := d.GetOk("offset"); ok {
+		offset = int64(d.Get("offset").(int))
+	}
+	for {
+		options.SetOffset(offset)
+		result, response, err := appconfigClient.ListCollections(options)
+		collectionList = result
+		if err != nil {
+			log.Printf("[DEBUG] ListCollections failed %s\n%s", err, response)
+			return err
+		}
+		if isLimit {
+			offset = 0
+		} else {
+			offset = dataSourceEnvironmentListGetNext(result.Next)
+		}
+		finalList = append(finalList, result.Collections...)
+		if offset == 0 {
+			break
+		}
+	}
+
+	collectionList.Collections = finalList
+
+	d.SetId(guid)
+
+	if collectionList.Collections != nil {
+		err = d.Set("collections", dataSourceCollectionListFlattenCollections(collectionList.Collections))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error setting collections %s", err)
+		}
+	}
+	if collectionList.TotalCount != nil {
+		if err = d.Set("total_count", collectionList.TotalCount); err != nil {
+			return fmt.Errorf("[ERROR] Error setting total_count: %s", err)
+		}
+	}
+	if collectionList.Limit != nil {
+		if err = d.Set("limit", collectionList.Limit); err != nil {
+			return fmt.Errorf("[ERROR] Error setting limit: %s", err)
+		}
+	}
+	if collectionList.Offset != nil {
+		if err = d.Set("offset", collectionList.Offset); err != nil {
+			return fmt.Errorf("[ERROR] Error setting offset: %s", err)
+		}
+	}
+	if collectionList.First != nil {
+		err = d.Set("first", dataSourceEnvironmentListFlattenPagination(*collectionList.First))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error setting first %s", err)
+		}
+	}
+	if collectionList.Previous != nil {
+		err = d.Set("previous", dataSourceEnvironmentListFlattenPagination(*collectionList.Previous))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error setting previous %s", err)
+		}
+	}
+	if collectionList.Last != nil {
+		err = d.Set("last", dataSourceEnvironmentListFlattenPagination(*collectionList.Last))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error setting last %s", err)
+		}
+	}
+	if collectionList.Next != nil {
+		err = d.Set("next", dataSourceEnvironmentListFlattenPagination(*collectionList.Next))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error setting next %s", err)
+		}
+	}
+
+	return nil
+}
+
+func dataSourceCollectionListFlattenCollections(result []appconfigurationv1.Collection) (collections []map[string]interface{}) {
+	for _, collectionsItem := range result {
+		collections = append(collections, dataSourceCollectionListCollectionsToMap(collectionsItem))
+	}
+
+	return collections
+}
+
+func dataSourceCollectionListCollectionsToMap(collectionsItem appconfigurationv1.Collection) (collectionsMap map[string]interface{}) {
+	collectionsMap = map[string]interface{}{}
+
+	if collectionsItem.Name != nil {
+		collectionsMap["name"] = collectionsItem.Name
+	}
+	if collectionsItem.CollectionID != nil {
+		collectionsMap["collection_id"] = collectionsItem.CollectionID
+	}
+	if collectionsItem.Description != nil {
+		collectionsMap["description"] = collectionsItem.Description
+	}
+	if collectionsItem.Tags != nil {
+		collectionsMap["tags"] = collectionsItem.Tags
+	}
+	if collectionsItem.FeaturesCount != nil {
+		collectionsMap["features_count"] = collectionsItem.FeaturesCount
+	}
+	if collectionsItem.PropertiesCount != nil {
+		collectionsMap["properties_count"] = collectionsItem.PropertiesCount
+	}
+	if collectionsItem.CreatedTime != nil {
+		collectionsMap["created_time"] = collectionsItem.CreatedTime.String()
+	}
+	if collectionsItem.UpdatedTime != nil {
+		collectionsMap["updated_time"] = collectionsItem.UpdatedTime.String()
+	}
+	if collectionsItem.Href != nil {
+		collectionsMap["href"] = collectionsItem.Href
+	}
+
+	return collectionsMap
+}
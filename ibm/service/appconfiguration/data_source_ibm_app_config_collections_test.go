@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmAppConfigCollectionsDataSource(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	collectionID := fmt.Sprintf("tf_collection_id_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigCollectionsDataSourceConfigBasic(instanceName, name, collectionID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_collections.app_config_collections_data1", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_collections.app_config_collections_data1", "total_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_collections.app_config_collections_data1", "first.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_collections.app_config_collections_data1", "collections.#"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_collections.app_config_collections_data1", "collections.0.collection_id", collectionID),
+					resource.TestCheckResourceAttr("data.ibm_app_config_collections.app_config_collections_data1", "collections.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigCollectionsDataSourceConfigBasic(instanceName, name, collectionID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test486" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "lite"
+		}
+		resource "ibm_app_config_collection" "app_config_collection_resource4" {
+			guid          = ibm_resource_instance.app_config_terraform_test486.guid
+			name          = "%s"
+			collection_id = "%s"
+			tags          = "terraform"
+		}
+		data "ibm_app_config_collections" "app_config_collections_data1" {
+			guid   = ibm_app_config_collection.app_config_collection_resource4.guid
+			tags   = ibm_app_config_collection.app_config_collection_resource4.tags
+			expand = true
+		}`, instanceName, name, collectionID)
+}
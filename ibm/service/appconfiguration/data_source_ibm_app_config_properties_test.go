@@ -0,0 +1,64 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmAppConfigPropertiesDataSource(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	propertyID := fmt.Sprintf("tf_property_id_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigPropertiesDataSourceConfigBasic(instanceName, name, propertyID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_properties.app_config_properties_data1", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_properties.app_config_properties_data1", "total_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_properties.app_config_properties_data1", "first.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_properties.app_config_properties_data1", "properties.#"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_properties.app_config_properties_data1", "properties.0.property_id", propertyID),
+					resource.TestCheckResourceAttr("data.ibm_app_config_properties.app_config_properties_data1", "properties.0.type", "BOOLEAN"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_properties.app_config_properties_data1", "properties.0.value", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigPropertiesDataSourceConfigBasic(instanceName, name, propertyID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test488" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "lite"
+		}
+		resource "ibm_app_config_property" "app_config_property_resource3" {
+			guid           = ibm_resource_instance.app_config_terraform_test488.guid
+			environment_id = "dev"
+			name           = "%s"
+			property_id    = "%s"
+			type           = "BOOLEAN"
+			value          = "true"
+			tags           = "terraform"
+		}
+		data "ibm_app_config_properties" "app_config_properties_data1" {
+			guid           = ibm_app_config_property.app_config_property_resource3.guid
+			environment_id = ibm_app_config_property.app_config_property_resource3.environment_id
+			tags           = ibm_app_config_property.app_config_property_resource3.tags
+			expand         = true
+		}`, instanceName, name, propertyID)
+}
@@ -0,0 +1,247 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+func DataSourceIBMAppConfigProperty() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIbmAppConfigPropertyRead,
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Environment Id.",
+			},
+			"property_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Property Id.",
+			},
+			"includes": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Include the associated collections in the response.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Property name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Property description.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Type of the property (BOOLEAN, STRING, NUMERIC).",
+			},
+			"format": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Format of the property (TEXT, JSON, YAML).",
+			},
+			"value": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Value of the property. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
+			},
+			"tags": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Tags associated with the property.",
+			},
+			"segment_exists": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Denotes if the targeting rules are specified for the property.",
+			},
+			"segment_rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Specify the targeting rules that is used to set different property values for different segments.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rules": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Rules array.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"segments": {
+										Type:        schema.TypeList,
+										Computed:    true,
+										Description: "List of segment ids that are used for targeting using the rule.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Value to be used for evaluation for this rule. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
+						},
+						"order": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "Order of the rule, used during evaluation. The evaluation is performed in the order defined and the value associated with the first matching rule is used for evaluation.",
+						},
+					},
+				},
+			},
+			"collections": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of collection id representing the collections that are associated with the specified property.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"collection_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Collection id.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the collection.",
+						},
+					},
+				},
+			},
+			"created_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the property.",
+			},
+			"updated_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modified time of the property data.",
+			},
+			"evaluation_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last occurrence of the property value evaluation.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Property URL.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmAppConfigPropertyRead(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.GetPropertyOptions{}
+	options.SetEnvironmentID(d.Get("environment_id").(string))
+	options.SetPropertyID(d.Get("property_id").(string))
+	if _, ok := d.GetOk("includes"); ok {
+		options.SetInclude(d.Get("includes").(string))
+	}
+
+	result, response, err := appconfigClient.GetProperty(options)
+	if err != nil {
+		log.Printf("[DEBUG] GetProperty failed %s\n%s", err, response)
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", guid, *options.EnvironmentID, *result.PropertyID))
+	if result.Name != nil {
+		if err = d.Set("name", result.Name); err != nil {
+			return fmt.Errorf("[ERROR] Error setting name: %s", err)
+		}
+	}
+	if result.Description != nil {
+		if err = d.Set("description", result.Description); err != nil {
+			return fmt.Errorf("[ERROR] Error setting description: %s", err)
+		}
+	}
+	if result.Type != nil {
+		if err = d.Set("type", result.Type); err != nil {
+			return fmt.Errorf("[ERROR] Error setting type: %s", err)
+		}
+	}
+	if result.Format != nil {
+		if err = d.Set("format", result.Format); err != nil {
+			return fmt.Errorf("[ERROR] Error setting format: %s", err)
+		}
+	}
+	if result.Value != nil {
+		if err = d.Set("value", resourceIbmAppConfigPropertyValueToString(result.Value)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting value: %s", err)
+		}
+	}
+	if result.Tags != nil {
+		if err = d.Set("tags", result.Tags); err != nil {
+			return fmt.Errorf("[ERROR] Error setting tags: %s", err)
+		}
+	}
+	if result.SegmentExists != nil {
+		if err = d.Set("segment_exists", result.SegmentExists); err != nil {
+			return fmt.Errorf("[ERROR] Error setting segment_exists: %s", err)
+		}
+	}
+	if result.SegmentRules != nil {
+		if err = d.Set("segment_rules", dataSourcePropertyFlattenSegmentRules(result.SegmentRules)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting segment_rules: %s", err)
+		}
+	}
+	if result.Collections != nil {
+		if err = d.Set("collections", dataSourceFeatureFlattenCollections(result.Collections)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting collections: %s", err)
+		}
+	}
+	if result.CreatedTime != nil {
+		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting created_time: %s", err)
+		}
+	}
+	if result.UpdatedTime != nil {
+		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting updated_time: %s", err)
+		}
+	}
+	if result.EvaluationTime != nil {
+		if err = d.Set("evaluation_time", result.EvaluationTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting evaluation_time: %s", err)
+		}
+	}
+	if result.Href != nil {
+		if err = d.Set("href", result.Href); err != nil {
+			return fmt.Errorf("[ERROR] Error setting href: %s", err)
+		}
+	}
+	return nil
+}
+
+func dataSourcePropertyFlattenSegmentRules(result []appconfigurationv1.SegmentRule) (segmentRules []map[string]interface{}) {
+	for _, segmentRulesItem := range result {
+		segmentRules = append(segmentRules, resourceIbmAppConfigPropertySegmentRuleToMap(segmentRulesItem))
+	}
+	return segmentRules
+}
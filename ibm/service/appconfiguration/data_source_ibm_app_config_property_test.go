@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmAppConfigPropertyDataSource(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	propertyID := fmt.Sprintf("tf_property_id_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigPropertyDataSourceConfigBasic(instanceName, name, propertyID, description),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_property.app_config_property_data1", "id"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_property.app_config_property_data1", "name", name),
+					resource.TestCheckResourceAttr("data.ibm_app_config_property.app_config_property_data1", "type", "STRING"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_property.app_config_property_data1", "format", "TEXT"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_property.app_config_property_data1", "value", "blue"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_property.app_config_property_data1", "segment_exists"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_property.app_config_property_data1", "created_time"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_property.app_config_property_data1", "href"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigPropertyDataSourceConfigBasic(instanceName, name, propertyID, description string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test487" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "lite"
+		}
+		resource "ibm_app_config_property" "app_config_property_resource2" {
+			guid           = ibm_resource_instance.app_config_terraform_test487.guid
+			environment_id = "dev"
+			name           = "%s"
+			property_id    = "%s"
+			type           = "STRING"
+			format         = "TEXT"
+			value          = "blue"
+			description    = "%s"
+		}
+		data "ibm_app_config_property" "app_config_property_data1" {
+			guid           = ibm_app_config_property.app_config_property_resource2.guid
+			environment_id = ibm_app_config_property.app_config_property_resource2.environment_id
+			property_id    = ibm_app_config_property.app_config_property_resource2.property_id
+		}`, instanceName, name, propertyID, description)
+}
@@ -0,0 +1,223 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+func DataSourceIBMAppConfigSegment() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIbmAppConfigSegmentRead,
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"segment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Segment Id.",
+			},
+			"includes": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Include the associated features or properties in the response.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Segment name.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Segment description.",
+			},
+			"tags": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Tags associated with the segment.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of rules that determine if the entity belongs to the segment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Attribute name.",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Operator to be used for the evaluation if the entity belongs to the segment.",
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "List of values.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"created_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the segment.",
+			},
+			"updated_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modified time of the segment data.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Segment URL.",
+			},
+			"features": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of features that use the segment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"feature_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Feature id.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Feature name.",
+						},
+					},
+				},
+			},
+			"properties": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of properties that use the segment.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"property_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Property id.",
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Property name.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIbmAppConfigSegmentRead(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.GetSegmentOptions{}
+	options.SetSegmentID(d.Get("segment_id").(string))
+	if _, ok := d.GetOk("includes"); ok {
+		options.SetInclude(flex.ExpandStringList(d.Get("includes").([]interface{})))
+	}
+
+	result, response, err := appconfigClient.GetSegment(options)
+	if err != nil {
+		log.Printf("[DEBUG] GetSegment failed %s\n%s", err, response)
+		return err
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", guid, *result.SegmentID))
+	if result.Name != nil {
+		if err = d.Set("name", result.Name); err != nil {
+			return fmt.Errorf("[ERROR] Error setting name: %s", err)
+		}
+	}
+	if result.Description != nil {
+		if err = d.Set("description", result.Description); err != nil {
+			return fmt.Errorf("[ERROR] Error setting description: %s", err)
+		}
+	}
+	if result.Tags != nil {
+		if err = d.Set("tags", result.Tags); err != nil {
+			return fmt.Errorf("[ERROR] Error setting tags: %s", err)
+		}
+	}
+	if result.Rules != nil {
+		if err = d.Set("rules", resourceIbmAppConfigSegmentRulesToMap(result.Rules)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting rules: %s", err)
+		}
+	}
+	if result.CreatedTime != nil {
+		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting created_time: %s", err)
+		}
+	}
+	if result.UpdatedTime != nil {
+		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting updated_time: %s", err)
+		}
+	}
+	if result.Href != nil {
+		if err = d.Set("href", result.Href); err != nil {
+			return fmt.Errorf("[ERROR] Error setting href: %s", err)
+		}
+	}
+	if result.Features != nil {
+		if err = d.Set("features", dataSourceAppConfigFlattenFeatureOutputs(result.Features)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting features: %s", err)
+		}
+	}
+	if result.Properties != nil {
+		if err = d.Set("properties", dataSourceAppConfigFlattenPropertyOutputs(result.Properties)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting properties: %s", err)
+		}
+	}
+	return nil
+}
+
+func dataSourceAppConfigFlattenFeatureOutputs(result []appconfigurationv1.FeatureOutput) (features []map[string]interface{}) {
+	features = []map[string]interface{}{}
+	for _, featuresItem := range result {
+		features = append(features, map[string]interface{}{
+			"feature_id": featuresItem.FeatureID,
+			"name":       featuresItem.Name,
+		})
+	}
+	return features
+}
+
+func dataSourceAppConfigFlattenPropertyOutputs(result []appconfigurationv1.PropertyOutput) (properties []map[string]interface{}) {
+	properties = []map[string]interface{}{}
+	for _, propertiesItem := range result {
+		properties = append(properties, map[string]interface{}{
+			"property_id": propertiesItem.PropertyID,
+			"name":        propertiesItem.Name,
+		})
+	}
+	return properties
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmAppConfigSegmentDataSource(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	segmentID := fmt.Sprintf("tf_segment_id_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigSegmentDataSourceConfigBasic(instanceName, name, segmentID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_segment.app_config_segment_data1", "id"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_segment.app_config_segment_data1", "name", name),
+					resource.TestCheckResourceAttr("data.ibm_app_config_segment.app_config_segment_data1", "rules.#", "1"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_segment.app_config_segment_data1", "rules.0.attribute_name", "email"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_segment.app_config_segment_data1", "created_time"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_segment.app_config_segment_data1", "href"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigSegmentDataSourceConfigBasic(instanceName, name, segmentID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test483" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "standard"
+		}
+		resource "ibm_app_config_segment" "app_config_segment_resource3" {
+			guid       = ibm_resource_instance.app_config_terraform_test483.guid
+			name       = "%s"
+			segment_id = "%s"
+			rules {
+				attribute_name = "email"
+				operator       = "endsWith"
+				values         = ["@ibm.com"]
+			}
+		}
+		data "ibm_app_config_segment" "app_config_segment_data1" {
+			guid       = ibm_app_config_segment.app_config_segment_resource3.guid
+			segment_id = ibm_app_config_segment.app_config_segment_resource3.segment_id
+			includes   = ["features", "properties"]
+		}`, instanceName, name, segmentID)
+}
@@ -0,0 +1,63 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIbmAppConfigSegmentsDataSource(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	segmentID := fmt.Sprintf("tf_segment_id_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigSegmentsDataSourceConfigBasic(instanceName, name, segmentID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_segments.app_config_segments_data1", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_segments.app_config_segments_data1", "total_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_segments.app_config_segments_data1", "first.#"),
+					resource.TestCheckResourceAttrSet("data.ibm_app_config_segments.app_config_segments_data1", "segments.#"),
+					resource.TestCheckResourceAttr("data.ibm_app_config_segments.app_config_segments_data1", "segments.0.segment_id", segmentID),
+					resource.TestCheckResourceAttr("data.ibm_app_config_segments.app_config_segments_data1", "segments.0.name", name),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigSegmentsDataSourceConfigBasic(instanceName, name, segmentID string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test484" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "standard"
+		}
+		resource "ibm_app_config_segment" "app_config_segment_resource4" {
+			guid       = ibm_resource_instance.app_config_terraform_test484.guid
+			name       = "%s"
+			segment_id = "%s"
+			tags       = "terraform"
+			rules {
+				attribute_name = "country"
+				operator       = "is"
+				values         = ["India", "USA"]
+			}
+		}
+		data "ibm_app_config_segments" "app_config_segments_data1" {
+			guid = ibm_app_config_segment.app_config_segment_resource4.guid
+			tags = ibm_app_config_segment.app_config_segment_resource4.tags
+		}`, instanceName, name, segmentID)
+}
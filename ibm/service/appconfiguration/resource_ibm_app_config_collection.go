@@ -0,0 +1,228 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+func ResourceIBMAppConfigCollection() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIbmAppConfigCollectionCreate,
+		Read:     resourceIbmAppConfigCollectionRead,
+		Update:   resourceIbmAppConfigCollectionUpdate,
+		Delete:   resourceIbmAppConfigCollectionDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Collection name.",
+			},
+			"collection_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Collection id.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Collection description.",
+			},
+			"tags": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Tags associated with the collection.",
+			},
+			"features_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of features associated with the collection.",
+			},
+			"properties_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Number of properties associated with the collection.",
+			},
+			"created_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the collection.",
+			},
+			"updated_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modified time of the collection data.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Collection URL.",
+			},
+		},
+	}
+}
+
+func resourceIbmAppConfigCollectionCreate(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return err
+	}
+	options := &appconfigurationv1.CreateCollectionOptions{}
+	options.SetName(d.Get("name").(string))
+	options.SetCollectionID(d.Get("collection_id").(string))
+	if _, ok := d.GetOk("description"); ok {
+		options.SetDescription(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("tags"); ok {
+		options.SetTags(d.Get("tags").(string))
+	}
+
+	collection, response, err := appconfigClient.CreateCollection(options)
+	if err != nil {
+		return fmt.Errorf("[DEBUG] CreateCollection failed %s\n%s", err, response)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", guid, *collection.CollectionID))
+
+	return resourceIbmAppConfigCollectionRead(d, meta)
+}
+
+func resourceIbmAppConfigCollectionUpdate(d *schema.ResourceData, meta interface{}) error {
+	if ok := d.HasChanges("name", "description", "tags"); ok {
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return nil
+		}
+		appconfigClient, err := getAppConfigClient(meta, parts[0])
+		if err != nil {
+			return err
+		}
+
+		options := &appconfigurationv1.UpdateCollectionOptions{}
+		options.SetCollectionID(parts[1])
+		options.SetName(d.Get("name").(string))
+		options.SetDescription(d.Get("description").(string))
+		options.SetTags(d.Get("tags").(string))
+
+		_, response, err := appconfigClient.UpdateCollection(options)
+		if err != nil {
+			return fmt.Errorf("[DEBUG] UpdateCollection failed %s\n%s", err, response)
+		}
+		return resourceIbmAppConfigCollectionRead(d, meta)
+	}
+	return nil
+}
+
+func resourceIbmAppConfigCollectionRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil
+	}
+	appconfigClient, err := getAppConfigClient(meta, parts[0])
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.GetCollectionOptions{}
+	options.SetExpand(true)
+	options.SetCollectionID(parts[1])
+
+	result, response, err := appconfigClient.GetCollection(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[DEBUG] GetCollection failed %s\n%s", err, response)
+	}
+
+	d.Set("guid", parts[0])
+	if result.Name != nil {
+		if err = d.Set("name", result.Name); err != nil {
+			return fmt.Errorf("[ERROR] Error setting name: %s", err)
+		}
+	}
+	if result.CollectionID != nil {
+		if err = d.Set("collection_id", result.CollectionID); err != nil {
+			return fmt.Errorf("[ERROR] Error setting collection_id: %s", err)
+		}
+	}
+	if result.Description != nil {
+		if err = d.Set("description", result.Description); err != nil {
+			return fmt.Errorf("[ERROR] Error setting description: %s", err)
+		}
+	}
+	if result.Tags != nil {
+		if err = d.Set("tags", result.Tags); err != nil {
+			return fmt.Errorf("[ERROR] Error setting tags: %s", err)
+		}
+	}
+	if result.FeaturesCount != nil {
+		if err = d.Set("features_count", result.FeaturesCount); err != nil {
+			return fmt.Errorf("[ERROR] Error setting features_count: %s", err)
+		}
+	}
+	if result.PropertiesCount != nil {
+		if err = d.Set("properties_count", result.PropertiesCount); err != nil {
+			return fmt.Errorf("[ERROR] Error setting properties_count: %s", err)
+		}
+	}
+	if result.CreatedTime != nil {
+		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting created_time: %s", err)
+		}
+	}
+	if result.UpdatedTime != nil {
+		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting updated_time: %s", err)
+		}
+	}
+	if result.Href != nil {
+		if err = d.Set("href", result.Href); err != nil {
+			return fmt.Errorf("[ERROR] Error setting href: %s", err)
+		}
+	}
+	return nil
+}
+
+func resourceIbmAppConfigCollectionDelete(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil
+	}
+	appconfigClient, err := getAppConfigClient(meta, parts[0])
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.DeleteCollectionOptions{}
+	options.SetCollectionID(parts[1])
+
+	response, err := appconfigClient.DeleteCollection(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[DEBUG] DeleteCollection failed %s\n%s", err, response)
+	}
+
+	d.SetId("")
+
+	return nil
+}
@@ -0,0 +1,138 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+func TestAccIbmAppConfigCollectionBasic(t *testing.T) {
+	var conf appconfigurationv1.Collection
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	collectionID := fmt.Sprintf("tf_collection_id_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	tags := fmt.Sprintf("tags_%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	descriptionUpdate := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	tagsUpdate := fmt.Sprintf("tags_updated_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmAppConfigCollectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigCollectionConfigBasic(instanceName, name, collectionID, description, tags),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmAppConfigCollectionExists("ibm_app_config_collection.app_config_collection_resource1", conf),
+					resource.TestCheckResourceAttrSet("ibm_app_config_collection.app_config_collection_resource1", "id"),
+					resource.TestCheckResourceAttr("ibm_app_config_collection.app_config_collection_resource1", "collection_id", collectionID),
+					resource.TestCheckResourceAttr("ibm_app_config_collection.app_config_collection_resource1", "name", name),
+					resource.TestCheckResourceAttr("ibm_app_config_collection.app_config_collection_resource1", "tags", tags),
+					resource.TestCheckResourceAttrSet("ibm_app_config_collection.app_config_collection_resource1", "created_time"),
+					resource.TestCheckResourceAttrSet("ibm_app_config_collection.app_config_collection_resource1", "href"),
+				),
+			},
+			{
+				Config: testAccCheckIbmAppConfigCollectionConfigBasic(instanceName, nameUpdate, collectionID, descriptionUpdate, tagsUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_app_config_collection.app_config_collection_resource1", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_app_config_collection.app_config_collection_resource1", "description", descriptionUpdate),
+					resource.TestCheckResourceAttr("ibm_app_config_collection.app_config_collection_resource1", "tags", tagsUpdate),
+				),
+			},
+			{
+				ResourceName:      "ibm_app_config_collection.app_config_collection_resource1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigCollectionConfigBasic(instanceName, name, collectionID, description, tags string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test461" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "lite"
+		}
+		resource "ibm_app_config_collection" "app_config_collection_resource1" {
+			guid          = ibm_resource_instance.app_config_terraform_test461.guid
+			name          = "%s"
+			collection_id = "%s"
+			description   = "%s"
+			tags          = "%s"
+		}`, instanceName, name, collectionID, description, tags)
+}
+
+func testAccCheckIbmAppConfigCollectionExists(n string, obj appconfigurationv1.Collection) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		appconfigClient, err := getAppConfigClient(acc.TestAccProvider.Meta(), parts[0])
+		if err != nil {
+			return err
+		}
+
+		options := &appconfigurationv1.GetCollectionOptions{}
+		options.SetCollectionID(parts[1])
+
+		result, _, err := appconfigClient.GetCollection(options)
+		if err != nil {
+			return err
+		}
+
+		obj = *result
+		return nil
+	}
+}
+
+func testAccCheckIbmAppConfigCollectionDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_app_config_collection" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		appconfigClient, err := getAppConfigClient(acc.TestAccProvider.Meta(), parts[0])
+		if err != nil {
+			return err
+		}
+		options := &appconfigurationv1.GetCollectionOptions{}
+		options.SetCollectionID(parts[1])
+
+		// Try to find the key
+		_, response, err := appconfigClient.GetCollection(options)
+
+		if err == nil {
+			return fmt.Errorf("Collection still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for Collection (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
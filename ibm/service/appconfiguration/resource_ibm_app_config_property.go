@@ -0,0 +1,507 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMAppConfigProperty() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIbmAppConfigPropertyCreate,
+		Read:     resourceIbmAppConfigPropertyRead,
+		Update:   resourceIbmAppConfigPropertyUpdate,
+		Delete:   resourceIbmAppConfigPropertyDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"environment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Environment Id.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Property name.",
+			},
+			"property_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Property id.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_app_config_property", "type"),
+				Description:  "Type of the property (BOOLEAN, STRING, NUMERIC).",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_app_config_property", "format"),
+				Description:  "Format of the property (TEXT, JSON, YAML). Only applicable to the STRING type.",
+			},
+			"value": {
+				Type:             schema.TypeString,
+				Required:         true,
+				DiffSuppressFunc: SuppressAppConfigPropertyJSONValueDiff,
+				Description:      "Value of the property. The value can be BOOLEAN, STRING or a NUMERIC value as per the `type` attribute. A JSON value is set with `jsonencode()`.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Property description.",
+			},
+			"tags": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Tags associated with the property.",
+			},
+			"segment_rules": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specify the targeting rules that is used to set different property values for different segments.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"rules": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "Rules array.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"segments": {
+										Type:        schema.TypeList,
+										Required:    true,
+										Description: "List of segment ids that are used for targeting using the rule.",
+										Elem:        &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+						"value": {
+							Type:             schema.TypeString,
+							Required:         true,
+							DiffSuppressFunc: SuppressAppConfigPropertyJSONValueDiff,
+							Description:      "Value to be used for evaluation for this rule. The value can be Boolean, String or a Numeric value as per the `type` attribute.",
+						},
+						"order": {
+							Type:        schema.TypeInt,
+							Required:    true,
+							Description: "Order of the rule, used during evaluation. The evaluation is performed in the order defined and the value associated with the first matching rule is used for evaluation.",
+						},
+					},
+				},
+			},
+			"collections": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of collection id representing the collections that are associated with the specified property.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"collection_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Collection id.",
+						},
+					},
+				},
+			},
+			"segment_exists": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Denotes if the targeting rules are specified for the property.",
+			},
+			"created_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the property.",
+			},
+			"updated_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modified time of the property data.",
+			},
+			"evaluation_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last occurrence of the property value evaluation.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Property URL.",
+			},
+		},
+	}
+}
+
+func resourceIbmAppConfigPropertyCreate(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return err
+	}
+	propertyType := d.Get("type").(string)
+	propertyFormat := d.Get("format").(string)
+
+	options := &appconfigurationv1.CreatePropertyOptions{}
+	options.SetEnvironmentID(d.Get("environment_id").(string))
+	options.SetName(d.Get("name").(string))
+	options.SetPropertyID(d.Get("property_id").(string))
+	options.SetType(propertyType)
+	if propertyFormat != "" {
+		options.SetFormat(propertyFormat)
+	}
+	value, err := resourceIbmAppConfigPropertyValue(propertyType, propertyFormat, d.Get("value").(string))
+	if err != nil {
+		return fmt.Errorf("'value' parameter has wrong value: %s", err)
+	}
+	options.SetValue(value)
+	if _, ok := d.GetOk("description"); ok {
+		options.SetDescription(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("tags"); ok {
+		options.SetTags(d.Get("tags").(string))
+	}
+	if _, ok := d.GetOk("segment_rules"); ok {
+		segmentRules, err := resourceIbmAppConfigPropertyMapToSegmentRules(propertyType, propertyFormat, d.Get("segment_rules").([]interface{}))
+		if err != nil {
+			return err
+		}
+		options.SetSegmentRules(segmentRules)
+	}
+	if _, ok := d.GetOk("collections"); ok {
+		var collections []appconfigurationv1.CollectionRef
+		for _, e := range d.Get("collections").([]interface{}) {
+			collections = append(collections, resourceIbmAppConfigFeatureMapToCollectionRef(e.(map[string]interface{})))
+		}
+		options.SetCollections(collections)
+	}
+
+	property, response, err := appconfigClient.CreateProperty(options)
+	if err != nil {
+		return fmt.Errorf("[DEBUG] CreateProperty failed %s\n%s", err, response)
+	}
+	d.SetId(fmt.Sprintf("%s/%s/%s", guid, *options.EnvironmentID, *property.PropertyID))
+
+	return resourceIbmAppConfigPropertyRead(d, meta)
+}
+
+func resourceIbmAppConfigPropertyUpdate(d *schema.ResourceData, meta interface{}) error {
+	if ok := d.HasChanges("name", "value", "description", "tags", "segment_rules", "collections"); ok {
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return nil
+		}
+		appconfigClient, err := getAppConfigClient(meta, parts[0])
+		if err != nil {
+			return err
+		}
+		propertyType := d.Get("type").(string)
+		propertyFormat := d.Get("format").(string)
+
+		options := &appconfigurationv1.UpdatePropertyOptions{}
+		options.SetEnvironmentID(parts[1])
+		options.SetPropertyID(parts[2])
+		options.SetName(d.Get("name").(string))
+		value, err := resourceIbmAppConfigPropertyValue(propertyType, propertyFormat, d.Get("value").(string))
+		if err != nil {
+			return fmt.Errorf("'value' parameter has wrong value: %s", err)
+		}
+		options.SetValue(value)
+		options.SetDescription(d.Get("description").(string))
+		options.SetTags(d.Get("tags").(string))
+
+		// Removed targeting rules and collections are cleared with empty lists
+		segmentRules, err := resourceIbmAppConfigPropertyMapToSegmentRules(propertyType, propertyFormat, d.Get("segment_rules").([]interface{}))
+		if err != nil {
+			return err
+		}
+		options.SetSegmentRules(segmentRules)
+		collections := []appconfigurationv1.CollectionRef{}
+		for _, e := range d.Get("collections").([]interface{}) {
+			collections = append(collections, resourceIbmAppConfigFeatureMapToCollectionRef(e.(map[string]interface{})))
+		}
+		options.SetCollections(collections)
+
+		_, response, err := appconfigClient.UpdateProperty(options)
+		if err != nil {
+			return fmt.Errorf("[DEBUG] UpdateProperty failed %s\n%s", err, response)
+		}
+		return resourceIbmAppConfigPropertyRead(d, meta)
+	}
+	return nil
+}
+
+func resourceIbmAppConfigPropertyRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil
+	}
+	appconfigClient, err := getAppConfigClient(meta, parts[0])
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.GetPropertyOptions{}
+	options.SetEnvironmentID(parts[1])
+	options.SetPropertyID(parts[2])
+
+	result, response, err := appconfigClient.GetProperty(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[DEBUG] GetProperty failed %s\n%s", err, response)
+	}
+
+	d.Set("guid", parts[0])
+	d.Set("environment_id", parts[1])
+	if result.Name != nil {
+		if err = d.Set("name", result.Name); err != nil {
+			return fmt.Errorf("[ERROR] Error setting name: %s", err)
+		}
+	}
+	if result.PropertyID != nil {
+		if err = d.Set("property_id", result.PropertyID); err != nil {
+			return fmt.Errorf("[ERROR] Error setting property_id: %s", err)
+		}
+	}
+	if result.Type != nil {
+		if err = d.Set("type", result.Type); err != nil {
+			return fmt.Errorf("[ERROR] Error setting type: %s", err)
+		}
+	}
+	if result.Format != nil {
+		if err = d.Set("format", result.Format); err != nil {
+			return fmt.Errorf("[ERROR] Error setting format: %s", err)
+		}
+	}
+	if result.Value != nil {
+		if err = d.Set("value", resourceIbmAppConfigPropertyValueToString(result.Value)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting value: %s", err)
+		}
+	}
+	if result.Description != nil {
+		if err = d.Set("description", result.Description); err != nil {
+			return fmt.Errorf("[ERROR] Error setting description: %s", err)
+		}
+	}
+	if result.Tags != nil {
+		if err = d.Set("tags", result.Tags); err != nil {
+			return fmt.Errorf("[ERROR] Error setting tags: %s", err)
+		}
+	}
+	segmentRules := []map[string]interface{}{}
+	for _, segmentRulesItem := range result.SegmentRules {
+		segmentRules = append(segmentRules, resourceIbmAppConfigPropertySegmentRuleToMap(segmentRulesItem))
+	}
+	if err = d.Set("segment_rules", segmentRules); err != nil {
+		return fmt.Errorf("[ERROR] Error setting segment_rules: %s", err)
+	}
+	collections := []map[string]interface{}{}
+	for _, collectionsItem := range result.Collections {
+		collections = append(collections, map[string]interface{}{"collection_id": collectionsItem.CollectionID})
+	}
+	if err = d.Set("collections", collections); err != nil {
+		return fmt.Errorf("[ERROR] Error setting collections: %s", err)
+	}
+	if result.SegmentExists != nil {
+		if err = d.Set("segment_exists", result.SegmentExists); err != nil {
+			return fmt.Errorf("[ERROR] Error setting segment_exists: %s", err)
+		}
+	}
+	if result.CreatedTime != nil {
+		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting created_time: %s", err)
+		}
+	}
+	if result.UpdatedTime != nil {
+		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting updated_time: %s", err)
+		}
+	}
+	if result.EvaluationTime != nil {
+		if err = d.Set("evaluation_time", result.EvaluationTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting evaluation_time: %s", err)
+		}
+	}
+	if result.Href != nil {
+		if err = d.Set("href", result.Href); err != nil {
+			return fmt.Errorf("[ERROR] Error setting href: %s", err)
+		}
+	}
+	return nil
+}
+
+func resourceIbmAppConfigPropertyDelete(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil
+	}
+	appconfigClient, err := getAppConfigClient(meta, parts[0])
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.DeletePropertyOptions{}
+	options.SetEnvironmentID(parts[1])
+	options.SetPropertyID(parts[2])
+
+	response, err := appconfigClient.DeleteProperty(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[DEBUG] DeleteProperty failed %s\n%s", err, response)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func ResourceIBMAppConfigPropertyValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "type",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "BOOLEAN, NUMERIC, STRING",
+		},
+		validate.ValidateSchema{
+			Identifier:                 "format",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			AllowedValues:              "TEXT, JSON, YAML",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{
+		ResourceName: "ibm_app_config_property",
+		Schema:       validateSchema,
+	}
+	return &resourceValidator
+}
+
+// resourceIbmAppConfigPropertyValue converts the value of the configuration
+// to the value of the type and the format of the property.
+func resourceIbmAppConfigPropertyValue(propertyType, propertyFormat, value string) (interface{}, error) {
+	switch propertyType {
+	case "NUMERIC":
+		return strconv.ParseFloat(value, 64)
+	case "BOOLEAN":
+		if value != "true" && value != "false" {
+			return nil, fmt.Errorf("%q is not true or false", value)
+		}
+		return value == "true", nil
+	}
+	if propertyFormat == "JSON" {
+		var jsonValue interface{}
+		if err := json.Unmarshal([]byte(value), &jsonValue); err != nil {
+			return nil, err
+		}
+		return jsonValue, nil
+	}
+	return value, nil
+}
+
+func resourceIbmAppConfigPropertyValueToString(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return fmt.Sprintf("%v", v)
+	case bool:
+		return strconv.FormatBool(v)
+	}
+	// JSON values are returned as objects or arrays
+	jsonValue, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(jsonValue)
+}
+
+// SuppressAppConfigPropertyJSONValueDiff compares the values of a JSON
+// property as JSON, the value is read back compacted with sorted keys.
+func SuppressAppConfigPropertyJSONValueDiff(k, old, new string, d *schema.ResourceData) bool {
+	if d.Get("format").(string) != "JSON" || old == "" || new == "" {
+		return false
+	}
+	var oldValue, newValue interface{}
+	if err := json.Unmarshal([]byte(old), &oldValue); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newValue); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldValue, newValue)
+}
+
+// output
+func resourceIbmAppConfigPropertySegmentRuleToMap(segmentRule appconfigurationv1.SegmentRule) map[string]interface{} {
+	segmentRuleMap := map[string]interface{}{}
+
+	rules := []map[string]interface{}{}
+	for _, rulesItem := range segmentRule.Rules {
+		rules = append(rules, resourceIbmAppConfigFeatureRuleToMap(rulesItem))
+	}
+	segmentRuleMap["rules"] = rules
+	segmentRuleMap["order"] = flex.IntValue(segmentRule.Order)
+	segmentRuleMap["value"] = resourceIbmAppConfigPropertyValueToString(segmentRule.Value)
+
+	return segmentRuleMap
+}
+
+// input
+func resourceIbmAppConfigPropertyMapToSegmentRules(propertyType, propertyFormat string, segmentRulesList []interface{}) ([]appconfigurationv1.SegmentRule, error) {
+	segmentRules := []appconfigurationv1.SegmentRule{}
+	for _, e := range segmentRulesList {
+		segmentRuleMap := e.(map[string]interface{})
+		segmentRule := appconfigurationv1.SegmentRule{}
+
+		rules := []appconfigurationv1.TargetSegments{}
+		for _, rulesItem := range segmentRuleMap["rules"].([]interface{}) {
+			rules = append(rules, resourceIbmAppConfigFeatureMapToRule(rulesItem.(map[string]interface{})))
+		}
+		segmentRule.Rules = rules
+		segmentRule.Order = core.Int64Ptr(int64(segmentRuleMap["order"].(int)))
+
+		value, err := resourceIbmAppConfigPropertyValue(propertyType, propertyFormat, segmentRuleMap["value"].(string))
+		if err != nil {
+			return segmentRules, fmt.Errorf("'value' parameter in 'segment_rules' has wrong value: %s", err)
+		}
+		segmentRule.Value = value
+		segmentRules = append(segmentRules, segmentRule)
+	}
+	return segmentRules, nil
+}
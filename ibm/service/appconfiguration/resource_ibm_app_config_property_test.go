@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/appconfiguration"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+func TestAccIbmAppConfigPropertyBasic(t *testing.T) {
+	var conf appconfigurationv1.Property
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	propertyID := fmt.Sprintf("tf_property_id_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	descriptionUpdate := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmAppConfigPropertyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigPropertyConfigBasic(instanceName, name, propertyID, description, "10", "20"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmAppConfigPropertyExists("ibm_app_config_property.app_config_property_resource1", conf),
+					resource.TestCheckResourceAttrSet("ibm_app_config_property.app_config_property_resource1", "id"),
+					resource.TestCheckResourceAttr("ibm_app_config_property.app_config_property_resource1", "property_id", propertyID),
+					resource.TestCheckResourceAttr("ibm_app_config_property.app_config_property_resource1", "type", "NUMERIC"),
+					resource.TestCheckResourceAttr("ibm_app_config_property.app_config_property_resource1", "value", "10"),
+					resource.TestCheckResourceAttr("ibm_app_config_property.app_config_property_resource1", "segment_rules.#", "1"),
+					resource.TestCheckResourceAttr("ibm_app_config_property.app_config_property_resource1", "segment_rules.0.value", "20"),
+					resource.TestCheckResourceAttr("ibm_app_config_property.app_config_property_resource1", "collections.#", "1"),
+					resource.TestCheckResourceAttr("ibm_app_config_property.app_config_property_resource1", "segment_exists", "true"),
+					resource.TestCheckResourceAttrSet("ibm_app_config_property.app_config_property_resource1", "created_time"),
+					resource.TestCheckResourceAttrSet("ibm_app_config_property.app_config_property_resource1", "href"),
+				),
+			},
+			{
+				Config: testAccCheckIbmAppConfigPropertyConfigBasic(instanceName, nameUpdate, propertyID, descriptionUpdate, "15.5", "25"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_app_config_property.app_config_property_resource1", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_app_config_property.app_config_property_resource1", "description", descriptionUpdate),
+					resource.TestCheckResourceAttr("ibm_app_config_property.app_config_property_resource1", "value", "15.5"),
+					resource.TestCheckResourceAttr("ibm_app_config_property.app_config_property_resource1", "segment_rules.0.value", "25"),
+				),
+			},
+			{
+				ResourceName:      "ibm_app_config_property.app_config_property_resource1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigPropertyConfigBasic(instanceName, name, propertyID, description, value, segmentValue string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test462" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "standard"
+		}
+		resource "ibm_app_config_segment" "app_config_segment_resource2" {
+			guid       = ibm_resource_instance.app_config_terraform_test462.guid
+			name       = "ibm employees"
+			segment_id = "ibm_employees"
+			rules {
+				attribute_name = "email"
+				operator       = "endsWith"
+				values         = ["@ibm.com"]
+			}
+		}
+		resource "ibm_app_config_collection" "app_config_collection_resource2" {
+			guid          = ibm_resource_instance.app_config_terraform_test462.guid
+			name          = "web app"
+			collection_id = "web_app"
+		}
+		resource "ibm_app_config_property" "app_config_property_resource1" {
+			guid           = ibm_resource_instance.app_config_terraform_test462.guid
+			environment_id = "dev"
+			name           = "%s"
+			property_id    = "%s"
+			type           = "NUMERIC"
+			value          = "%s"
+			description    = "%s"
+			tags           = "development property"
+			segment_rules {
+				rules {
+					segments = [ibm_app_config_segment.app_config_segment_resource2.segment_id]
+				}
+				value = "%s"
+				order = 1
+			}
+			collections {
+				collection_id = ibm_app_config_collection.app_config_collection_resource2.collection_id
+			}
+		}`, instanceName, name, propertyID, value, description, segmentValue)
+}
+
+func testAccCheckIbmAppConfigPropertyExists(n string, obj appconfigurationv1.Property) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		appconfigClient, err := getAppConfigClient(acc.TestAccProvider.Meta(), parts[0])
+		if err != nil {
+			return err
+		}
+
+		options := &appconfigurationv1.GetPropertyOptions{}
+		options.SetEnvironmentID(parts[1])
+		options.SetPropertyID(parts[2])
+
+		result, _, err := appconfigClient.GetProperty(options)
+		if err != nil {
+			return err
+		}
+
+		obj = *result
+		return nil
+	}
+}
+
+func testAccCheckIbmAppConfigPropertyDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_app_config_property" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		appconfigClient, err := getAppConfigClient(acc.TestAccProvider.Meta(), parts[0])
+		if err != nil {
+			return err
+		}
+		options := &appconfigurationv1.GetPropertyOptions{}
+		options.SetEnvironmentID(parts[1])
+		options.SetPropertyID(parts[2])
+
+		// Try to find the key
+		_, response, err := appconfigClient.GetProperty(options)
+
+		if err == nil {
+			return fmt.Errorf("Property still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for Property (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func TestSuppressAppConfigPropertyJSONValueDiff(t *testing.T) {
+	resourceSchema := appconfiguration.ResourceIBMAppConfigProperty().Schema
+	jsonProperty := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"type": "STRING", "format": "JSON"})
+	textProperty := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{"type": "STRING", "format": "TEXT"})
+
+	old := `{"a":1,"b":[true,"x"]}`
+	equivalent := "{\n  \"b\": [true, \"x\"],\n  \"a\": 1.0\n}"
+	if !appconfiguration.SuppressAppConfigPropertyJSONValueDiff("value", old, equivalent, jsonProperty) {
+		t.Errorf("expected the diff between equivalent JSON values to be suppressed")
+	}
+	for _, n := range []string{`{"a":2,"b":[true,"x"]}`, `{"a":`} {
+		if appconfiguration.SuppressAppConfigPropertyJSONValueDiff("value", old, n, jsonProperty) {
+			t.Errorf("expected the diff to %s not to be suppressed", n)
+		}
+	}
+	if appconfiguration.SuppressAppConfigPropertyJSONValueDiff("value", "", old, jsonProperty) {
+		t.Errorf("expected the diff of a new value not to be suppressed")
+	}
+	if appconfiguration.SuppressAppConfigPropertyJSONValueDiff("value", old, equivalent, textProperty) {
+		t.Errorf("expected the diff of a TEXT value not to be suppressed")
+	}
+}
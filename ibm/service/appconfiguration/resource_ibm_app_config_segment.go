@@ -0,0 +1,288 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func ResourceIBMAppConfigSegment() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIbmAppConfigSegmentCreate,
+		Read:     resourceIbmAppConfigSegmentRead,
+		Update:   resourceIbmAppConfigSegmentUpdate,
+		Delete:   resourceIbmAppConfigSegmentDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"guid": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "GUID of the App Configuration service. Get it from the service instance credentials section of the dashboard.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Segment name.",
+			},
+			"segment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Segment id.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Segment description.",
+			},
+			"tags": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Tags associated with the segment.",
+			},
+			"rules": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Description: "List of rules that determine if the entity belongs to the segment during feature flag or property evaluation. An entity belongs to the segment if it matches all the rules.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"attribute_name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Attribute name.",
+						},
+						"operator": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.InvokeValidator("ibm_app_config_segment", "operator"),
+							Description:  "Operator to be used for the evaluation if the entity belongs to the segment.",
+						},
+						"values": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Description: "List of values. Entities matching any of the given values will be considered to belong to the segment.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+			"created_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Creation time of the segment.",
+			},
+			"updated_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Last modified time of the segment data.",
+			},
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Segment URL.",
+			},
+		},
+	}
+}
+
+func resourceIbmAppConfigSegmentCreate(d *schema.ResourceData, meta interface{}) error {
+	guid := d.Get("guid").(string)
+	appconfigClient, err := getAppConfigClient(meta, guid)
+	if err != nil {
+		return err
+	}
+	options := &appconfigurationv1.CreateSegmentOptions{}
+	options.SetName(d.Get("name").(string))
+	options.SetSegmentID(d.Get("segment_id").(string))
+	if _, ok := d.GetOk("description"); ok {
+		options.SetDescription(d.Get("description").(string))
+	}
+	if _, ok := d.GetOk("tags"); ok {
+		options.SetTags(d.Get("tags").(string))
+	}
+	options.SetRules(resourceIbmAppConfigSegmentMapToRules(d.Get("rules").([]interface{})))
+
+	segment, response, err := appconfigClient.CreateSegment(options)
+	if err != nil {
+		return fmt.Errorf("[DEBUG] CreateSegment failed %s\n%s", err, response)
+	}
+	d.SetId(fmt.Sprintf("%s/%s", guid, *segment.SegmentID))
+
+	return resourceIbmAppConfigSegmentRead(d, meta)
+}
+
+func resourceIbmAppConfigSegmentUpdate(d *schema.ResourceData, meta interface{}) error {
+	if ok := d.HasChanges("name", "description", "tags", "rules"); ok {
+		parts, err := flex.IdParts(d.Id())
+		if err != nil {
+			return nil
+		}
+		appconfigClient, err := getAppConfigClient(meta, parts[0])
+		if err != nil {
+			return err
+		}
+
+		options := &appconfigurationv1.UpdateSegmentOptions{}
+		options.SetSegmentID(parts[1])
+		options.SetName(d.Get("name").(string))
+		options.SetDescription(d.Get("description").(string))
+		options.SetTags(d.Get("tags").(string))
+		options.SetRules(resourceIbmAppConfigSegmentMapToRules(d.Get("rules").([]interface{})))
+
+		_, response, err := appconfigClient.UpdateSegment(options)
+		if err != nil {
+			return fmt.Errorf("[DEBUG] UpdateSegment failed %s\n%s", err, response)
+		}
+		return resourceIbmAppConfigSegmentRead(d, meta)
+	}
+	return nil
+}
+
+func resourceIbmAppConfigSegmentRead(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil
+	}
+	appconfigClient, err := getAppConfigClient(meta, parts[0])
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.GetSegmentOptions{}
+	options.SetSegmentID(parts[1])
+
+	result, response, err := appconfigClient.GetSegment(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[DEBUG] GetSegment failed %s\n%s", err, response)
+	}
+
+	d.Set("guid", parts[0])
+	if result.Name != nil {
+		if err = d.Set("name", result.Name); err != nil {
+			return fmt.Errorf("[ERROR] Error setting name: %s", err)
+		}
+	}
+	if result.SegmentID != nil {
+		if err = d.Set("segment_id", result.SegmentID); err != nil {
+			return fmt.Errorf("[ERROR] Error setting segment_id: %s", err)
+		}
+	}
+	if result.Description != nil {
+		if err = d.Set("description", result.Description); err != nil {
+			return fmt.Errorf("[ERROR] Error setting description: %s", err)
+		}
+	}
+	if result.Tags != nil {
+		if err = d.Set("tags", result.Tags); err != nil {
+			return fmt.Errorf("[ERROR] Error setting tags: %s", err)
+		}
+	}
+	if result.Rules != nil {
+		if err = d.Set("rules", resourceIbmAppConfigSegmentRulesToMap(result.Rules)); err != nil {
+			return fmt.Errorf("[ERROR] Error setting rules: %s", err)
+		}
+	}
+	if result.CreatedTime != nil {
+		if err = d.Set("created_time", result.CreatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting created_time: %s", err)
+		}
+	}
+	if result.UpdatedTime != nil {
+		if err = d.Set("updated_time", result.UpdatedTime.String()); err != nil {
+			return fmt.Errorf("[ERROR] Error setting updated_time: %s", err)
+		}
+	}
+	if result.Href != nil {
+		if err = d.Set("href", result.Href); err != nil {
+			return fmt.Errorf("[ERROR] Error setting href: %s", err)
+		}
+	}
+	return nil
+}
+
+func resourceIbmAppConfigSegmentDelete(d *schema.ResourceData, meta interface{}) error {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return nil
+	}
+	appconfigClient, err := getAppConfigClient(meta, parts[0])
+	if err != nil {
+		return err
+	}
+
+	options := &appconfigurationv1.DeleteSegmentOptions{}
+	options.SetSegmentID(parts[1])
+
+	response, err := appconfigClient.DeleteSegment(options)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[DEBUG] DeleteSegment failed %s\n%s", err, response)
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func ResourceIBMAppConfigSegmentValidator() *validate.ResourceValidator {
+	validateSchema := make([]validate.ValidateSchema, 0)
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "operator",
+			ValidateFunctionIdentifier: validate.ValidateAllowedStringValue,
+			Type:                       validate.TypeString,
+			Required:                   true,
+			AllowedValues:              "is, contains, startsWith, endsWith, greaterThan, lesserThan, greaterThanEquals, lesserThanEquals",
+		},
+	)
+
+	resourceValidator := validate.ResourceValidator{
+		ResourceName: "ibm_app_config_segment",
+		Schema:       validateSchema,
+	}
+	return &resourceValidator
+}
+
+// output
+func resourceIbmAppConfigSegmentRulesToMap(rules []appconfigurationv1.Rule) []map[string]interface{} {
+	rulesList := []map[string]interface{}{}
+	for _, rule := range rules {
+		ruleMap := map[string]interface{}{}
+		ruleMap["attribute_name"] = rule.AttributeName
+		ruleMap["operator"] = rule.Operator
+		ruleMap["values"] = rule.Values
+		rulesList = append(rulesList, ruleMap)
+	}
+	return rulesList
+}
+
+// input
+func resourceIbmAppConfigSegmentMapToRules(rulesList []interface{}) []appconfigurationv1.Rule {
+	rules := []appconfigurationv1.Rule{}
+	for _, rulesItem := range rulesList {
+		ruleMap := rulesItem.(map[string]interface{})
+		rule := appconfigurationv1.Rule{}
+		rule.AttributeName = core.StringPtr(ruleMap["attribute_name"].(string))
+		rule.Operator = core.StringPtr(ruleMap["operator"].(string))
+		rule.Values = flex.ExpandStringList(ruleMap["values"].([]interface{}))
+		rules = append(rules, rule)
+	}
+	return rules
+}
@@ -0,0 +1,143 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package appconfiguration_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/appconfiguration-go-admin-sdk/appconfigurationv1"
+)
+
+func TestAccIbmAppConfigSegmentBasic(t *testing.T) {
+	var conf appconfigurationv1.Segment
+	instanceName := fmt.Sprintf("tf_app_config_test_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	segmentID := fmt.Sprintf("tf_segment_id_%d", acctest.RandIntRange(10, 100))
+	description := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+	nameUpdate := fmt.Sprintf("tf_name_%d", acctest.RandIntRange(10, 100))
+	descriptionUpdate := fmt.Sprintf("tf_description_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmAppConfigSegmentDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIbmAppConfigSegmentConfigBasic(instanceName, name, segmentID, description, "endsWith"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmAppConfigSegmentExists("ibm_app_config_segment.app_config_segment_resource1", conf),
+					resource.TestCheckResourceAttrSet("ibm_app_config_segment.app_config_segment_resource1", "id"),
+					resource.TestCheckResourceAttr("ibm_app_config_segment.app_config_segment_resource1", "segment_id", segmentID),
+					resource.TestCheckResourceAttr("ibm_app_config_segment.app_config_segment_resource1", "name", name),
+					resource.TestCheckResourceAttr("ibm_app_config_segment.app_config_segment_resource1", "rules.#", "1"),
+					resource.TestCheckResourceAttr("ibm_app_config_segment.app_config_segment_resource1", "rules.0.operator", "endsWith"),
+					resource.TestCheckResourceAttr("ibm_app_config_segment.app_config_segment_resource1", "rules.0.values.#", "2"),
+					resource.TestCheckResourceAttrSet("ibm_app_config_segment.app_config_segment_resource1", "created_time"),
+					resource.TestCheckResourceAttrSet("ibm_app_config_segment.app_config_segment_resource1", "href"),
+				),
+			},
+			{
+				Config: testAccCheckIbmAppConfigSegmentConfigBasic(instanceName, nameUpdate, segmentID, descriptionUpdate, "contains"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_app_config_segment.app_config_segment_resource1", "name", nameUpdate),
+					resource.TestCheckResourceAttr("ibm_app_config_segment.app_config_segment_resource1", "description", descriptionUpdate),
+					resource.TestCheckResourceAttr("ibm_app_config_segment.app_config_segment_resource1", "rules.0.operator", "contains"),
+				),
+			},
+			{
+				ResourceName:      "ibm_app_config_segment.app_config_segment_resource1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmAppConfigSegmentConfigBasic(instanceName, name, segmentID, description, operator string) string {
+	return fmt.Sprintf(`
+		resource "ibm_resource_instance" "app_config_terraform_test460" {
+			name     = "%s"
+			location = "us-south"
+			service  = "apprapp"
+			plan     = "standard"
+		}
+		resource "ibm_app_config_segment" "app_config_segment_resource1" {
+			guid        = ibm_resource_instance.app_config_terraform_test460.guid
+			name        = "%s"
+			segment_id  = "%s"
+			description = "%s"
+			tags        = "development segment"
+			rules {
+				attribute_name = "email"
+				operator       = "%s"
+				values         = ["@in.ibm.com", "@us.ibm.com"]
+			}
+		}`, instanceName, name, segmentID, description, operator)
+}
+
+func testAccCheckIbmAppConfigSegmentExists(n string, obj appconfigurationv1.Segment) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		appconfigClient, err := getAppConfigClient(acc.TestAccProvider.Meta(), parts[0])
+		if err != nil {
+			return err
+		}
+
+		options := &appconfigurationv1.GetSegmentOptions{}
+		options.SetSegmentID(parts[1])
+
+		result, _, err := appconfigClient.GetSegment(options)
+		if err != nil {
+			return err
+		}
+
+		obj = *result
+		return nil
+	}
+}
+
+func testAccCheckIbmAppConfigSegmentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_app_config_segment" {
+			continue
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		appconfigClient, err := getAppConfigClient(acc.TestAccProvider.Meta(), parts[0])
+		if err != nil {
+			return err
+		}
+		options := &appconfigurationv1.GetSegmentOptions{}
+		options.SetSegmentID(parts[1])
+
+		// Try to find the key
+		_, response, err := appconfigClient.GetSegment(options)
+
+		if err == nil {
+			return fmt.Errorf("Segment still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error checking for Segment (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
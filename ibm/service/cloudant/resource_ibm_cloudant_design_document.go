@@ -0,0 +1,347 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantDesignDocument() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantDesignDocumentCreate,
+		ReadContext:   resourceIBMCloudantDesignDocumentRead,
+		UpdateContext: resourceIBMCloudantDesignDocumentUpdate,
+		DeleteContext: resourceIBMCloudantDesignDocumentDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path parameter to specify the database name.",
+			},
+			"ddoc": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path parameter to specify the design document name. The design document name is the design document ID excluding the `_design/` prefix.",
+			},
+			"partitioned": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Computed:    true,
+				Description: "Whether the design document is partitioned. Only applicable to the design documents of a partitioned database.",
+			},
+			"views": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				StateFunc:    normalizeCloudantDesignDocumentJSON,
+				ValidateFunc: validateCloudantDesignDocumentViews,
+				Description:  "The MapReduce views of the design document in JSON format, e.g. {\"by_name\": {\"map\": \"function (doc) { emit(doc.name, null); }\", \"reduce\": \"_count\"}}.",
+			},
+			"indexes": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				StateFunc:    normalizeCloudantDesignDocumentJSON,
+				ValidateFunc: validateCloudantDesignDocumentIndexes,
+				Description:  "The search indexes of the design document in JSON format, e.g. {\"by_name\": {\"index\": \"function (doc) { index(\\\"name\\\", doc.name); }\"}}.",
+			},
+			"validate_doc_update": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The JavaScript function that validates the document updates of the database.",
+			},
+			"rev": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The revision of the design document.",
+			},
+		},
+	}
+}
+
+func normalizeCloudantDesignDocumentJSON(v interface{}) string {
+	json, err := flex.NormalizeJSONString(v)
+	if err != nil {
+		return fmt.Sprintf("%q", err.Error())
+	}
+	return json
+}
+
+// FlattenCloudantDesignDocumentJSON marshals the views or indexes of a design
+// document in the normalized form of the state, the field order of the
+// service models differs from the sorted keys of the configuration.
+func FlattenCloudantDesignDocumentJSON(v interface{}) (string, error) {
+	b, err := json.Marshal(v)
+	if err != nil {
+		return "", err
+	}
+	return flex.NormalizeJSONString(string(b))
+}
+
+// decodeCloudantDesignDocumentJSON decodes the JSON of a design document
+// attribute. Unknown fields are rejected as they would be dropped by the
+// service models and cause a permanent diff.
+func decodeCloudantDesignDocumentJSON(v string, result interface{}) error {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(v)))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode(result)
+}
+
+func validateCloudantDesignDocumentViews(v interface{}, k string) (ws []string, errors []error) {
+	views := map[string]cloudantv1.DesignDocumentViewsMapReduce{}
+	if err := decodeCloudantDesignDocumentJSON(v.(string), &views); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+	for name, view := range views {
+		if view.Map == nil {
+			errors = append(errors, fmt.Errorf("%q view %s is missing the map function", k, name))
+		}
+	}
+	return
+}
+
+func validateCloudantDesignDocumentIndexes(v interface{}, k string) (ws []string, errors []error) {
+	indexes := map[string]cloudantv1.SearchIndexDefinition{}
+	if err := decodeCloudantDesignDocumentJSON(v.(string), &indexes); err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+		return
+	}
+	for name, index := range indexes {
+		if index.Index == nil {
+			errors = append(errors, fmt.Errorf("%q index %s is missing the index function", k, name))
+		}
+	}
+	return
+}
+
+func resourceIBMCloudantDesignDocumentCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dbName := d.Get("db").(string)
+	ddoc := d.Get("ddoc").(string)
+	designDocument, err := expandCloudantDesignDocument(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	putDesignDocumentOptions := cloudantClient.NewPutDesignDocumentOptions(dbName, ddoc, designDocument)
+
+	_, response, err := cloudantClient.PutDesignDocumentWithContext(context, putDesignDocumentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutDesignDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutDesignDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", instanceCRN, dbName, ddoc))
+
+	return resourceIBMCloudantDesignDocumentRead(context, d, meta)
+}
+
+func resourceIBMCloudantDesignDocumentRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, err := getCloudantDesignDocumentIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getDesignDocumentOptions := cloudantClient.NewGetDesignDocumentOptions(dbName, ddoc)
+
+	designDocument, response, err := cloudantClient.GetDesignDocumentWithContext(context, getDesignDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetDesignDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetDesignDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", dbName)
+	d.Set("ddoc", ddoc)
+
+	// The options are only stored when set explicitly, otherwise the design
+	// document follows the partitioning of the database.
+	if designDocument.Options != nil && designDocument.Options.Partitioned != nil {
+		if err = d.Set("partitioned", *designDocument.Options.Partitioned); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting partitioned: %s", err))
+		}
+	}
+
+	views := ""
+	if len(designDocument.Views) > 0 {
+		views, err = FlattenCloudantDesignDocumentJSON(designDocument.Views)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set("views", views); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting views: %s", err))
+	}
+
+	indexes := ""
+	if len(designDocument.Indexes) > 0 {
+		indexes, err = FlattenCloudantDesignDocumentJSON(designDocument.Indexes)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+	if err = d.Set("indexes", indexes); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting indexes: %s", err))
+	}
+
+	if err = d.Set("validate_doc_update", designDocument.ValidateDocUpdate); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting validate_doc_update: %s", err))
+	}
+
+	if err = d.Set("rev", designDocument.Rev); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rev: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantDesignDocumentUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, err := getCloudantDesignDocumentIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	designDocument, err := expandCloudantDesignDocument(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	designDocument.Rev = flex.PtrToString(d.Get("rev").(string))
+
+	putDesignDocumentOptions := cloudantClient.NewPutDesignDocumentOptions(dbName, ddoc, designDocument)
+
+	_, response, err := cloudantClient.PutDesignDocumentWithContext(context, putDesignDocumentOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutDesignDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutDesignDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	return resourceIBMCloudantDesignDocumentRead(context, d, meta)
+}
+
+func resourceIBMCloudantDesignDocumentDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN, dbName, ddoc, err := getCloudantDesignDocumentIDParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteDesignDocumentOptions := cloudantClient.NewDeleteDesignDocumentOptions(dbName, ddoc)
+	deleteDesignDocumentOptions.SetRev(d.Get("rev").(string))
+
+	_, response, err := cloudantClient.DeleteDesignDocumentWithContext(context, deleteDesignDocumentOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteDesignDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteDesignDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandCloudantDesignDocument(d *schema.ResourceData) (*cloudantv1.DesignDocument, error) {
+	designDocument := &cloudantv1.DesignDocument{}
+
+	if partitioned, ok := d.GetOkExists("partitioned"); ok {
+		designDocument.Options = &cloudantv1.DesignDocumentOptions{
+			Partitioned: core.BoolPtr(partitioned.(bool)),
+		}
+	}
+	if views, ok := d.GetOk("views"); ok {
+		designDocument.Views = map[string]cloudantv1.DesignDocumentViewsMapReduce{}
+		if err := decodeCloudantDesignDocumentJSON(views.(string), &designDocument.Views); err != nil {
+			return nil, fmt.Errorf("Error parsing views: %s", err)
+		}
+	}
+	if indexes, ok := d.GetOk("indexes"); ok {
+		designDocument.Indexes = map[string]cloudantv1.SearchIndexDefinition{}
+		if err := decodeCloudantDesignDocumentJSON(indexes.(string), &designDocument.Indexes); err != nil {
+			return nil, fmt.Errorf("Error parsing indexes: %s", err)
+		}
+	}
+	if validateDocUpdate, ok := d.GetOk("validate_doc_update"); ok {
+		designDocument.ValidateDocUpdate = flex.PtrToString(validateDocUpdate.(string))
+	}
+
+	return designDocument, nil
+}
+
+// getCloudantDesignDocumentIDParts splits the ID <instance_crn>/<db>/<ddoc>,
+// the instance CRN contains a slash itself.
+func getCloudantDesignDocumentIDParts(id string) (string, string, string, error) {
+	parts, err := flex.IdParts(id)
+	if err != nil {
+		return "", "", "", err
+	}
+	if len(parts) < 3 {
+		return "", "", "", fmt.Errorf("Incorrect ID %s: ID should be a combination of instance_crn/db/ddoc", id)
+	}
+	return strings.Join(parts[:len(parts)-2], "/"), parts[len(parts)-2], parts[len(parts)-1], nil
+}
@@ -0,0 +1,254 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cloudant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestAccIBMCloudantDesignDocumentBasic(t *testing.T) {
+	var conf cloudantv1.DesignDocument
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+	ddoc := fmt.Sprintf("tf_ddoc_%d", acctest.RandIntRange(10, 100))
+	views := `{"by_name": {"map": "function (doc) { emit(doc.name, null); }"}}`
+	viewsUpdate := `{"by_name": {"map": "function (doc) { emit(doc.name, null); }", "reduce": "_count"}}`
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantDesignDocumentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDesignDocumentConfigBasic(instanceName, db, ddoc, views),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCloudantDesignDocumentExists("ibm_cloudant_design_document.cloudant_design_document", conf),
+					resource.TestCheckResourceAttr("ibm_cloudant_design_document.cloudant_design_document", "ddoc", ddoc),
+					resource.TestCheckResourceAttrSet("ibm_cloudant_design_document.cloudant_design_document", "views"),
+					resource.TestCheckResourceAttrSet("ibm_cloudant_design_document.cloudant_design_document", "rev"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDesignDocumentConfigBasic(instanceName, db, ddoc, viewsUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_design_document.cloudant_design_document", "ddoc", ddoc),
+					resource.TestCheckResourceAttrSet("ibm_cloudant_design_document.cloudant_design_document", "views"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCloudantDesignDocumentAllArgs(t *testing.T) {
+	var conf cloudantv1.DesignDocument
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+	ddoc := fmt.Sprintf("tf_ddoc_%d", acctest.RandIntRange(10, 100))
+	validateDocUpdate := "function (newDoc, oldDoc, userCtx) { if (!newDoc.name) { throw({forbidden: 'name is required'}); } }"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantDesignDocumentDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantDesignDocumentConfig(instanceName, db, ddoc, validateDocUpdate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCloudantDesignDocumentExists("ibm_cloudant_design_document.cloudant_design_document", conf),
+					resource.TestCheckResourceAttr("ibm_cloudant_design_document.cloudant_design_document", "ddoc", ddoc),
+					resource.TestCheckResourceAttr("ibm_cloudant_design_document.cloudant_design_document", "partitioned", "false"),
+					resource.TestCheckResourceAttrSet("ibm_cloudant_design_document.cloudant_design_document", "views"),
+					resource.TestCheckResourceAttrSet("ibm_cloudant_design_document.cloudant_design_document", "indexes"),
+					resource.TestCheckResourceAttr("ibm_cloudant_design_document.cloudant_design_document", "validate_doc_update", validateDocUpdate),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cloudant_design_document.cloudant_design_document",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantDesignDocumentConfigBasic(instanceName, db, ddoc, views string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+		}
+
+		resource "ibm_cloudant_design_document" "cloudant_design_document" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = ibm_cloudant_database.cloudant_database.db
+			ddoc = "%s"
+			views = jsonencode(%s)
+		}
+	`, instanceName, db, ddoc, views)
+}
+
+func testAccCheckIBMCloudantDesignDocumentConfig(instanceName, db, ddoc, validateDocUpdate string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+			partitioned = true
+		}
+
+		resource "ibm_cloudant_design_document" "cloudant_design_document" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = ibm_cloudant_database.cloudant_database.db
+			ddoc = "%s"
+			partitioned = false
+			views = jsonencode({
+				by_name = {
+					map = "function (doc) { emit(doc.name, null); }"
+					reduce = "_count"
+				}
+			})
+			indexes = jsonencode({
+				by_name = {
+					index = "function (doc) { index(\"name\", doc.name); }"
+					analyzer = {
+						name = "standard"
+					}
+				}
+			})
+			validate_doc_update = "%s"
+		}
+	`, instanceName, db, ddoc, validateDocUpdate)
+}
+
+func testAccCheckIBMCloudantDesignDocumentExists(n string, obj cloudantv1.DesignDocument) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		instanceCRN := rs.Primary.Attributes["instance_crn"]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getDesignDocumentOptions := cloudantClient.NewGetDesignDocumentOptions(rs.Primary.Attributes["db"], rs.Primary.Attributes["ddoc"])
+
+		designDocument, _, err := cloudantClient.GetDesignDocument(getDesignDocumentOptions)
+		if err != nil {
+			return err
+		}
+
+		obj = *designDocument
+		return nil
+	}
+}
+
+func testAccCheckIBMCloudantDesignDocumentDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cloudant_design_document" {
+			continue
+		}
+
+		instanceCRN := rs.Primary.Attributes["instance_crn"]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getDesignDocumentOptions := cloudantClient.NewGetDesignDocumentOptions(rs.Primary.Attributes["db"], rs.Primary.Attributes["ddoc"])
+
+		// Try to find the key
+		_, _, err = cloudantClient.GetDesignDocument(getDesignDocumentOptions)
+		if err == nil {
+			return fmt.Errorf("cloudant_design_document still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestFlattenCloudantDesignDocumentJSON(t *testing.T) {
+	config := `{
+		"by_name": {
+			"index": "function (doc) { index(\"name\", doc.name); }",
+			"analyzer": {
+				"stopwords": ["the"],
+				"name": "perfield",
+				"fields": {"name": {"name": "keyword"}}
+			}
+		}
+	}`
+	indexes := map[string]cloudantv1.SearchIndexDefinition{
+		"by_name": {
+			Index: core.StringPtr("function (doc) { index(\"name\", doc.name); }"),
+			Analyzer: &cloudantv1.AnalyzerConfiguration{
+				Name:      core.StringPtr("perfield"),
+				Stopwords: []string{"the"},
+				Fields: map[string]cloudantv1.Analyzer{
+					"name": {Name: core.StringPtr("keyword")},
+				},
+			},
+		},
+	}
+
+	expected, err := flex.NormalizeJSONString(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := cloudant.FlattenCloudantDesignDocumentJSON(indexes)
+	if err != nil {
+		t.Fatalf("FlattenCloudantDesignDocumentJSON returned an error: %s", err)
+	}
+	if got != expected {
+		t.Errorf("FlattenCloudantDesignDocumentJSON = %s, want %s", got, expected)
+	}
+}
@@ -0,0 +1,420 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMCloudantReplication() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantReplicationCreate,
+		ReadContext:   resourceIBMCloudantReplicationRead,
+		DeleteContext: resourceIBMCloudantReplicationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN. The replication document is stored in the `_replicator` database of this instance.",
+			},
+			"replication_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path parameter to specify the document ID of the replication document.",
+			},
+			"source": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The source database of the replication.",
+				Elem:        resourceIBMCloudantReplicationDatabase(),
+			},
+			"target": &schema.Schema{
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Description: "The target database of the replication.",
+				Elem:        resourceIBMCloudantReplicationDatabase(),
+			},
+			"continuous": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Configure the replication to be continuous.",
+			},
+			"create_target": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Creates the target database.",
+			},
+			"doc_ids": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The IDs of the documents to replicate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"filter": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of a filter function which is defined in a design document in the source database in {ddoc_id}/{filter} format.",
+			},
+			"selector": &schema.Schema{
+				Type:     schema.TypeString,
+				Optional: true,
+				ForceNew: true,
+				StateFunc: func(v interface{}) string {
+					json, err := flex.NormalizeJSONString(v)
+					if err != nil {
+						return fmt.Sprintf("%q", err.Error())
+					}
+					return json
+				},
+				ValidateFunc: func(v interface{}, k string) (ws []string, errors []error) {
+					selector := map[string]interface{}{}
+					if err := json.Unmarshal([]byte(v.(string)), &selector); err != nil {
+						errors = append(errors, fmt.Errorf("%q contains an invalid JSON: %s", k, err))
+					}
+					return
+				},
+				Description: "The JSON selector, in Cloudant Query syntax, which filters the documents to replicate.",
+			},
+			"rev": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The revision of the replication document.",
+			},
+			"state": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the replication job, e.g. initializing, running, pending, crashing, error, failed or completed.",
+			},
+			"error": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The last error of the replication job.",
+			},
+			"error_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of consecutive errors of the replication job.",
+			},
+			"docs_read": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of documents read from the source.",
+			},
+			"docs_written": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of documents written to the target.",
+			},
+			"doc_write_failures": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of documents that failed to be written to the target.",
+			},
+			"changes_pending": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of source changes not yet replicated.",
+			},
+			"last_updated": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time the state of the replication job was last updated.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantReplicationDatabase() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"url": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The URL of the database, e.g. https://<account>.cloudantnosqldb.appdomain.cloud/<db>.",
+			},
+			"iam_api_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The IAM API key used to authenticate with the database.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantReplicationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	source := expandCloudantReplicationDatabase(d.Get("source").([]interface{}))
+	target := expandCloudantReplicationDatabase(d.Get("target").([]interface{}))
+
+	replicationDocument, err := cloudantClient.NewReplicationDocument(source, target)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	replicationDocument.Continuous = core.BoolPtr(d.Get("continuous").(bool))
+	replicationDocument.CreateTarget = core.BoolPtr(d.Get("create_target").(bool))
+	if docIds, ok := d.GetOk("doc_ids"); ok {
+		replicationDocument.DocIds = flex.ExpandStringList(docIds.(*schema.Set).List())
+	}
+	if filter, ok := d.GetOk("filter"); ok {
+		replicationDocument.Filter = core.StringPtr(filter.(string))
+	}
+	if selector, ok := d.GetOk("selector"); ok {
+		if err = json.Unmarshal([]byte(selector.(string)), &replicationDocument.Selector); err != nil {
+			return diag.FromErr(fmt.Errorf("Error parsing selector: %s", err))
+		}
+	}
+
+	replicationID := d.Get("replication_id").(string)
+	putReplicationDocumentOptions := cloudantClient.NewPutReplicationDocumentOptions(replicationID, replicationDocument)
+
+	_, response, err := cloudantClient.PutReplicationDocumentWithContext(context, putReplicationDocumentOptions)
+	if err != nil && response != nil && response.StatusCode == 404 {
+		// A new instance has no _replicator database until it is first used.
+		putDatabaseOptions := cloudantClient.NewPutDatabaseOptions("_replicator")
+		_, response, err = cloudantClient.PutDatabaseWithContext(context, putDatabaseOptions)
+		if err != nil && (response == nil || response.StatusCode != 412) {
+			log.Printf("[DEBUG] PutDatabaseWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("PutDatabaseWithContext failed %s\n%s", err, response))
+		}
+		_, response, err = cloudantClient.PutReplicationDocumentWithContext(context, putReplicationDocumentOptions)
+	}
+	if err != nil {
+		log.Printf("[DEBUG] PutReplicationDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutReplicationDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, replicationID))
+
+	return resourceIBMCloudantReplicationRead(context, d, meta)
+}
+
+func resourceIBMCloudantReplicationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceCRN, replicationID := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getReplicationDocumentOptions := cloudantClient.NewGetReplicationDocumentOptions(replicationID)
+
+	replicationDocument, response, err := cloudantClient.GetReplicationDocumentWithContext(context, getReplicationDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetReplicationDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetReplicationDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("replication_id", replicationID)
+
+	if err = d.Set("source", FlattenCloudantReplicationDatabase(replicationDocument.Source, d.Get("source").([]interface{}))); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting source: %s", err))
+	}
+	if err = d.Set("target", FlattenCloudantReplicationDatabase(replicationDocument.Target, d.Get("target").([]interface{}))); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting target: %s", err))
+	}
+	if err = d.Set("continuous", replicationDocument.Continuous != nil && *replicationDocument.Continuous); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting continuous: %s", err))
+	}
+	if err = d.Set("create_target", replicationDocument.CreateTarget != nil && *replicationDocument.CreateTarget); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting create_target: %s", err))
+	}
+	if err = d.Set("doc_ids", replicationDocument.DocIds); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting doc_ids: %s", err))
+	}
+	if err = d.Set("filter", replicationDocument.Filter); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting filter: %s", err))
+	}
+	selector := ""
+	if len(replicationDocument.Selector) > 0 {
+		b, err := json.Marshal(replicationDocument.Selector)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		selector = string(b)
+	}
+	if err = d.Set("selector", selector); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting selector: %s", err))
+	}
+	if err = d.Set("rev", replicationDocument.Rev); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rev: %s", err))
+	}
+
+	getSchedulerDocumentOptions := cloudantClient.NewGetSchedulerDocumentOptions(replicationID)
+
+	schedulerDocument, response, err := cloudantClient.GetSchedulerDocumentWithContext(context, getSchedulerDocumentOptions)
+	if err != nil {
+		// The scheduler only knows the replication job once the replicator
+		// has picked up the document.
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Replication job %s is not scheduled yet", replicationID)
+			return nil
+		}
+		log.Printf("[DEBUG] GetSchedulerDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSchedulerDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	if err = d.Set("state", schedulerDocument.State); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting state: %s", err))
+	}
+	if err = d.Set("error_count", schedulerDocument.ErrorCount); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting error_count: %s", err))
+	}
+	if schedulerDocument.LastUpdated != nil {
+		if err = d.Set("last_updated", schedulerDocument.LastUpdated.String()); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting last_updated: %s", err))
+		}
+	}
+	if info := schedulerDocument.Info; info != nil {
+		if err = d.Set("error", info.Error); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting error: %s", err))
+		}
+		if err = d.Set("docs_read", info.DocsRead); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting docs_read: %s", err))
+		}
+		if err = d.Set("docs_written", info.DocsWritten); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting docs_written: %s", err))
+		}
+		if err = d.Set("doc_write_failures", info.DocWriteFailures); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting doc_write_failures: %s", err))
+		}
+		if err = d.Set("changes_pending", info.ChangesPending); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting changes_pending: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantReplicationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceCRN, replicationID := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The replicator updates the document once a job completes or fails,
+	// so the revision in the state may be outdated.
+	getReplicationDocumentOptions := cloudantClient.NewGetReplicationDocumentOptions(replicationID)
+
+	replicationDocument, response, err := cloudantClient.GetReplicationDocumentWithContext(context, getReplicationDocumentOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetReplicationDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetReplicationDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	deleteReplicationDocumentOptions := cloudantClient.NewDeleteReplicationDocumentOptions(replicationID)
+	deleteReplicationDocumentOptions.Rev = replicationDocument.Rev
+
+	_, response, err = cloudantClient.DeleteReplicationDocumentWithContext(context, deleteReplicationDocumentOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteReplicationDocumentWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteReplicationDocumentWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandCloudantReplicationDatabase(l []interface{}) *cloudantv1.ReplicationDatabase {
+	m := l[0].(map[string]interface{})
+	replicationDatabase := &cloudantv1.ReplicationDatabase{
+		URL: core.StringPtr(m["url"].(string)),
+	}
+	if apiKey, ok := m["iam_api_key"]; ok && apiKey.(string) != "" {
+		iam := &cloudantv1.ReplicationDatabaseAuthIam{
+			ApiKey: core.StringPtr(apiKey.(string)),
+		}
+		replicationDatabase.Auth = &cloudantv1.ReplicationDatabaseAuth{Iam: iam}
+	}
+	return replicationDatabase
+}
+
+// FlattenCloudantReplicationDatabase keeps the API key of the configuration,
+// the replication document only provides it when the resource is imported.
+func FlattenCloudantReplicationDatabase(replicationDatabase *cloudantv1.ReplicationDatabase, current []interface{}) []interface{} {
+	if replicationDatabase == nil || replicationDatabase.URL == nil {
+		return []interface{}{}
+	}
+	apiKey := ""
+	if len(current) > 0 && current[0] != nil {
+		apiKey = current[0].(map[string]interface{})["iam_api_key"].(string)
+	}
+	if apiKey == "" && replicationDatabase.Auth != nil && replicationDatabase.Auth.Iam != nil && replicationDatabase.Auth.Iam.ApiKey != nil {
+		apiKey = *replicationDatabase.Auth.Iam.ApiKey
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"url":         *replicationDatabase.URL,
+			"iam_api_key": apiKey,
+		},
+	}
+}
@@ -0,0 +1,187 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cloudant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+func TestAccIBMCloudantReplicationBasic(t *testing.T) {
+	var conf cloudantv1.ReplicationDocument
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+	replicationID := fmt.Sprintf("tf_replication_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantReplicationDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantReplicationConfigBasic(instanceName, db, replicationID, "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCloudantReplicationExists("ibm_cloudant_replication.cloudant_replication", conf),
+					resource.TestCheckResourceAttr("ibm_cloudant_replication.cloudant_replication", "replication_id", replicationID),
+					resource.TestCheckResourceAttr("ibm_cloudant_replication.cloudant_replication", "continuous", "false"),
+					resource.TestCheckResourceAttr("ibm_cloudant_replication.cloudant_replication", "create_target", "true"),
+					resource.TestCheckResourceAttrSet("ibm_cloudant_replication.cloudant_replication", "rev"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantReplicationConfigBasic(instanceName, db, replicationID, "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_replication.cloudant_replication", "continuous", "true"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cloudant_replication.cloudant_replication",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"state", "error", "error_count", "docs_read", "docs_written", "doc_write_failures", "changes_pending", "last_updated"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantReplicationConfigBasic(instanceName, db, replicationID, continuous string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_resource_key" "cloudant_key" {
+			name                 = "%s"
+			role                 = "Manager"
+			resource_instance_id = ibm_cloudant.cloudant_instance.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+		}
+
+		resource "ibm_cloudant_replication" "cloudant_replication" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			replication_id = "%s"
+			source {
+				url = "https://${ibm_cloudant.cloudant_instance.extensions["endpoints.public"]}/${ibm_cloudant_database.cloudant_database.db}"
+				iam_api_key = ibm_resource_key.cloudant_key.credentials["apikey"]
+			}
+			target {
+				url = "https://${ibm_cloudant.cloudant_instance.extensions["endpoints.public"]}/${ibm_cloudant_database.cloudant_database.db}_backup"
+				iam_api_key = ibm_resource_key.cloudant_key.credentials["apikey"]
+			}
+			create_target = true
+			continuous = %s
+			selector = jsonencode({
+				type = "order"
+			})
+		}
+	`, instanceName, instanceName, db, replicationID, continuous)
+}
+
+func testAccCheckIBMCloudantReplicationExists(n string, obj cloudantv1.ReplicationDocument) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		instanceCRN := rs.Primary.Attributes["instance_crn"]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getReplicationDocumentOptions := cloudantClient.NewGetReplicationDocumentOptions(rs.Primary.Attributes["replication_id"])
+
+		replicationDocument, _, err := cloudantClient.GetReplicationDocument(getReplicationDocumentOptions)
+		if err != nil {
+			return err
+		}
+
+		obj = *replicationDocument
+		return nil
+	}
+}
+
+func testAccCheckIBMCloudantReplicationDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cloudant_replication" {
+			continue
+		}
+
+		instanceCRN := rs.Primary.Attributes["instance_crn"]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getReplicationDocumentOptions := cloudantClient.NewGetReplicationDocumentOptions(rs.Primary.Attributes["replication_id"])
+
+		// Try to find the key
+		_, _, err = cloudantClient.GetReplicationDocument(getReplicationDocumentOptions)
+		if err == nil {
+			return fmt.Errorf("cloudant_replication still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestFlattenCloudantReplicationDatabase(t *testing.T) {
+	replicationDatabase := &cloudantv1.ReplicationDatabase{
+		URL: core.StringPtr("https://example.cloudant.com/source"),
+		Auth: &cloudantv1.ReplicationDatabaseAuth{
+			Iam: &cloudantv1.ReplicationDatabaseAuthIam{ApiKey: core.StringPtr("returned")},
+		},
+	}
+	current := []interface{}{map[string]interface{}{"url": "https://example.cloudant.com/source", "iam_api_key": "configured"}}
+
+	flattened := cloudant.FlattenCloudantReplicationDatabase(replicationDatabase, current)
+	if apiKey := flattened[0].(map[string]interface{})["iam_api_key"]; apiKey != "configured" {
+		t.Errorf("iam_api_key = %v, want the configured key", apiKey)
+	}
+
+	flattened = cloudant.FlattenCloudantReplicationDatabase(replicationDatabase, nil)
+	database := flattened[0].(map[string]interface{})
+	if database["iam_api_key"] != "returned" || database["url"] != "https://example.cloudant.com/source" {
+		t.Errorf("iam_api_key = %v, url = %v, want the returned key and url", database["iam_api_key"], database["url"])
+	}
+
+	if flattened := cloudant.FlattenCloudantReplicationDatabase(&cloudantv1.ReplicationDatabase{}, current); len(flattened) != 0 {
+		t.Errorf("FlattenCloudantReplicationDatabase without url = %v, want no database", flattened)
+	}
+}
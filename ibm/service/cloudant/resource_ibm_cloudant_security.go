@@ -0,0 +1,331 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+// cloudantLegacyRoles are the roles that can be granted to legacy
+// credentials and API keys in the cloudant section of the security document.
+var cloudantLegacyRoles = []string{"_reader", "_writer", "_admin", "_replicator", "_db_updates", "_design", "_shards", "_security"}
+
+func ResourceIBMCloudantSecurity() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCloudantSecurityCreate,
+		ReadContext:   resourceIBMCloudantSecurityRead,
+		UpdateContext: resourceIBMCloudantSecurityUpdate,
+		DeleteContext: resourceIBMCloudantSecurityDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMCloudantSecurityValidate(diff)
+			},
+		),
+
+		Schema: map[string]*schema.Schema{
+			"instance_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Cloudant Instance CRN.",
+			},
+			"db": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path parameter to specify the database name.",
+			},
+			"admins": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The users and roles with admin access to the database. Only evaluated when `couchdb_auth_only` is `true`.",
+				Elem:        resourceIBMCloudantSecurityObject(),
+			},
+			"members": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "The users and roles with member access to the database. Only evaluated when `couchdb_auth_only` is `true`.",
+				Elem:        resourceIBMCloudantSecurityObject(),
+			},
+			"cloudant": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "The database permissions of legacy credentials and API keys. Not applicable to IAM authentication, which is managed with IAM access policies.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": &schema.Schema{
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The legacy username or API key, use `nobody` for unauthenticated access.",
+						},
+						"roles": &schema.Schema{
+							Type:        schema.TypeSet,
+							Required:    true,
+							Description: "The roles granted on the database.",
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringInSlice(cloudantLegacyRoles, false),
+							},
+						},
+					},
+				},
+			},
+			"couchdb_auth_only": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Manage permissions using the `_users` database only, the `admins` and `members` roles are evaluated instead of the `cloudant` permissions.",
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantSecurityObject() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"names": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of usernames.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"roles": &schema.Schema{
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "List of roles.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+func resourceIBMCloudantSecurityValidate(diff *schema.ResourceDiff) error {
+	if diff.Get("couchdb_auth_only").(bool) && diff.Get("cloudant").(*schema.Set).Len() > 0 {
+		return fmt.Errorf("[ERROR] cloudant permissions are ignored when couchdb_auth_only is true, use admins and members instead")
+	}
+	return nil
+}
+
+func resourceIBMCloudantSecurityCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceCRN := d.Get("instance_crn").(string)
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	dbName := d.Get("db").(string)
+	putSecurityOptions := expandCloudantSecurity(cloudantClient, dbName, d)
+
+	_, response, err := cloudantClient.PutSecurityWithContext(context, putSecurityOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutSecurityWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutSecurityWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", instanceCRN, dbName))
+
+	return resourceIBMCloudantSecurityRead(context, d, meta)
+}
+
+func resourceIBMCloudantSecurityRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceCRN, dbName := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecurityOptions := cloudantClient.NewGetSecurityOptions(dbName)
+
+	security, response, err := cloudantClient.GetSecurityWithContext(context, getSecurityOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecurityWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecurityWithContext failed %s\n%s", err, response))
+	}
+
+	d.Set("instance_crn", instanceCRN)
+	d.Set("db", dbName)
+
+	if err = d.Set("admins", flattenCloudantSecurityObject(security.Admins)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting admins: %s", err))
+	}
+	if err = d.Set("members", flattenCloudantSecurityObject(security.Members)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting members: %s", err))
+	}
+	if err = d.Set("cloudant", FlattenCloudantSecurityCloudant(security.Cloudant)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting cloudant: %s", err))
+	}
+	if err = d.Set("couchdb_auth_only", security.CouchdbAuthOnly != nil && *security.CouchdbAuthOnly); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting couchdb_auth_only: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIBMCloudantSecurityUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceCRN, dbName := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The security document is always replaced as a whole.
+	putSecurityOptions := expandCloudantSecurity(cloudantClient, dbName, d)
+
+	_, response, err := cloudantClient.PutSecurityWithContext(context, putSecurityOptions)
+	if err != nil {
+		log.Printf("[DEBUG] PutSecurityWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutSecurityWithContext failed %s\n%s", err, response))
+	}
+
+	return resourceIBMCloudantSecurityRead(context, d, meta)
+}
+
+func resourceIBMCloudantSecurityDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	instanceCRN, dbName := strings.Join(parts[:len(parts)-1], "/"), parts[len(parts)-1]
+
+	cUrl, err := GetCloudantInstanceUrl(instanceCRN, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudantClient, err := GetCloudantClientForUrl(cUrl, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// A security document cannot be deleted, it is reset to the empty
+	// document a new database is created with.
+	putSecurityOptions := cloudantClient.NewPutSecurityOptions(dbName)
+
+	_, response, err := cloudantClient.PutSecurityWithContext(context, putSecurityOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] PutSecurityWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("PutSecurityWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func expandCloudantSecurity(cloudantClient *cloudantv1.CloudantV1, dbName string, d *schema.ResourceData) *cloudantv1.PutSecurityOptions {
+	putSecurityOptions := cloudantClient.NewPutSecurityOptions(dbName)
+
+	if admins, ok := d.GetOk("admins"); ok {
+		putSecurityOptions.SetAdmins(expandCloudantSecurityObject(admins.([]interface{})))
+	}
+	if members, ok := d.GetOk("members"); ok {
+		putSecurityOptions.SetMembers(expandCloudantSecurityObject(members.([]interface{})))
+	}
+	if permissions, ok := d.GetOk("cloudant"); ok {
+		cloudant := map[string][]string{}
+		for _, p := range permissions.(*schema.Set).List() {
+			permission := p.(map[string]interface{})
+			cloudant[permission["name"].(string)] = flex.ExpandStringList(permission["roles"].(*schema.Set).List())
+		}
+		putSecurityOptions.SetCloudant(cloudant)
+	}
+	putSecurityOptions.SetCouchdbAuthOnly(d.Get("couchdb_auth_only").(bool))
+
+	return putSecurityOptions
+}
+
+func expandCloudantSecurityObject(l []interface{}) *cloudantv1.SecurityObject {
+	securityObject := &cloudantv1.SecurityObject{
+		Names: []string{},
+		Roles: []string{},
+	}
+	if len(l) == 0 || l[0] == nil {
+		return securityObject
+	}
+	m := l[0].(map[string]interface{})
+	if names, ok := m["names"]; ok {
+		securityObject.Names = flex.ExpandStringList(names.(*schema.Set).List())
+	}
+	if roles, ok := m["roles"]; ok {
+		securityObject.Roles = flex.ExpandStringList(roles.(*schema.Set).List())
+	}
+	return securityObject
+}
+
+func flattenCloudantSecurityObject(securityObject *cloudantv1.SecurityObject) []interface{} {
+	if securityObject == nil || (len(securityObject.Names) == 0 && len(securityObject.Roles) == 0) {
+		return []interface{}{}
+	}
+	return []interface{}{
+		map[string]interface{}{
+			"names": securityObject.Names,
+			"roles": securityObject.Roles,
+		},
+	}
+}
+
+// FlattenCloudantSecurityCloudant flattens the IAM and legacy permissions
+// sorted by name.
+func FlattenCloudantSecurityCloudant(cloudant map[string][]string) []interface{} {
+	names := make([]string, 0, len(cloudant))
+	for name := range cloudant {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	permissions := make([]interface{}, 0, len(cloudant))
+	for _, name := range names {
+		permissions = append(permissions, map[string]interface{}{
+			"name":  name,
+			"roles": cloudant[name],
+		})
+	}
+	return permissions
+}
@@ -0,0 +1,222 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cloudant_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cloudant"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	"github.com/IBM/cloudant-go-sdk/cloudantv1"
+)
+
+func TestAccIBMCloudantSecurityBasic(t *testing.T) {
+	var conf cloudantv1.Security
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantSecurityDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantSecurityConfigBasic(instanceName, db, "_reader"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCloudantSecurityExists("ibm_cloudant_security.cloudant_security", conf),
+					resource.TestCheckResourceAttr("ibm_cloudant_security.cloudant_security", "db", db),
+					resource.TestCheckResourceAttr("ibm_cloudant_security.cloudant_security", "cloudant.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cloudant_security.cloudant_security", "couchdb_auth_only", "false"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantSecurityConfigBasic(instanceName, db, "_writer"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cloudant_security.cloudant_security", "cloudant.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMCloudantSecurityAllArgs(t *testing.T) {
+	var conf cloudantv1.Security
+	instanceName := fmt.Sprintf("tf_instance_%d", acctest.RandIntRange(10, 100))
+	db := fmt.Sprintf("tf_db_%d", acctest.RandIntRange(10, 100))
+	member := fmt.Sprintf("tf_member_%d", acctest.RandIntRange(10, 100))
+	admin := fmt.Sprintf("tf_admin_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCloudantSecurityDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCloudantSecurityConfig(instanceName, db, member, admin),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCloudantSecurityExists("ibm_cloudant_security.cloudant_security", conf),
+					resource.TestCheckResourceAttr("ibm_cloudant_security.cloudant_security", "db", db),
+					resource.TestCheckResourceAttr("ibm_cloudant_security.cloudant_security", "couchdb_auth_only", "true"),
+					resource.TestCheckResourceAttr("ibm_cloudant_security.cloudant_security", "members.0.names.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cloudant_security.cloudant_security", "admins.0.names.#", "1"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_cloudant_security.cloudant_security",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCloudantSecurityConfigBasic(instanceName, db, role string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+			legacy_credentials = true
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+		}
+
+		resource "ibm_cloudant_security" "cloudant_security" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = ibm_cloudant_database.cloudant_database.db
+			cloudant {
+				name = "nobody"
+				roles = ["%s"]
+			}
+		}
+	`, instanceName, db, role)
+}
+
+func testAccCheckIBMCloudantSecurityConfig(instanceName, db, member, admin string) string {
+	return fmt.Sprintf(`
+
+		data "ibm_resource_group" "cloudant" {
+			is_default=true
+		}
+
+		resource "ibm_cloudant" "cloudant_instance" {
+			name              = "%s"
+			plan              = "standard"
+			location          = "us-south"
+			resource_group_id = data.ibm_resource_group.cloudant.id
+		}
+
+		resource "ibm_cloudant_database" "cloudant_database" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = "%s"
+		}
+
+		resource "ibm_cloudant_security" "cloudant_security" {
+			instance_crn = ibm_cloudant.cloudant_instance.crn
+			db = ibm_cloudant_database.cloudant_database.db
+			couchdb_auth_only = true
+			members {
+				names = ["%s"]
+			}
+			admins {
+				names = ["%s"]
+				roles = ["_admin"]
+			}
+		}
+	`, instanceName, db, member, admin)
+}
+
+func testAccCheckIBMCloudantSecurityExists(n string, obj cloudantv1.Security) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		instanceCRN := rs.Primary.Attributes["instance_crn"]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getSecurityOptions := cloudantClient.NewGetSecurityOptions(rs.Primary.Attributes["db"])
+
+		security, _, err := cloudantClient.GetSecurity(getSecurityOptions)
+		if err != nil {
+			return err
+		}
+
+		obj = *security
+		return nil
+	}
+}
+
+func testAccCheckIBMCloudantSecurityDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_cloudant_security" {
+			continue
+		}
+
+		instanceCRN := rs.Primary.Attributes["instance_crn"]
+		cUrl, err := cloudant.GetCloudantInstanceUrl(instanceCRN, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		cloudantClient, err := cloudant.GetCloudantClientForUrl(cUrl, acc.TestAccProvider.Meta())
+		if err != nil {
+			return err
+		}
+
+		getSecurityOptions := cloudantClient.NewGetSecurityOptions(rs.Primary.Attributes["db"])
+
+		// The security document only exists as long as the database does,
+		// otherwise it has to be reset to the empty document.
+		security, _, err := cloudantClient.GetSecurity(getSecurityOptions)
+		if err == nil && (len(security.Cloudant) > 0 || security.Admins != nil || security.Members != nil) {
+			return fmt.Errorf("cloudant_security still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func TestFlattenCloudantSecurityCloudant(t *testing.T) {
+	permissions := cloudant.FlattenCloudantSecurityCloudant(map[string][]string{
+		"nobody":     {"_reader"},
+		"apikey-123": {"_reader", "_writer"},
+	})
+	expected := []interface{}{
+		map[string]interface{}{"name": "apikey-123", "roles": []string{"_reader", "_writer"}},
+		map[string]interface{}{"name": "nobody", "roles": []string{"_reader"}},
+	}
+	if !reflect.DeepEqual(permissions, expected) {
+		t.Errorf("FlattenCloudantSecurityCloudant = %v, want %v", permissions, expected)
+	}
+	if permissions := cloudant.FlattenCloudantSecurityCloudant(nil); len(permissions) != 0 {
+		t.Errorf("FlattenCloudantSecurityCloudant(nil) = %v, want no permissions", permissions)
+	}
+}
@@ -7,15 +7,20 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
+	"strings"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
+	"github.com/IBM/vpc-go-sdk/vpcv1"
 )
 
 func ResourceIBMCbrZone() *schema.Resource {
@@ -26,6 +31,12 @@ func ResourceIBMCbrZone() *schema.Resource {
 		DeleteContext: ResourceIBMCbrZoneDelete,
 		Importer:      &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+				return ResourceIBMCbrZoneAddressSourceDiff(context, diff, meta)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": &schema.Schema{
 				Type:         schema.TypeString,
@@ -46,9 +57,10 @@ func ResourceIBMCbrZone() *schema.Resource {
 				Description:  "The description of the zone.",
 			},
 			"addresses": &schema.Schema{
-				Type:        schema.TypeList,
-				Required:    true,
-				Description: "The list of addresses in the zone.",
+				Type:         schema.TypeList,
+				Optional:     true,
+				AtLeastOneOf: []string{"addresses", "address_source"},
+				Description:  "The list of addresses in the zone.",
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
 						"type": &schema.Schema{
@@ -143,6 +155,128 @@ func ResourceIBMCbrZone() *schema.Resource {
 					},
 				},
 			},
+			"address_source": &schema.Schema{
+				Type:         schema.TypeList,
+				MaxItems:     1,
+				Optional:     true,
+				AtLeastOneOf: []string{"addresses", "address_source"},
+				Description:  "Selects addresses that are resolved when the zone is planned. The resolved addresses are added to the zone next to the static `addresses`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"vpc": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Selects the VPCs of the account by resource group and tags.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_group_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Only select the VPCs of the resource group.",
+									},
+									"tags": &schema.Schema{
+										Type:        schema.TypeSet,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+										Description: "Only select the VPCs that have all of the user tags.",
+									},
+								},
+							},
+						},
+						"subnet": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Selects the subnets of a VPC.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"vpc_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The ID of the VPC whose subnets are selected.",
+									},
+								},
+							},
+						},
+						"service_ref": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Selects a service of the account by name.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"service_name": &schema.Schema{
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The service name.",
+									},
+								},
+							},
+						},
+						"satellite_location": &schema.Schema{
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "Selects the Satellite locations of the account.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"resource_group_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Optional:    true,
+										Description: "Only select the Satellite locations of the resource group.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"resolved_addresses": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of addresses resolved from `address_source`.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of address.",
+						},
+						"value": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The IP address.",
+						},
+						"ref": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "A service reference value.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"account_id": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The id of the account owning the service.",
+									},
+									"service_type": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The service type.",
+									},
+									"service_name": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The service name.",
+									},
+									"service_instance": &schema.Schema{
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The service instance.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"x_correlation_id": &schema.Schema{
 				Type:         schema.TypeString,
 				Optional:     true,
@@ -274,18 +408,11 @@ func ResourceIBMCbrZoneCreate(context context.Context, d *schema.ResourceData, m
 	if _, ok := d.GetOk("description"); ok {
 		createZoneOptions.SetDescription(d.Get("description").(string))
 	}
-	if _, ok := d.GetOk("addresses"); ok {
-		var addresses []contextbasedrestrictionsv1.AddressIntf
-		for _, e := range d.Get("addresses").([]interface{}) {
-			value := e.(map[string]interface{})
-			addressesItem, err := ResourceIBMCbrZoneMapToAddress(value)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			addresses = append(addresses, addressesItem)
-		}
-		createZoneOptions.SetAddresses(addresses)
+	addresses, err := ResourceIBMCbrZoneExpandAddresses(context, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	createZoneOptions.SetAddresses(addresses)
 	if _, ok := d.GetOk("excluded"); ok {
 		var excluded []contextbasedrestrictionsv1.AddressIntf
 		for _, e := range d.Get("excluded").([]interface{}) {
@@ -351,19 +478,36 @@ func ResourceIBMCbrZoneRead(context context.Context, d *schema.ResourceData, met
 	if err = d.Set("description", zone.Description); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
 	}
+	// With an address_source, the addresses that are not configured statically
+	// were resolved and are tracked in resolved_addresses.
+	_, hasAddressSource := d.GetOk("address_source")
+	staticAddresses := map[string]bool{}
+	for _, e := range d.Get("addresses").([]interface{}) {
+		if e != nil {
+			staticAddresses[ResourceIBMCbrZoneAddressKey(e.(map[string]interface{}))] = true
+		}
+	}
 	addresses := []map[string]interface{}{}
+	resolvedAddresses := []map[string]interface{}{}
 	if zone.Addresses != nil {
 		for _, addressesItem := range zone.Addresses {
 			addressesItemMap, err := ResourceIBMCbrZoneAddressToMap(addressesItem)
 			if err != nil {
 				return diag.FromErr(err)
 			}
-			addresses = append(addresses, addressesItemMap)
+			if hasAddressSource && !staticAddresses[ResourceIBMCbrZoneAddressKey(addressesItemMap)] {
+				resolvedAddresses = append(resolvedAddresses, addressesItemMap)
+			} else {
+				addresses = append(addresses, addressesItemMap)
+			}
 		}
 	}
 	if err = d.Set("addresses", addresses); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting addresses: %s", err))
 	}
+	if err = d.Set("resolved_addresses", resolvedAddresses); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting resolved_addresses: %s", err))
+	}
 	excluded := []map[string]interface{}{}
 	if zone.Excluded != nil {
 		for _, excludedItem := range zone.Excluded {
@@ -426,18 +570,11 @@ func ResourceIBMCbrZoneUpdate(context context.Context, d *schema.ResourceData, m
 	if _, ok := d.GetOk("description"); ok {
 		replaceZoneOptions.SetDescription(d.Get("description").(string))
 	}
-	if _, ok := d.GetOk("addresses"); ok {
-		var addresses []contextbasedrestrictionsv1.AddressIntf
-		for _, e := range d.Get("addresses").([]interface{}) {
-			value := e.(map[string]interface{})
-			addressesItem, err := ResourceIBMCbrZoneMapToAddress(value)
-			if err != nil {
-				return diag.FromErr(err)
-			}
-			addresses = append(addresses, addressesItem)
-		}
-		replaceZoneOptions.SetAddresses(addresses)
+	addresses, err := ResourceIBMCbrZoneExpandAddresses(context, d, meta)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	replaceZoneOptions.SetAddresses(addresses)
 	if _, ok := d.GetOk("excluded"); ok {
 		var excluded []contextbasedrestrictionsv1.AddressIntf
 		for _, e := range d.Get("excluded").([]interface{}) {
@@ -649,3 +786,353 @@ func ResourceIBMCbrZoneAddressVPCToMap(model *contextbasedrestrictionsv1.Address
 	modelMap["value"] = model.Value
 	return modelMap, nil
 }
+
+// ResourceIBMCbrZoneExpandAddresses returns the configured addresses together with
+// the resolved_addresses of the plan, so the zone gets the addresses that were
+// shown in the plan. The address_source is only resolved here when its
+// selectors were not known at plan time.
+func ResourceIBMCbrZoneExpandAddresses(context context.Context, d *schema.ResourceData, meta interface{}) ([]contextbasedrestrictionsv1.AddressIntf, error) {
+	var addresses []contextbasedrestrictionsv1.AddressIntf
+	staticAddresses := map[string]bool{}
+	for _, e := range d.Get("addresses").([]interface{}) {
+		value := e.(map[string]interface{})
+		addressesItem, err := ResourceIBMCbrZoneMapToAddress(value)
+		if err != nil {
+			return nil, err
+		}
+		staticAddresses[ResourceIBMCbrZoneAddressKey(value)] = true
+		addresses = append(addresses, addressesItem)
+	}
+	if source, ok := d.GetOk("address_source"); ok {
+		var resolvedAddresses []map[string]interface{}
+		if plan := d.GetRawPlan(); !plan.IsNull() && plan.GetAttr("resolved_addresses").IsKnown() {
+			for _, e := range d.Get("resolved_addresses").([]interface{}) {
+				if e != nil {
+					resolvedAddresses = append(resolvedAddresses, e.(map[string]interface{}))
+				}
+			}
+		} else {
+			var err error
+			resolvedAddresses, err = ResourceIBMCbrZoneResolveAddressSource(context, meta, d.Get("account_id").(string), source.([]interface{})[0].(map[string]interface{}))
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, value := range resolvedAddresses {
+			if staticAddresses[ResourceIBMCbrZoneAddressKey(value)] {
+				continue
+			}
+			addressesItem, err := ResourceIBMCbrZoneMapToAddress(value)
+			if err != nil {
+				return nil, err
+			}
+			addresses = append(addresses, addressesItem)
+		}
+		if len(addresses) == 0 {
+			return nil, fmt.Errorf("address_source did not select any address and no addresses are configured")
+		}
+	}
+	return addresses, nil
+}
+
+// ResourceIBMCbrZoneAddressSourceDiff resolves the address_source on every plan so
+// that VPCs, subnets and Satellite locations added or removed since the last apply
+// show up as a change of resolved_addresses.
+func ResourceIBMCbrZoneAddressSourceDiff(context context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	oldResolved := diff.Get("resolved_addresses").([]interface{})
+	source, ok := diff.GetOk("address_source")
+	if !ok {
+		if len(oldResolved) > 0 {
+			return diff.SetNew("resolved_addresses", []interface{}{})
+		}
+		return nil
+	}
+	if !diff.NewValueKnown("account_id") || !ResourceIBMCbrZoneAddressSourceKnown(diff) {
+		return diff.SetNewComputed("resolved_addresses")
+	}
+
+	resolved, err := ResourceIBMCbrZoneResolveAddressSource(context, meta, diff.Get("account_id").(string), source.([]interface{})[0].(map[string]interface{}))
+	if err != nil {
+		return err
+	}
+	staticAddresses := map[string]bool{}
+	for _, e := range diff.Get("addresses").([]interface{}) {
+		if e != nil {
+			staticAddresses[ResourceIBMCbrZoneAddressKey(e.(map[string]interface{}))] = true
+		}
+	}
+	newResolved := make([]interface{}, 0, len(resolved))
+	for _, address := range resolved {
+		if !staticAddresses[ResourceIBMCbrZoneAddressKey(address)] {
+			newResolved = append(newResolved, address)
+		}
+	}
+	if len(newResolved) == 0 && len(staticAddresses) == 0 {
+		return fmt.Errorf("address_source did not select any address and no addresses are configured")
+	}
+	if diff.Id() != "" && ResourceIBMCbrZoneSameAddresses(oldResolved, newResolved) {
+		return nil
+	}
+	if err = diff.SetNew("resolved_addresses", newResolved); err != nil {
+		return err
+	}
+	return diff.SetNewComputed("address_count")
+}
+
+// ResourceIBMCbrZoneAddressSourceKnown reports whether the selectors of the
+// address_source are known at plan time, they are not when they refer to a VPC
+// or resource group that is created in the same apply.
+func ResourceIBMCbrZoneAddressSourceKnown(diff *schema.ResourceDiff) bool {
+	keys := []string{}
+	for i := range diff.Get("address_source.0.vpc").([]interface{}) {
+		keys = append(keys, fmt.Sprintf("address_source.0.vpc.%d.resource_group_id", i), fmt.Sprintf("address_source.0.vpc.%d.tags", i))
+	}
+	for i := range diff.Get("address_source.0.subnet").([]interface{}) {
+		keys = append(keys, fmt.Sprintf("address_source.0.subnet.%d.vpc_id", i))
+	}
+	for i := range diff.Get("address_source.0.service_ref").([]interface{}) {
+		keys = append(keys, fmt.Sprintf("address_source.0.service_ref.%d.service_name", i))
+	}
+	for i := range diff.Get("address_source.0.satellite_location").([]interface{}) {
+		keys = append(keys, fmt.Sprintf("address_source.0.satellite_location.%d.resource_group_id", i))
+	}
+	for _, key := range keys {
+		if !diff.NewValueKnown(key) {
+			return false
+		}
+	}
+	return true
+}
+
+// ResourceIBMCbrZoneResolveAddressSource returns the addresses selected by the
+// address_source, sorted and without duplicates.
+func ResourceIBMCbrZoneResolveAddressSource(context context.Context, meta interface{}, accountID string, source map[string]interface{}) ([]map[string]interface{}, error) {
+	resolved := map[string]map[string]interface{}{}
+	add := func(address map[string]interface{}) {
+		resolved[ResourceIBMCbrZoneAddressKey(address)] = address
+	}
+
+	vpcSelectors := source["vpc"].([]interface{})
+	subnetSelectors := source["subnet"].([]interface{})
+	if len(vpcSelectors) > 0 || len(subnetSelectors) > 0 {
+		vpcClient, err := meta.(conns.ClientSession).VpcV1API()
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range vpcSelectors {
+			selector := map[string]interface{}{}
+			if e != nil {
+				selector = e.(map[string]interface{})
+			}
+			resourceGroupID, _ := selector["resource_group_id"].(string)
+			vpcs, err := ResourceIBMCbrZoneListVpcs(context, vpcClient, resourceGroupID)
+			if err != nil {
+				return nil, err
+			}
+			tags := []string{}
+			if tagSet, ok := selector["tags"].(*schema.Set); ok {
+				tags = flex.ExpandStringList(tagSet.List())
+			}
+			for _, vpc := range vpcs {
+				if len(tags) > 0 {
+					vpcTags, err := flex.GetGlobalTagsUsingCRN(meta, *vpc.CRN, "", "user")
+					if err != nil {
+						return nil, fmt.Errorf("[ERROR] Error getting the tags of VPC %s: %s", *vpc.ID, err)
+					}
+					if !ResourceIBMCbrZoneHasAllTags(vpcTags, tags) {
+						continue
+					}
+				}
+				add(map[string]interface{}{
+					"type":  "vpc",
+					"value": *vpc.CRN,
+				})
+			}
+		}
+		if len(subnetSelectors) > 0 {
+			subnets, err := ResourceIBMCbrZoneListSubnets(context, vpcClient)
+			if err != nil {
+				return nil, err
+			}
+			for _, e := range subnetSelectors {
+				vpcID := e.(map[string]interface{})["vpc_id"].(string)
+				for _, subnet := range subnets {
+					if subnet.VPC != nil && subnet.VPC.ID != nil && *subnet.VPC.ID == vpcID && subnet.Ipv4CIDRBlock != nil {
+						add(map[string]interface{}{
+							"type":  "subnet",
+							"value": *subnet.Ipv4CIDRBlock,
+						})
+					}
+				}
+			}
+		}
+	}
+
+	for _, e := range source["service_ref"].([]interface{}) {
+		add(ResourceIBMCbrZoneServiceRefAddress(accountID, e.(map[string]interface{})["service_name"].(string), ""))
+	}
+
+	satelliteSelectors := source["satellite_location"].([]interface{})
+	if len(satelliteSelectors) > 0 {
+		satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+		if err != nil {
+			return nil, err
+		}
+		for _, e := range satelliteSelectors {
+			getSatLocationsOptions := &kubernetesserviceapiv1.GetSatelliteLocationsOptions{}
+			if e != nil {
+				if resourceGroupID := e.(map[string]interface{})["resource_group_id"].(string); resourceGroupID != "" {
+					getSatLocationsOptions.XAuthResourceGroup = core.StringPtr(resourceGroupID)
+				}
+			}
+			locations, response, err := satClient.GetSatelliteLocationsWithContext(context, getSatLocationsOptions)
+			if err != nil {
+				return nil, fmt.Errorf("[ERROR] Error listing Satellite locations: %s\n%s", err, response)
+			}
+			for _, location := range locations {
+				if location.ID != nil {
+					add(ResourceIBMCbrZoneServiceRefAddress(accountID, "satellite", *location.ID))
+				}
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(resolved))
+	for key := range resolved {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	addresses := make([]map[string]interface{}, 0, len(keys))
+	for _, key := range keys {
+		addresses = append(addresses, resolved[key])
+	}
+	return addresses, nil
+}
+
+func ResourceIBMCbrZoneListVpcs(context context.Context, vpcClient *vpcv1.VpcV1, resourceGroupID string) ([]vpcv1.VPC, error) {
+	start := ""
+	allrecs := []vpcv1.VPC{}
+	for {
+		listOptions := &vpcv1.ListVpcsOptions{}
+		if start != "" {
+			listOptions.Start = &start
+		}
+		if resourceGroupID != "" {
+			listOptions.ResourceGroupID = &resourceGroupID
+		}
+		result, response, err := vpcClient.ListVpcsWithContext(context, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing VPCs: %s\n%s", err, response)
+		}
+		allrecs = append(allrecs, result.Vpcs...)
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+func ResourceIBMCbrZoneListSubnets(context context.Context, vpcClient *vpcv1.VpcV1) ([]vpcv1.Subnet, error) {
+	start := ""
+	allrecs := []vpcv1.Subnet{}
+	for {
+		listOptions := &vpcv1.ListSubnetsOptions{}
+		if start != "" {
+			listOptions.Start = &start
+		}
+		result, response, err := vpcClient.ListSubnetsWithContext(context, listOptions)
+		if err != nil {
+			return nil, fmt.Errorf("[ERROR] Error listing subnets: %s\n%s", err, response)
+		}
+		allrecs = append(allrecs, result.Subnets...)
+		start = flex.GetNext(result.Next)
+		if start == "" {
+			break
+		}
+	}
+	return allrecs, nil
+}
+
+func ResourceIBMCbrZoneServiceRefAddress(accountID, serviceName, serviceInstance string) map[string]interface{} {
+	ref := map[string]interface{}{
+		"account_id":   accountID,
+		"service_name": serviceName,
+	}
+	if serviceInstance != "" {
+		ref["service_instance"] = serviceInstance
+	}
+	return map[string]interface{}{
+		"type": "serviceRef",
+		"ref":  []interface{}{ref},
+	}
+}
+
+func ResourceIBMCbrZoneHasAllTags(tags *schema.Set, required []string) bool {
+	for _, tag := range required {
+		if !tags.Contains(tag) {
+			return false
+		}
+	}
+	return true
+}
+
+// ResourceIBMCbrZoneAddressKey identifies an address, whether the map comes from
+// the configuration or from ResourceIBMCbrZoneAddressToMap.
+func ResourceIBMCbrZoneAddressKey(address map[string]interface{}) string {
+	parts := []string{ResourceIBMCbrZoneStringValue(address["type"]), ResourceIBMCbrZoneStringValue(address["value"])}
+	var ref map[string]interface{}
+	switch refs := address["ref"].(type) {
+	case []interface{}:
+		if len(refs) > 0 && refs[0] != nil {
+			ref = refs[0].(map[string]interface{})
+		}
+	case []map[string]interface{}:
+		if len(refs) > 0 {
+			ref = refs[0]
+		}
+	}
+	if ref != nil {
+		for _, key := range []string{"account_id", "service_type", "service_name", "service_instance"} {
+			parts = append(parts, ResourceIBMCbrZoneStringValue(ref[key]))
+		}
+	}
+	return strings.Join(parts, "|")
+}
+
+func ResourceIBMCbrZoneStringValue(value interface{}) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case *string:
+		if v != nil {
+			return *v
+		}
+	}
+	return ""
+}
+
+func ResourceIBMCbrZoneSameAddresses(old, new []interface{}) bool {
+	if len(old) != len(new) {
+		return false
+	}
+	keys := map[string]bool{}
+	for _, e := range old {
+		if e != nil {
+			keys[ResourceIBMCbrZoneAddressKey(e.(map[string]interface{}))] = true
+		}
+	}
+	for _, e := range new {
+		var address map[string]interface{}
+		switch v := e.(type) {
+		case map[string]interface{}:
+			address = v
+		default:
+			return false
+		}
+		if !keys[ResourceIBMCbrZoneAddressKey(address)] {
+			return false
+		}
+	}
+	return true
+}
@@ -9,11 +9,14 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/contextbasedrestrictions"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/contextbasedrestrictionsv1"
 )
 
@@ -75,6 +78,42 @@ func TestAccIBMCbrZoneAllArgs(t *testing.T) {
 	})
 }
 
+func TestAccIBMCbrZoneAddressSource(t *testing.T) {
+	var conf contextbasedrestrictionsv1.Zone
+	prefix := fmt.Sprintf("tf-cbr-zone-%d", acctest.RandIntRange(10, 100))
+	tag := fmt.Sprintf("cbr-zone-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCbrZoneDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMCbrZoneConfigAddressSource(prefix, tag, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCbrZoneExists("ibm_cbr_zone.cbr_zone", conf),
+					resource.TestCheckResourceAttr("ibm_cbr_zone.cbr_zone", "addresses.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cbr_zone.cbr_zone", "resolved_addresses.#", "2"),
+					resource.TestCheckResourceAttr("ibm_cbr_zone.cbr_zone", "address_count", "3"),
+				),
+			},
+			// The zone is planned before the second VPC exists, the next plan picks it up
+			resource.TestStep{
+				Config:             testAccCheckIBMCbrZoneConfigAddressSource(prefix, tag, 2),
+				ExpectNonEmptyPlan: true,
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMCbrZoneConfigAddressSource(prefix, tag, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cbr_zone.cbr_zone", "addresses.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cbr_zone.cbr_zone", "resolved_addresses.#", "3"),
+					resource.TestCheckResourceAttr("ibm_cbr_zone.cbr_zone", "address_count", "4"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMCbrZoneConfigBasic() string {
 	return fmt.Sprintf(`
 		resource "ibm_cbr_zone" "cbr_zone" {
@@ -167,3 +206,115 @@ func testAccCheckIBMCbrZoneDestroy(s *terraform.State) error {
 
 	return nil
 }
+
+func testAccCheckIBMCbrZoneConfigAddressSource(prefix string, tag string, vpcCount int) string {
+	return fmt.Sprintf(`
+		data "ibm_iam_account_settings" "account_settings" {
+		}
+
+		resource "ibm_is_vpc" "vpc" {
+			count = %d
+			name  = "%s-${count.index}"
+			tags  = ["%s"]
+		}
+
+		resource "ibm_is_subnet" "subnet" {
+			name                     = "%s-subnet"
+			vpc                      = ibm_is_vpc.vpc[0].id
+			zone                     = "us-south-1"
+			total_ipv4_address_count = 16
+		}
+
+		resource "ibm_cbr_zone" "cbr_zone" {
+			name = "%s"
+			description = "Test Zone Resource Config Address Source"
+			account_id = data.ibm_iam_account_settings.account_settings.account_id
+			addresses {
+				type = "ipRange"
+				value = "169.23.22.0-169.23.22.255"
+			}
+			address_source {
+				vpc {
+					tags = ["%s"]
+				}
+				subnet {
+					vpc_id = ibm_is_subnet.subnet.vpc
+				}
+			}
+		}
+	`, vpcCount, prefix, tag, prefix, prefix, tag)
+}
+
+func TestResourceIBMCbrZoneAddressKey(t *testing.T) {
+	configured := map[string]interface{}{
+		"type":  "serviceRef",
+		"value": "",
+		"ref": []interface{}{map[string]interface{}{
+			"account_id":       "12ab34cd56ef78ab90cd12ef34ab56cd",
+			"service_type":     "",
+			"service_name":     "cloud-object-storage",
+			"service_instance": "",
+		}},
+	}
+	read := map[string]interface{}{
+		"type": core.StringPtr("serviceRef"),
+		"ref": []map[string]interface{}{{
+			"account_id":   core.StringPtr("12ab34cd56ef78ab90cd12ef34ab56cd"),
+			"service_name": core.StringPtr("cloud-object-storage"),
+		}},
+	}
+	if configuredKey, readKey := contextbasedrestrictions.ResourceIBMCbrZoneAddressKey(configured), contextbasedrestrictions.ResourceIBMCbrZoneAddressKey(read); configuredKey != readKey {
+		t.Errorf("configured address key %q differs from the read address key %q", configuredKey, readKey)
+	}
+
+	ip := map[string]interface{}{"type": "ipAddress", "value": "169.23.56.234"}
+	otherIP := map[string]interface{}{"type": "ipAddress", "value": "169.23.56.235"}
+	ipKey := contextbasedrestrictions.ResourceIBMCbrZoneAddressKey(ip)
+	if ipKey != "ipAddress|169.23.56.234" {
+		t.Errorf("address key = %q, want ipAddress|169.23.56.234", ipKey)
+	}
+	if ipKey == contextbasedrestrictions.ResourceIBMCbrZoneAddressKey(otherIP) || ipKey == contextbasedrestrictions.ResourceIBMCbrZoneAddressKey(configured) {
+		t.Errorf("different addresses share the address key %q", ipKey)
+	}
+}
+
+func TestResourceIBMCbrZoneSameAddresses(t *testing.T) {
+	vpc := map[string]interface{}{"type": "vpc", "value": "crn:v1:bluemix:public:is:us-south:a/12ab34cd56ef78ab90cd12ef34ab56cd::vpc:r006-1"}
+	subnet := map[string]interface{}{"type": "subnet", "value": "10.240.0.0/24"}
+	other := map[string]interface{}{"type": "subnet", "value": "10.240.1.0/24"}
+
+	for _, c := range []struct {
+		o, n []interface{}
+		want bool
+	}{
+		{[]interface{}{}, []interface{}{}, true},
+		{[]interface{}{vpc, subnet}, []interface{}{subnet, vpc}, true},
+		{[]interface{}{vpc, subnet}, []interface{}{vpc, other}, false},
+		{[]interface{}{vpc}, []interface{}{vpc, subnet}, false},
+		{[]interface{}{vpc}, []interface{}{"vpc"}, false},
+	} {
+		if got := contextbasedrestrictions.ResourceIBMCbrZoneSameAddresses(c.o, c.n); got != c.want {
+			t.Errorf("ResourceIBMCbrZoneSameAddresses(%v, %v) = %t, want %t", c.o, c.n, got, c.want)
+		}
+	}
+}
+
+func TestResourceIBMCbrZoneHasAllTags(t *testing.T) {
+	tags := schema.NewSet(schema.HashString, []interface{}{"env:prod", "team:a"})
+
+	for _, c := range []struct {
+		tags     *schema.Set
+		required []string
+		want     bool
+	}{
+		{tags, nil, true},
+		{tags, []string{"env:prod"}, true},
+		{tags, []string{"env:prod", "team:a"}, true},
+		{tags, []string{"env:prod", "team:b"}, false},
+		{schema.NewSet(schema.HashString, nil), []string{"env:prod"}, false},
+	} {
+		if got := contextbasedrestrictions.ResourceIBMCbrZoneHasAllTags(c.tags, c.required); got != c.want {
+			t.Errorf("ResourceIBMCbrZoneHasAllTags(%v, %v) = %t, want %t", c.tags.List(), c.required, got, c.want)
+		}
+	}
+}
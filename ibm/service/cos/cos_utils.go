@@ -0,0 +1,43 @@
+package cos
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// getS3ClientSessionFromBucketId returns the S3 client and the bucket name of
+// the resources that configure a bucket, their id is formed like the id of
+// ibm_cos_bucket_replication_rule.
+func getS3ClientSessionFromBucketId(id string, meta interface{}) (*s3.S3, string, error) {
+	bucketName := parseBucketReplId(id, "bucketName")
+	bucketLocation := parseBucketReplId(id, "bucketLocation")
+	instanceCRN := parseBucketReplId(id, "instanceCRN")
+	endpointType := parseBucketReplId(id, "endpointType")
+
+	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, "", err
+	}
+
+	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return nil, "", err
+	}
+	return s3Client, bucketName, nil
+}
+
+// cosBucketConfigurationId generates the id of the resources that configure a
+// bucket, it contains every information about to get the bucket via s3 api.
+func cosBucketConfigurationId(d *schema.ResourceData) (string, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	crnParts := strings.Split(bucketCRN, ":bucket:")
+	if len(crnParts) != 2 || crnParts[1] == "" {
+		return "", fmt.Errorf("[ERROR] Invalid bucket_crn %s, the CRN must end with :bucket:<bucket name>", bucketCRN)
+	}
+	instanceCRN := fmt.Sprintf("%s::", crnParts[0])
+	return fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", crnParts[1], d.Get("bucket_location").(string), d.Get("endpoint_type").(string)), nil
+}
@@ -20,6 +20,7 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 )
 
@@ -67,7 +68,7 @@ func ResourceIBMCOSBucket() *schema.Resource {
 		Delete:        resourceIBMCOSBucketDelete,
 		Exists:        resourceIBMCOSBucketExists,
 		Importer:      &schema.ResourceImporter{},
-		CustomizeDiff: resourceExpiryValidate,
+		CustomizeDiff: customdiff.Sequence(resourceExpiryValidate, resourceObjectLockValidate),
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -394,6 +395,62 @@ func ResourceIBMCOSBucket() *schema.Resource {
 					},
 				},
 			},
+			"object_lock_configuration": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"retention_rule"},
+				Description:   "Object Lock stores objects in a write-once-read-many (WORM) model, an object version can not be deleted or overwritten until its retention expires. Object Lock can only be enabled when the bucket is created and requires object versioning.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"object_lock_enabled": {
+							Type:         schema.TypeString,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"Enabled"}),
+							Description:  "Enable Object Lock on the bucket, the only allowed value is Enabled.",
+						},
+						"object_lock_rule": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							MaxItems:    1,
+							Description: "The default retention of the object versions that are stored in the bucket.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"default_retention": {
+										Type:        schema.TypeList,
+										Required:    true,
+										MaxItems:    1,
+										Description: "The retention that is applied to an object version that is stored without a retention.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"mode": {
+													Type:         schema.TypeString,
+													Required:     true,
+													ValidateFunc: validate.ValidateAllowedStringValues([]string{"COMPLIANCE"}),
+													Description:  "The retention mode, COS supports COMPLIANCE.",
+												},
+												"days": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ExactlyOneOf: []string{"object_lock_configuration.0.object_lock_rule.0.default_retention.0.days", "object_lock_configuration.0.object_lock_rule.0.default_retention.0.years"},
+													ValidateFunc: validate.ValidateAllowedRangeInt(1, 36500),
+													Description:  "The number of days an object version is retained.",
+												},
+												"years": {
+													Type:         schema.TypeInt,
+													Optional:     true,
+													ValidateFunc: validate.ValidateAllowedRangeInt(1, 100),
+													Description:  "The number of years an object version is retained.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 			"noncurrent_version_expiration": {
 				Type:        schema.TypeList,
 				Optional:    true,
@@ -850,6 +907,20 @@ func resourceIBMCOSBucketUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 	}
 
+	//update the object lock configuration, it requires object versioning
+	if d.HasChange("object_lock_configuration") {
+		if objectLock, ok := d.GetOk("object_lock_configuration"); ok {
+			input := &s3.PutObjectLockConfigurationInput{
+				Bucket:                  aws.String(bucketName),
+				ObjectLockConfiguration: objectLockConfigurationSet(objectLock.([]interface{})),
+			}
+			_, err := s3Client.PutObjectLockConfiguration(input)
+			if err != nil {
+				return fmt.Errorf("failed to update the object lock configuration on COS bucket %s, %v", bucketName, err)
+			}
+		}
+	}
+
 	sess, err := meta.(conns.ClientSession).CosConfigV1API()
 	if err != nil {
 		return err
@@ -1180,6 +1251,22 @@ func resourceIBMCOSBucketRead(d *schema.ResourceData, meta interface{}) error {
 			d.Set("object_versioning", nil)
 		}
 	}
+
+	// Read Object Lock configuration
+	if apiType != "sl" {
+		objectLock, err := s3Client.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{Bucket: aws.String(bucketName)})
+		if err != nil {
+			if !isCOSObjectLockNotFound(err) {
+				if _, ok := d.GetOk("object_lock_configuration"); ok {
+					return fmt.Errorf("failed to read the object lock configuration of COS bucket %s, %v", bucketName, err)
+				}
+				log.Printf("[WARN] Error reading the object lock configuration of COS bucket %s, %v", bucketName, err)
+			}
+			d.Set("object_lock_configuration", nil)
+		} else {
+			d.Set("object_lock_configuration", objectLockConfigurationGet(objectLock.ObjectLockConfiguration))
+		}
+	}
 	return nil
 }
 
@@ -1296,7 +1383,12 @@ func resourceIBMCOSBucketCreate(d *schema.ResourceData, meta interface{}) error
 	s3Sess := session.Must(session.NewSession())
 	s3Client := s3.New(s3Sess, s3Conf)
 
-	_, err = s3Client.CreateBucket(create)
+	createRequest, _ := s3Client.CreateBucketRequest(create)
+	// Object Lock can only be enabled when the bucket is created
+	if _, ok := d.GetOk("object_lock_configuration"); ok {
+		createRequest.HTTPRequest.Header.Set("x-amz-bucket-object-lock-enabled", "true")
+	}
+	err = createRequest.Send()
 
 	if err != nil {
 		return err
@@ -1577,3 +1669,79 @@ func resourceExpiryValidate(_ context.Context, diff *schema.ResourceDiff, meta i
 	}
 	return nil
 }
+
+func objectLockConfigurationSet(objectLockList []interface{}) *s3.ObjectLockConfiguration {
+	configuration := &s3.ObjectLockConfiguration{
+		ObjectLockEnabled: aws.String("Enabled"),
+	}
+	if len(objectLockList) == 0 || objectLockList[0] == nil {
+		return configuration
+	}
+	objectLockMap := objectLockList[0].(map[string]interface{})
+	ruleList, ok := objectLockMap["object_lock_rule"].([]interface{})
+	if !ok || len(ruleList) == 0 || ruleList[0] == nil {
+		return configuration
+	}
+	retentionList, ok := ruleList[0].(map[string]interface{})["default_retention"].([]interface{})
+	if !ok || len(retentionList) == 0 || retentionList[0] == nil {
+		return configuration
+	}
+	retentionMap := retentionList[0].(map[string]interface{})
+	retention := &s3.DefaultRetention{
+		Mode: aws.String(retentionMap["mode"].(string)),
+	}
+	if days := retentionMap["days"].(int); days > 0 {
+		retention.Days = aws.Int64(int64(days))
+	}
+	if years := retentionMap["years"].(int); years > 0 {
+		retention.Years = aws.Int64(int64(years))
+	}
+	configuration.Rule = &s3.ObjectLockRule{DefaultRetention: retention}
+	return configuration
+}
+
+func objectLockConfigurationGet(configuration *s3.ObjectLockConfiguration) []map[string]interface{} {
+	if configuration == nil || aws.StringValue(configuration.ObjectLockEnabled) != "Enabled" {
+		return nil
+	}
+	objectLockMap := map[string]interface{}{
+		"object_lock_enabled": aws.StringValue(configuration.ObjectLockEnabled),
+	}
+	if configuration.Rule != nil && configuration.Rule.DefaultRetention != nil {
+		retention := configuration.Rule.DefaultRetention
+		objectLockMap["object_lock_rule"] = []map[string]interface{}{{
+			"default_retention": []map[string]interface{}{{
+				"mode":  aws.StringValue(retention.Mode),
+				"days":  int(aws.Int64Value(retention.Days)),
+				"years": int(aws.Int64Value(retention.Years)),
+			}},
+		}}
+	}
+	return []map[string]interface{}{objectLockMap}
+}
+
+// resourceObjectLockValidate checks the Object Lock configuration against the
+// object versioning of the bucket. Object Lock can only be enabled when the
+// bucket is created and it can not be disabled.
+func resourceObjectLockValidate(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	_, objectLock := diff.GetOk("object_lock_configuration")
+	if diff.Id() != "" && diff.HasChange("object_lock_configuration") {
+		o, _ := diff.GetChange("object_lock_configuration")
+		hadObjectLock := len(o.([]interface{})) > 0
+		if !hadObjectLock && objectLock {
+			return fmt.Errorf("[ERROR] Object Lock can only be enabled when the bucket is created, create a new bucket with object_lock_configuration")
+		}
+		if hadObjectLock && !objectLock {
+			return fmt.Errorf("[ERROR] Object Lock can not be disabled once it is enabled on a bucket")
+		}
+	}
+	if objectLock && !diff.Get("object_versioning.0.enable").(bool) {
+		return fmt.Errorf("[ERROR] Object Lock requires object versioning, set object_versioning.enable to true")
+	}
+	if objectLock {
+		if _, ok := diff.GetOk("satellite_location_id"); ok {
+			return fmt.Errorf("[ERROR] Object Lock is not supported for buckets in a satellite location")
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSBucketCorsConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketCorsConfigurationCreate,
+		Read:     resourceIBMCOSBucketCorsConfigurationRead,
+		Update:   resourceIBMCOSBucketCorsConfigurationUpdate,
+		Delete:   resourceIBMCOSBucketCorsConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+				ForceNew:     true,
+			},
+			"cors_rule": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    100,
+				Description: "Cross-origin resource sharing rules of the bucket. A bucket can have up to 100 rules.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"allowed_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The headers that are allowed in a preflight request.",
+						},
+						"allowed_methods": {
+							Type:     schema.TypeList,
+							Required: true,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validate.ValidateAllowedStringValues([]string{"GET", "PUT", "POST", "DELETE", "HEAD"}),
+							},
+							Description: "The HTTP methods an origin is allowed to run: GET, PUT, POST, DELETE, HEAD.",
+						},
+						"allowed_origins": {
+							Type:        schema.TypeList,
+							Required:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The origins that are allowed to access the bucket.",
+						},
+						"expose_headers": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The headers in the response that a client is allowed to access.",
+						},
+						"max_age_seconds": {
+							Type:        schema.TypeInt,
+							Optional:    true,
+							Description: "The time in seconds that a browser caches the response to a preflight request.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func corsRuleSet(corsList []interface{}) []*s3.CORSRule {
+	var rules []*s3.CORSRule
+	for _, c := range corsList {
+		corsMap, _ := c.(map[string]interface{})
+		rule := &s3.CORSRule{
+			AllowedMethods: aws.StringSlice(flex.ExpandStringList(corsMap["allowed_methods"].([]interface{}))),
+			AllowedOrigins: aws.StringSlice(flex.ExpandStringList(corsMap["allowed_origins"].([]interface{}))),
+		}
+		if headers := corsMap["allowed_headers"].([]interface{}); len(headers) > 0 {
+			rule.AllowedHeaders = aws.StringSlice(flex.ExpandStringList(headers))
+		}
+		if headers := corsMap["expose_headers"].([]interface{}); len(headers) > 0 {
+			rule.ExposeHeaders = aws.StringSlice(flex.ExpandStringList(headers))
+		}
+		if maxAge := corsMap["max_age_seconds"].(int); maxAge > 0 {
+			rule.MaxAgeSeconds = aws.Int64(int64(maxAge))
+		}
+		rules = append(rules, rule)
+	}
+	return rules
+}
+
+func corsRuleGet(rules []*s3.CORSRule) []map[string]interface{} {
+	corsRules := make([]map[string]interface{}, 0, len(rules))
+	for _, rule := range rules {
+		corsRules = append(corsRules, map[string]interface{}{
+			"allowed_headers": aws.StringValueSlice(rule.AllowedHeaders),
+			"allowed_methods": aws.StringValueSlice(rule.AllowedMethods),
+			"allowed_origins": aws.StringValueSlice(rule.AllowedOrigins),
+			"expose_headers":  aws.StringValueSlice(rule.ExposeHeaders),
+			"max_age_seconds": int(aws.Int64Value(rule.MaxAgeSeconds)),
+		})
+	}
+	return corsRules
+}
+
+func resourceIBMCOSBucketCorsConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	id, err := cosBucketConfigurationId(d)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	putBucketCorsInput := &s3.PutBucketCorsInput{
+		Bucket: aws.String(bucketName),
+		CORSConfiguration: &s3.CORSConfiguration{
+			CORSRules: corsRuleSet(d.Get("cors_rule").([]interface{})),
+		},
+	}
+	_, err = s3Client.PutBucketCors(putBucketCorsInput)
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("failed to create the CORS configuration on COS bucket %s, %v", bucketName, err)
+	}
+
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("cors_rule") {
+		s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
+		if err != nil {
+			return err
+		}
+
+		putBucketCorsInput := &s3.PutBucketCorsInput{
+			Bucket: aws.String(bucketName),
+			CORSConfiguration: &s3.CORSConfiguration{
+				CORSRules: corsRuleSet(d.Get("cors_rule").([]interface{})),
+			},
+		}
+		_, err = s3Client.PutBucketCors(putBucketCorsInput)
+		if err != nil {
+			return fmt.Errorf("failed to update the CORS configuration on COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketCorsConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketCorsConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", parseBucketReplId(d.Id(), "bucketCRN"))
+	d.Set("bucket_location", parseBucketReplId(d.Id(), "bucketLocation"))
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
+	if err != nil {
+		return err
+	}
+
+	cors, err := s3Client.GetBucketCors(&s3.GetBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchCORSConfiguration") || strings.Contains(err.Error(), "NoSuchBucket") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to read the CORS configuration of COS bucket %s, %v", bucketName, err)
+	}
+
+	d.Set("cors_rule", corsRuleGet(cors.CORSRules))
+	return nil
+}
+
+func resourceIBMCOSBucketCorsConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
+	if err != nil {
+		return err
+	}
+
+	_, err = s3Client.DeleteBucketCors(&s3.DeleteBucketCorsInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete the CORS configuration of COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
@@ -6,7 +6,9 @@ package cos
 import (
 	"bytes"
 	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"log"
@@ -24,8 +26,11 @@ import (
 	token "github.com/IBM/ibm-cos-sdk-go/aws/credentials/ibmiam/token"
 	"github.com/IBM/ibm-cos-sdk-go/aws/session"
 	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3/s3manager"
+	"github.com/hashicorp/go-cty/cty"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 )
 
 func ResourceIBMCOSBucketObject() *schema.Resource {
@@ -35,6 +40,7 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 		UpdateContext: resourceIBMCOSBucketObjectUpdate,
 		DeleteContext: resourceIBMCOSBucketObjectDelete,
 		Importer:      &schema.ResourceImporter{},
+		CustomizeDiff: resourceIBMCOSBucketObjectLockValidate,
 
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(60 * time.Minute),
@@ -95,10 +101,25 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Default:      "public",
 			},
 			"etag": {
-				Type:        schema.TypeString,
-				Computed:    true,
-				Optional:    true,
-				Description: "COS object MD5 hexdigest",
+				Type:             schema.TypeString,
+				Computed:         true,
+				Optional:         true,
+				DiffSuppressFunc: suppressCOSObjectMultipartETagDiff,
+				Description:      "COS object MD5 hexdigest",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validate.ValidateAllowedRangeInt(5, 5120),
+				Description:  "Size in MiB of the parts of a multipart upload, objects larger than the part size are uploaded in parts",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, 100),
+				Description:  "Number of parts of a multipart upload that are uploaded in parallel",
 			},
 			"key": {
 				Type:        schema.TypeString,
@@ -121,6 +142,30 @@ func ResourceIBMCOSBucketObject() *schema.Resource {
 				Default:     true,
 				Description: "COS buckets need to be empty before they can be deleted. force_delete option empty the bucket and delete it.",
 			},
+			"object_lock_mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				RequiredWith: []string{"object_lock_retain_until_date"},
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"COMPLIANCE"}),
+				Description:  "Retention mode of the object version, COS supports COMPLIANCE. The bucket must have Object Lock enabled.",
+			},
+			"object_lock_retain_until_date": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				RequiredWith:     []string{"object_lock_mode"},
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: SuppressCOSObjectRetainUntilDateDiff,
+				Description:      "The date in RFC3339 format until the object version is retained. The date can be extended but not shortened.",
+			},
+			"object_lock_legal_hold_status": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"ON", "OFF"}),
+				Description:  "Legal hold status of the object version: ON, OFF. The bucket must have Object Lock enabled.",
+			},
 			"object_sql_url": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -160,47 +205,23 @@ func resourceIBMCOSBucketObjectCreate(ctx context.Context, d *schema.ResourceDat
 		return diag.FromErr(fmt.Errorf("[ERROR] Error COS bucket (%s) object (%s) already exists", bucketName, objectKey))
 	}
 
-	var body io.ReadSeeker
-
-	if v, ok := d.GetOk("content"); ok {
-		content := v.(string)
-		body = bytes.NewReader([]byte(content))
-	} else if v, ok := d.GetOk("content_base64"); ok {
-		content := v.(string)
-		contentRaw, err := base64.StdEncoding.DecodeString(content)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-		}
-		body = bytes.NewReader(contentRaw)
-	} else if v, ok := d.GetOk("content_file"); ok {
-		path := v.(string)
-		file, err := os.Open(path)
-		if err != nil {
-			return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-		}
-
-		body = file
-		defer func() {
-			err := file.Close()
-			if err != nil {
-				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-			}
-		}()
-	}
-
-	putInput := &s3.PutObjectInput{
-		Bucket: aws.String(bucketName),
-		Key:    aws.String(objectKey),
-		Body:   body,
+	body, closeBody, err := cosObjectBody(d)
+	if err != nil {
+		return diag.FromErr(err)
 	}
+	defer closeBody()
 
-	if _, err := s3Client.PutObject(putInput); err != nil {
+	if err := uploadCOSObject(ctx, s3Client, bucketName, objectKey, body, "", cosObjectPartSize(d), d.Get("upload_concurrency").(int)); err != nil {
 		return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
 	}
 
 	objectID := getObjectId(bucketCRN, objectKey, bucketLocation)
 	d.SetId(objectID)
 
+	if err := putCOSObjectLock(d, s3Client, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
+	}
+
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
 }
 
@@ -279,6 +300,10 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 		log.Printf("[INFO] Ignoring body of COS bucket (%s) object (%s) with Content-Type %q", bucketName, objectKey, contentType)
 	}
 
+	if err := readCOSObjectLock(d, s3Client, bucketName, objectKey); err != nil {
+		return diag.FromErr(err)
+	}
+
 	d.Set("key", objectKey)
 	d.Set("version_id", out.VersionId)
 	d.Set("object_sql_url", "cos://"+bucketLocation+"/"+bucketName+"/"+objectKey)
@@ -286,61 +311,34 @@ func resourceIBMCOSBucketObjectRead(ctx context.Context, d *schema.ResourceData,
 }
 
 func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
-	if d.HasChanges("content", "content_base64", "content_file", "etag") {
-		bucketCRN := d.Get("bucket_crn").(string)
-		bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-		instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
-
-		bucketLocation := d.Get("bucket_location").(string)
-		endpointType := d.Get("endpoint_type").(string)
-
-		bxSession, err := m.(conns.ClientSession).BluemixSession()
-		if err != nil {
-			return diag.FromErr(err)
-		}
-
-		s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
-		if err != nil {
-			return diag.FromErr(err)
-		}
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
 
-		var body io.ReadSeeker
+	bucketLocation := d.Get("bucket_location").(string)
+	endpointType := d.Get("endpoint_type").(string)
 
-		if v, ok := d.GetOk("content"); ok {
-			content := v.(string)
-			body = bytes.NewReader([]byte(content))
-		} else if v, ok := d.GetOk("content_base64"); ok {
-			content := v.(string)
-			contentRaw, err := base64.StdEncoding.DecodeString(content)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error decoding content_base64: %s", err))
-			}
-			body = bytes.NewReader(contentRaw)
-		} else if v, ok := d.GetOk("content_file"); ok {
-			path := v.(string)
-			file, err := os.Open(path)
-			if err != nil {
-				return diag.FromErr(fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err))
-			}
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-			body = file
-			defer func() {
-				err := file.Close()
-				if err != nil {
-					log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
-				}
-			}()
-		}
+	s3Client, err := getS3Client(bxSession, bucketLocation, endpointType, instanceCRN)
+	if err != nil {
+		return diag.FromErr(err)
+	}
 
-		objectKey := d.Get("key").(string)
+	objectKey := d.Get("key").(string)
+	contentChanged := d.HasChanges("content", "content_base64", "content_file", "etag")
 
-		putInput := &s3.PutObjectInput{
-			Bucket: aws.String(bucketName),
-			Key:    aws.String(objectKey),
-			Body:   body,
+	if contentChanged {
+		body, closeBody, err := cosObjectBody(d)
+		if err != nil {
+			return diag.FromErr(err)
 		}
+		defer closeBody()
 
-		if _, err := s3Client.PutObject(putInput); err != nil {
+		if err := uploadCOSObject(ctx, s3Client, bucketName, objectKey, body, "", cosObjectPartSize(d), d.Get("upload_concurrency").(int)); err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err))
 		}
 
@@ -348,6 +346,14 @@ func resourceIBMCOSBucketObjectUpdate(ctx context.Context, d *schema.ResourceDat
 		d.SetId(objectID)
 	}
 
+	// A new content is stored as a new object version, the retention and the
+	// legal hold are applied to the new version as well
+	if contentChanged || d.HasChanges("object_lock_mode", "object_lock_retain_until_date", "object_lock_legal_hold_status") {
+		if err := putCOSObjectLock(d, s3Client, bucketName, objectKey); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
 	return resourceIBMCOSBucketObjectRead(ctx, d, m)
 }
 
@@ -382,6 +388,129 @@ func resourceIBMCOSBucketObjectDelete(ctx context.Context, d *schema.ResourceDat
 	return nil
 }
 
+// cosObjectBody opens the content of the object from content, content_base64
+// or content_file. The returned func closes the content file.
+func cosObjectBody(d *schema.ResourceData) (io.ReadSeeker, func(), error) {
+	if v, ok := d.GetOk("content"); ok {
+		content := v.(string)
+		return bytes.NewReader([]byte(content)), func() {}, nil
+	} else if v, ok := d.GetOk("content_base64"); ok {
+		content := v.(string)
+		contentRaw, err := base64.StdEncoding.DecodeString(content)
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Error decoding content_base64: %s", err)
+		}
+		return bytes.NewReader(contentRaw), func() {}, nil
+	} else if v, ok := d.GetOk("content_file"); ok {
+		path := v.(string)
+		file, err := os.Open(path)
+		if err != nil {
+			return nil, nil, fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", path, err)
+		}
+		return file, func() {
+			err := file.Close()
+			if err != nil {
+				log.Printf("[WARN] Failed closing COS object file (%s): %s", path, err)
+			}
+		}, nil
+	}
+	return bytes.NewReader([]byte{}), func() {}, nil
+}
+
+func cosObjectPartSize(d *schema.ResourceData) int64 {
+	return int64(d.Get("part_size").(int)) * 1024 * 1024
+}
+
+// uploadCOSObject uploads the body with a single PUT when it fits in one part,
+// larger bodies are uploaded with a multipart upload.
+func uploadCOSObject(ctx context.Context, s3Client *s3.S3, bucketName, objectKey string, body io.ReadSeeker, contentType string, partSize int64, concurrency int) error {
+	uploader := s3manager.NewUploaderWithClient(s3Client, func(u *s3manager.Uploader) {
+		u.PartSize = partSize
+		u.Concurrency = concurrency
+	})
+	input := &s3manager.UploadInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+		Body:   body,
+	}
+	if contentType != "" {
+		input.ContentType = aws.String(contentType)
+	}
+	_, err := uploader.UploadWithContext(ctx, input)
+	return err
+}
+
+// COSObjectETag computes the ETag that COS returns for the body when it is
+// uploaded with uploadCOSObject, along with the MD5 hexdigest of the body. The
+// ETag of a multipart upload is the MD5 of the MD5 digests of the parts
+// followed by the number of parts. The body is read once.
+func COSObjectETag(body io.ReadSeeker, partSize int64) (string, string, error) {
+	size, err := body.Seek(0, io.SeekEnd)
+	if err != nil {
+		return "", "", err
+	}
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return "", "", err
+	}
+	// The uploader increases the part size of bodies that exceed the
+	// maximum number of parts
+	if size/partSize >= int64(s3manager.MaxUploadParts) {
+		partSize = (size / int64(s3manager.MaxUploadParts)) + 1
+	}
+
+	content := md5.New()
+	var digests []byte
+	parts := 0
+	for remaining := size; remaining > 0; remaining -= partSize {
+		hash := md5.New()
+		if _, err := io.CopyN(io.MultiWriter(content, hash), body, partSize); err != nil && err != io.EOF {
+			return "", "", err
+		}
+		digests = append(digests, hash.Sum(nil)...)
+		parts++
+	}
+	contentMD5 := hex.EncodeToString(content.Sum(nil))
+	if parts <= 1 {
+		return contentMD5, contentMD5, nil
+	}
+	sum := md5.Sum(digests)
+	return fmt.Sprintf("%s-%d", hex.EncodeToString(sum[:]), parts), contentMD5, nil
+}
+
+// suppressCOSObjectMultipartETagDiff compares the ETag of an object uploaded
+// in parts with the MD5 hexdigest of the content, e.g. filemd5(). The diff is
+// suppressed when the content matches the etag and the object in the bucket.
+func suppressCOSObjectMultipartETagDiff(k, old, new string, d *schema.ResourceData) bool {
+	if !strings.Contains(old, "-") || strings.Contains(new, "-") || new == "" {
+		return false
+	}
+	body, closeBody, err := cosObjectBody(d)
+	if err != nil {
+		return false
+	}
+	defer closeBody()
+
+	multipartETag, contentMD5, err := COSObjectETag(body, cosObjectPartSize(d))
+	if err != nil {
+		return false
+	}
+	return contentMD5 == new && multipartETag == old
+}
+
+// SuppressCOSObjectRetainUntilDateDiff compares the retain until dates as
+// times, COS returns the date in UTC while it can be configured in any offset.
+func SuppressCOSObjectRetainUntilDateDiff(k, old, new string, d *schema.ResourceData) bool {
+	oldDate, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newDate, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldDate.Equal(newDate)
+}
+
 func getCosEndpoint(bucketLocation string, endpointType string) string {
 	if bucketLocation != "" {
 		switch endpointType {
@@ -625,3 +754,163 @@ func deleteCOSObjectVersion(conn *s3.S3, b, k, v string, force bool) error {
 
 	return err
 }
+
+// putCOSObjectLock applies the retention and the legal hold of the
+// configuration to the latest version of the object. The retention that the
+// default retention of the bucket applied is only kept in the state.
+func putCOSObjectLock(d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string) error {
+	rawConfig := d.GetRawConfig()
+	if mode, ok := d.GetOk("object_lock_mode"); ok && cosObjectLockConfigured(rawConfig, "object_lock_mode") {
+		retainUntilDate, err := time.Parse(time.RFC3339, d.Get("object_lock_retain_until_date").(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error parsing object_lock_retain_until_date: %s", err)
+		}
+		input := &s3.PutObjectRetentionInput{
+			Bucket: aws.String(bucketName),
+			Key:    aws.String(objectKey),
+			Retention: &s3.ObjectLockRetention{
+				Mode:            aws.String(mode.(string)),
+				RetainUntilDate: aws.Time(retainUntilDate),
+			},
+		}
+		if _, err := s3Client.PutObjectRetention(input); err != nil {
+			return fmt.Errorf("[ERROR] Error putting retention of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+	}
+	if status, ok := d.GetOk("object_lock_legal_hold_status"); ok && cosObjectLockConfigured(rawConfig, "object_lock_legal_hold_status") {
+		input := &s3.PutObjectLegalHoldInput{
+			Bucket:    aws.String(bucketName),
+			Key:       aws.String(objectKey),
+			LegalHold: &s3.ObjectLockLegalHold{Status: aws.String(status.(string))},
+		}
+		if _, err := s3Client.PutObjectLegalHold(input); err != nil {
+			return fmt.Errorf("[ERROR] Error putting legal hold of object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+		}
+	}
+	return nil
+}
+
+// readCOSObjectLock reads the retention and the legal hold of the latest
+// version of the object. Buckets without Object Lock return an error that is
+// only reported when the object lock attributes are configured.
+func readCOSObjectLock(d *schema.ResourceData, s3Client *s3.S3, bucketName, objectKey string) error {
+	retention, err := s3Client.GetObjectRetention(&s3.GetObjectRetentionInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil && !isCOSObjectLockNotFound(err) {
+		if _, ok := d.GetOk("object_lock_mode"); ok {
+			return fmt.Errorf("failed getting retention of COS bucket (%s) object (%s): %w", bucketName, objectKey, err)
+		}
+		log.Printf("[DEBUG] Error getting retention of COS bucket (%s) object (%s): %s", bucketName, objectKey, err)
+	}
+	if err == nil && retention.Retention != nil {
+		d.Set("object_lock_mode", aws.StringValue(retention.Retention.Mode))
+		if retention.Retention.RetainUntilDate != nil {
+			d.Set("object_lock_retain_until_date", retention.Retention.RetainUntilDate.Format(time.RFC3339))
+		}
+	} else {
+		d.Set("object_lock_mode", nil)
+		d.Set("object_lock_retain_until_date", nil)
+	}
+
+	legalHold, err := s3Client.GetObjectLegalHold(&s3.GetObjectLegalHoldInput{
+		Bucket: aws.String(bucketName),
+		Key:    aws.String(objectKey),
+	})
+	if err != nil && !isCOSObjectLockNotFound(err) {
+		if _, ok := d.GetOk("object_lock_legal_hold_status"); ok {
+			return fmt.Errorf("failed getting legal hold of COS bucket (%s) object (%s): %w", bucketName, objectKey, err)
+		}
+		log.Printf("[DEBUG] Error getting legal hold of COS bucket (%s) object (%s): %s", bucketName, objectKey, err)
+	}
+	if err == nil && legalHold.LegalHold != nil {
+		d.Set("object_lock_legal_hold_status", aws.StringValue(legalHold.LegalHold.Status))
+	} else {
+		d.Set("object_lock_legal_hold_status", nil)
+	}
+	return nil
+}
+
+// cosObjectLockConfigured reports whether an object lock attribute is set in
+// the configuration rather than read from the default retention of the bucket.
+func cosObjectLockConfigured(rawConfig cty.Value, attr string) bool {
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return false
+	}
+	v := rawConfig.GetAttr(attr)
+	return !v.IsKnown() || !v.IsNull()
+}
+
+func isCOSObjectLockNotFound(err error) bool {
+	if aerr, ok := err.(awserr.Error); ok {
+		switch aerr.Code() {
+		case "NoSuchObjectLockConfiguration", "ObjectLockConfigurationNotFoundError":
+			return true
+		}
+	}
+	return false
+}
+
+// resourceIBMCOSBucketObjectLockValidate checks the configured retention of
+// the object against the Object Lock and the object versioning of the bucket.
+// A retention that is not configured, such as the default retention of the
+// bucket, is kept in the state and not checked.
+func resourceIBMCOSBucketObjectLockValidate(_ context.Context, diff *schema.ResourceDiff, m interface{}) error {
+	rawConfig := diff.GetRawConfig()
+	retention := cosObjectLockConfigured(rawConfig, "object_lock_mode")
+	legalHold := cosObjectLockConfigured(rawConfig, "object_lock_legal_hold_status")
+	if !retention && !legalHold {
+		return nil
+	}
+
+	if diff.Id() != "" && diff.HasChange("object_lock_retain_until_date") {
+		o, n := diff.GetChange("object_lock_retain_until_date")
+		if o.(string) != "" && n.(string) != "" {
+			oldDate, oldErr := time.Parse(time.RFC3339, o.(string))
+			newDate, newErr := time.Parse(time.RFC3339, n.(string))
+			if oldErr == nil && newErr == nil && newDate.Before(oldDate) && time.Now().Before(oldDate) {
+				return fmt.Errorf("[ERROR] The object_lock_retain_until_date of a COMPLIANCE retention can be extended but not shortened, current date is %s", o.(string))
+			}
+		}
+	}
+
+	// The bucket is only known once it exists
+	if !diff.NewValueKnown("bucket_crn") || !diff.NewValueKnown("bucket_location") || !diff.NewValueKnown("endpoint_type") {
+		return nil
+	}
+	bucketCRN := diff.Get("bucket_crn").(string)
+	if !strings.Contains(bucketCRN, ":bucket:") {
+		return nil
+	}
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	s3Client, err := getS3Client(bxSession, diff.Get("bucket_location").(string), diff.Get("endpoint_type").(string), instanceCRN)
+	if err != nil {
+		return err
+	}
+
+	versioning, err := s3Client.GetBucketVersioning(&s3.GetBucketVersioningInput{Bucket: aws.String(bucketName)})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == "NoSuchBucket" {
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error getting the object versioning of COS bucket (%s): %s", bucketName, err)
+	}
+	if aws.StringValue(versioning.Status) != s3.BucketVersioningStatusEnabled {
+		return fmt.Errorf("[ERROR] The object lock attributes require object versioning, enable object_versioning on COS bucket (%s)", bucketName)
+	}
+	objectLock, err := s3Client.GetObjectLockConfiguration(&s3.GetObjectLockConfigurationInput{Bucket: aws.String(bucketName)})
+	if err != nil && !isCOSObjectLockNotFound(err) {
+		return fmt.Errorf("[ERROR] Error getting the object lock configuration of COS bucket (%s): %s", bucketName, err)
+	}
+	if err != nil || objectLock.ObjectLockConfiguration == nil || aws.StringValue(objectLock.ObjectLockConfiguration.ObjectLockEnabled) != "Enabled" {
+		return fmt.Errorf("[ERROR] The object lock attributes require Object Lock, set object_lock_configuration on COS bucket (%s)", bucketName)
+	}
+	return nil
+}
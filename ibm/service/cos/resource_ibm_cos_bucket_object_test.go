@@ -4,15 +4,27 @@
 package cos_test
 
 import (
+	"bytes"
+	"context"
+	"crypto/md5"
 	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/cos"
 
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 )
 
 func TestAccIBMCOSBucketObject_basic(t *testing.T) {
@@ -68,6 +80,142 @@ func TestAccIBMCOSBucketObject_basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMCOSBucketObject_multipart(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	objectFile := filepath.Join(t.TempDir(), "multipart.bin")
+	if err := os.WriteFile(objectFile, make([]byte, 12*1024*1024), 0644); err != nil {
+		t.Fatal(err)
+	}
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "content_length", "12582912"),
+					resource.TestMatchResourceAttr("ibm_cos_bucket_object.testacc", "etag", regexp.MustCompile("^[0-9a-f]{32}-3$")),
+				),
+			},
+			{
+				// The multipart ETag matches filemd5() of the uploaded file
+				Config:   testAccIBMCOSBucketObjectConfig_multipart(name, instanceCRN, objectFile),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccIBMCOSBucketObject_objectLock(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	retainUntilDate := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	extendedRetainUntilDate := time.Now().Add(48 * time.Hour).UTC().Format(time.RFC3339)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_objectLock(name, instanceCRN, retainUntilDate, "ON"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_cos_bucket_object.testacc", "version_id"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_retain_until_date", retainUntilDate),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_legal_hold_status", "ON"),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketObjectConfig_objectLock(name, instanceCRN, extendedRetainUntilDate, "OFF"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_retain_until_date", extendedRetainUntilDate),
+					resource.TestCheckResourceAttr("ibm_cos_bucket_object.testacc", "object_lock_legal_hold_status", "OFF"),
+				),
+			},
+			{
+				Config:      testAccIBMCOSBucketObjectConfig_objectLock(name, instanceCRN, retainUntilDate, "OFF"),
+				ExpectError: regexp.MustCompile("can be extended but not shortened"),
+			},
+		},
+	})
+}
+
+func TestAccIBMCOSBucketObject_objectLockWithoutVersioning(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	retainUntilDate := time.Now().Add(24 * time.Hour).UTC().Format(time.RFC3339)
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectConfig_plaintext(name, instanceCRN, "Acceptance Testing"),
+			},
+			{
+				Config:      testAccIBMCOSBucketObjectConfig_objectLockWithoutVersioning(name, instanceCRN, retainUntilDate),
+				ExpectError: regexp.MustCompile("require object versioning"),
+			},
+		},
+	})
+}
+
+func TestSuppressCOSObjectRetainUntilDateDiff(t *testing.T) {
+	for _, c := range []struct {
+		o, n     string
+		suppress bool
+	}{
+		{"2030-01-01T00:00:00Z", "2030-01-01T01:00:00+01:00", true},
+		{"2030-01-01T00:00:00Z", "2030-01-01T00:00:00Z", true},
+		{"2030-01-01T00:00:00Z", "2030-01-01T00:00:00+01:00", false},
+		{"", "2030-01-01T00:00:00Z", false},
+		{"2030-01-01T00:00:00Z", "", false},
+	} {
+		if got := cos.SuppressCOSObjectRetainUntilDateDiff("object_lock_retain_until_date", c.o, c.n, nil); got != c.suppress {
+			t.Errorf("SuppressCOSObjectRetainUntilDateDiff(%q, %q) = %t, want %t", c.o, c.n, got, c.suppress)
+		}
+	}
+}
+
+func TestCOSObjectETag(t *testing.T) {
+	content := []byte("0123456789ab")
+	contentSum := md5.Sum(content)
+	contentMD5 := hex.EncodeToString(contentSum[:])
+
+	etag, md5hex, err := cos.COSObjectETag(bytes.NewReader(content), 12)
+	if err != nil {
+		t.Fatalf("COSObjectETag returned an error: %s", err)
+	}
+	if etag != contentMD5 || md5hex != contentMD5 {
+		t.Errorf("single part: etag = %s, md5 = %s, want %s", etag, md5hex, contentMD5)
+	}
+
+	var digests []byte
+	for _, part := range [][]byte{content[:5], content[5:10], content[10:]} {
+		sum := md5.Sum(part)
+		digests = append(digests, sum[:]...)
+	}
+	multipartSum := md5.Sum(digests)
+	etag, md5hex, err = cos.COSObjectETag(bytes.NewReader(content), 5)
+	if err != nil {
+		t.Fatalf("COSObjectETag returned an error: %s", err)
+	}
+	if want := hex.EncodeToString(multipartSum[:]) + "-3"; etag != want {
+		t.Errorf("multipart: etag = %s, want %s", etag, want)
+	}
+	if md5hex != contentMD5 {
+		t.Errorf("multipart: md5 = %s, want %s", md5hex, contentMD5)
+	}
+
+	emptySum := md5.Sum(nil)
+	etag, _, err = cos.COSObjectETag(bytes.NewReader(nil), 5)
+	if err != nil {
+		t.Fatalf("COSObjectETag returned an error: %s", err)
+	}
+	if want := hex.EncodeToString(emptySum[:]); etag != want {
+		t.Errorf("empty: etag = %s, want %s", etag, want)
+	}
+}
+
 func testAccIBMCOSBucketObjectConfig_plaintext(name string, instanceCRN string, objectBody string) string {
 	return fmt.Sprintf(`
 		resource "ibm_cos_bucket" "testacc" {
@@ -115,3 +263,117 @@ func testAccIBMCOSBucketObjectConfig_file(name string, instanceCRN string, objec
 			content_file	  = "%[3]s"
 		}`, name, instanceCRN, objectFile)
 }
+
+func testAccIBMCOSBucketObjectConfig_objectLock(name string, instanceCRN string, retainUntilDate string, legalHoldStatus string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+			object_versioning {
+				enable = true
+			}
+			object_lock_configuration {
+				object_lock_enabled = "Enabled"
+			}
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn                    = ibm_cos_bucket.testacc.crn
+			bucket_location               = ibm_cos_bucket.testacc.region_location
+			content                       = "Acceptance Testing"
+			key                           = "%[1]s.txt"
+			object_lock_mode              = "COMPLIANCE"
+			object_lock_retain_until_date = "%[3]s"
+			object_lock_legal_hold_status = "%[4]s"
+			force_delete                  = true
+		}
+	`, name, instanceCRN, retainUntilDate, legalHoldStatus)
+}
+
+func testAccIBMCOSBucketObjectConfig_objectLockWithoutVersioning(name string, instanceCRN string, retainUntilDate string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn                    = ibm_cos_bucket.testacc.crn
+			bucket_location               = ibm_cos_bucket.testacc.region_location
+			content                       = "Acceptance Testing"
+			key                           = "%[1]s.txt"
+			object_lock_mode              = "COMPLIANCE"
+			object_lock_retain_until_date = "%[3]s"
+		}
+	`, name, instanceCRN, retainUntilDate)
+}
+
+func testAccIBMCOSBucketObjectConfig_multipart(name string, instanceCRN string, objectFile string) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_object" "testacc" {
+			bucket_crn         = ibm_cos_bucket.testacc.crn
+			bucket_location    = ibm_cos_bucket.testacc.region_location
+			content_file       = "%[3]s"
+			key                = "%[1]s.bin"
+			etag               = filemd5("%[3]s")
+			part_size          = 5
+			upload_concurrency = 3
+		}
+	`, name, instanceCRN, objectFile)
+}
+
+func TestCOSBucketObjectDefaultRetentionDiff(t *testing.T) {
+	bucketCRN := "crn:v1:bluemix:public:cloud-object-storage:global:a/1234:5678:bucket:my-bucket"
+	objectID := bucketCRN + ":object:my-object:location:us-south"
+	// The default retention of the bucket applied a retention that is not configured
+	state := &terraform.InstanceState{
+		ID: objectID,
+		Attributes: map[string]string{
+			"id":                            objectID,
+			"bucket_crn":                    bucketCRN,
+			"bucket_location":               "us-south",
+			"key":                           "my-object",
+			"content":                       "content",
+			"endpoint_type":                 "public",
+			"force_delete":                  "true",
+			"object_lock_mode":              "COMPLIANCE",
+			"object_lock_retain_until_date": "2030-01-01T00:00:00Z",
+		},
+	}
+	raw := map[string]interface{}{
+		"bucket_crn":      bucketCRN,
+		"bucket_location": "us-south",
+		"key":             "my-object",
+		"content":         "content",
+	}
+
+	r := cos.ResourceIBMCOSBucketObject()
+	rawJSON, err := json.Marshal(raw)
+	if err != nil {
+		t.Fatal(err)
+	}
+	state.RawConfig, err = ctyjson.Unmarshal(rawJSON, r.CoreConfigSchema().ImpliedType())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil)
+	if err != nil {
+		t.Fatalf("Diff returned an error: %s", err)
+	}
+	if diff != nil {
+		for _, attr := range []string{"object_lock_mode", "object_lock_retain_until_date", "object_lock_legal_hold_status"} {
+			if d, ok := diff.Attributes[attr]; ok {
+				t.Errorf("unexpected diff of %s: %#v", attr, d)
+			}
+		}
+	}
+}
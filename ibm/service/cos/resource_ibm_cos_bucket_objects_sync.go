@@ -0,0 +1,445 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/fs"
+	"log"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// cosDeleteObjectsBatchSize is the maximum number of keys of a DeleteObjects request
+const cosDeleteObjectsBatchSize = 1000
+
+func ResourceIBMCOSBucketObjectsSync() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMCOSBucketObjectsSyncCreate,
+		ReadContext:   resourceIBMCOSBucketObjectsSyncRead,
+		UpdateContext: resourceIBMCOSBucketObjectsSyncUpdate,
+		DeleteContext: resourceIBMCOSBucketObjectsSyncDelete,
+		CustomizeDiff: resourceIBMCOSBucketObjectsSyncDiff,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+			},
+			"source_dir": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Local directory whose files are synced to the bucket",
+			},
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Prefix of the object keys, e.g. site/. The key of an object is the prefix followed by the path of the file relative to source_dir",
+			},
+			"exclude": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Glob patterns of the files that are not synced, matched against the relative path and the name of a file",
+			},
+			"content_types": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Content type of the objects by file extension, e.g. .md = text/markdown. The content type of other files is inferred from the extension and the content",
+			},
+			"delete_extra_objects": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Delete the objects under the prefix that do not have a file in source_dir",
+			},
+			"part_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validate.ValidateAllowedRangeInt(5, 5120),
+				Description:  "Size in MiB of the parts of a multipart upload, files larger than the part size are uploaded in parts",
+			},
+			"upload_concurrency": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				ValidateFunc: validate.ValidateAllowedRangeInt(1, 100),
+				Description:  "Number of parallel uploads, shared by the files and the parts of the files",
+			},
+			"objects": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "ETag of the synced objects by path relative to the prefix",
+			},
+		},
+	}
+}
+
+// cosLocalObject is a file of source_dir and the ETag of its object
+type cosLocalObject struct {
+	path string
+	etag string
+}
+
+func resourceIBMCOSBucketObjectsSyncCreate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	bucketCRN := d.Get("bucket_crn").(string)
+	bucketLocation := d.Get("bucket_location").(string)
+
+	if err := syncCOSBucketObjects(ctx, d, m, map[string]interface{}{}); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s:objects:%s:location:%s", bucketCRN, d.Get("prefix").(string), bucketLocation))
+
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncRead(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := getCOSObjectsSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	prefix := d.Get("prefix").(string)
+
+	remote, err := listCOSObjectETags(ctx, s3Client, bucketName, prefix)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("failed listing objects of COS bucket (%s) with prefix (%s): %w", bucketName, prefix, err))
+	}
+
+	// Only the synced objects are tracked, unless the extra objects are deleted
+	objects := map[string]string{}
+	managed := d.Get("objects").(map[string]interface{})
+	deleteExtra := d.Get("delete_extra_objects").(bool)
+	for key, etag := range remote {
+		if _, ok := managed[key]; ok || deleteExtra {
+			objects[key] = etag
+		}
+	}
+	d.Set("objects", objects)
+	return nil
+}
+
+func resourceIBMCOSBucketObjectsSyncUpdate(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	o, _ := d.GetChange("objects")
+	if err := syncCOSBucketObjects(ctx, d, m, o.(map[string]interface{})); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMCOSBucketObjectsSyncRead(ctx, d, m)
+}
+
+func resourceIBMCOSBucketObjectsSyncDelete(ctx context.Context, d *schema.ResourceData, m interface{}) diag.Diagnostics {
+	s3Client, bucketName, err := getCOSObjectsSyncClient(d, m)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	prefix := d.Get("prefix").(string)
+
+	var keys []string
+	for key := range d.Get("objects").(map[string]interface{}) {
+		keys = append(keys, prefix+key)
+	}
+	if err := deleteCOSObjects(ctx, s3Client, bucketName, keys); err != nil {
+		return diag.FromErr(err)
+	}
+	return nil
+}
+
+// resourceIBMCOSBucketObjectsSyncDiff compares the ETags of the local files
+// with the ETags of the objects, a changed file shows as a change of objects.
+func resourceIBMCOSBucketObjectsSyncDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("source_dir") || !diff.NewValueKnown("exclude") || !diff.NewValueKnown("part_size") {
+		return diff.SetNewComputed("objects")
+	}
+
+	local, err := listCOSLocalObjects(diff.Get("source_dir").(string), flex.ExpandStringList(diff.Get("exclude").([]interface{})), int64(diff.Get("part_size").(int))*1024*1024)
+	if err != nil {
+		return err
+	}
+	objects := map[string]interface{}{}
+	for key, object := range local {
+		objects[key] = object.etag
+	}
+
+	if diff.Id() == "" || !reflect.DeepEqual(objects, diff.Get("objects").(map[string]interface{})) {
+		return diff.SetNew("objects", objects)
+	}
+	return nil
+}
+
+// syncCOSBucketObjects uploads the files whose ETag differs from the ETag of
+// their object and deletes the objects of removed files. The objects under the
+// prefix without a file are deleted as well when delete_extra_objects is set.
+func syncCOSBucketObjects(ctx context.Context, d *schema.ResourceData, m interface{}, previous map[string]interface{}) error {
+	s3Client, bucketName, err := getCOSObjectsSyncClient(d, m)
+	if err != nil {
+		return err
+	}
+	prefix := d.Get("prefix").(string)
+	partSize := int64(d.Get("part_size").(int)) * 1024 * 1024
+	concurrency := d.Get("upload_concurrency").(int)
+	contentTypes := d.Get("content_types").(map[string]interface{})
+
+	local, err := listCOSLocalObjects(d.Get("source_dir").(string), flex.ExpandStringList(d.Get("exclude").([]interface{})), partSize)
+	if err != nil {
+		return err
+	}
+	remote, err := listCOSObjectETags(ctx, s3Client, bucketName, prefix)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing objects of COS bucket (%s) with prefix (%s): %s", bucketName, prefix, err)
+	}
+
+	var pending []string
+	for key, object := range local {
+		if remote[key] != object.etag {
+			pending = append(pending, key)
+		}
+	}
+	// upload_concurrency is split between the files and their parts, a single
+	// file is uploaded with all of it
+	workers := concurrency
+	if len(pending) < workers {
+		workers = len(pending)
+	}
+	partConcurrency := 1
+	if workers > 0 {
+		partConcurrency = concurrency / workers
+	}
+
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	var uploadErr error
+	uploads := make(chan string)
+	for i := 0; i < workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for key := range uploads {
+				err := uploadCOSLocalObject(ctx, s3Client, bucketName, prefix+key, local[key].path, contentTypes, partSize, partConcurrency)
+				if err != nil {
+					mutex.Lock()
+					if uploadErr == nil {
+						uploadErr = err
+					}
+					mutex.Unlock()
+				}
+			}
+		}()
+	}
+	for _, key := range pending {
+		log.Printf("[INFO] Uploading %s to COS bucket (%s) object (%s)", local[key].path, bucketName, prefix+key)
+		uploads <- key
+	}
+	close(uploads)
+	wg.Wait()
+	if uploadErr != nil {
+		return uploadErr
+	}
+
+	var deletes []string
+	for key := range remote {
+		if _, ok := local[key]; ok {
+			continue
+		}
+		if _, ok := previous[key]; ok || d.Get("delete_extra_objects").(bool) {
+			deletes = append(deletes, prefix+key)
+		}
+	}
+	return deleteCOSObjects(ctx, s3Client, bucketName, deletes)
+}
+
+func getCOSObjectsSyncClient(d *schema.ResourceData, m interface{}) (*s3.S3, string, error) {
+	bucketCRN := d.Get("bucket_crn").(string)
+	if !strings.Contains(bucketCRN, ":bucket:") {
+		return nil, "", fmt.Errorf("[ERROR] Error parsing bucket_crn (%s)", bucketCRN)
+	}
+	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
+	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
+
+	bxSession, err := m.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return nil, "", err
+	}
+	s3Client, err := getS3Client(bxSession, d.Get("bucket_location").(string), d.Get("endpoint_type").(string), instanceCRN)
+	if err != nil {
+		return nil, "", err
+	}
+	return s3Client, bucketName, nil
+}
+
+// listCOSLocalObjects walks the source directory and returns the files by
+// slash separated path relative to the directory.
+func listCOSLocalObjects(sourceDir string, exclude []string, partSize int64) (map[string]cosLocalObject, error) {
+	objects := map[string]cosLocalObject{}
+	err := filepath.WalkDir(sourceDir, func(filePath string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if entry.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(sourceDir, filePath)
+		if err != nil {
+			return err
+		}
+		key := filepath.ToSlash(rel)
+		for _, pattern := range exclude {
+			matchPath, _ := path.Match(pattern, key)
+			matchName, _ := path.Match(pattern, entry.Name())
+			if matchPath || matchName {
+				return nil
+			}
+		}
+
+		file, err := os.Open(filePath)
+		if err != nil {
+			return err
+		}
+		defer file.Close()
+		etag, _, err := COSObjectETag(file, partSize)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error computing the checksum of %s: %s", filePath, err)
+		}
+		objects[key] = cosLocalObject{path: filePath, etag: etag}
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error reading source_dir (%s): %s", sourceDir, err)
+	}
+	return objects, nil
+}
+
+// listCOSObjectETags returns the ETags of the objects under the prefix by key
+// relative to the prefix.
+func listCOSObjectETags(ctx context.Context, s3Client *s3.S3, bucketName, prefix string) (map[string]string, error) {
+	etags := map[string]string{}
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(bucketName),
+	}
+	if prefix != "" {
+		input.Prefix = aws.String(prefix)
+	}
+	err := s3Client.ListObjectsV2PagesWithContext(ctx, input, func(page *s3.ListObjectsV2Output, lastPage bool) bool {
+		for _, object := range page.Contents {
+			key := strings.TrimPrefix(aws.StringValue(object.Key), prefix)
+			etags[key] = strings.Trim(aws.StringValue(object.ETag), `"`)
+		}
+		return !lastPage
+	})
+	return etags, err
+}
+
+// cosObjectContentType returns the content type of the file from content_types,
+// its extension or its first 512 bytes.
+func cosObjectContentType(file io.ReadSeeker, filePath string, contentTypes map[string]interface{}) (string, error) {
+	ext := strings.ToLower(filepath.Ext(filePath))
+	if contentType, ok := contentTypes[ext]; ok {
+		return contentType.(string), nil
+	}
+	if contentType := mime.TypeByExtension(ext); contentType != "" {
+		return contentType, nil
+	}
+
+	buffer := make([]byte, 512)
+	n, err := io.ReadFull(file, buffer)
+	if err != nil && err != io.EOF && err != io.ErrUnexpectedEOF {
+		return "", err
+	}
+	if _, err := file.Seek(0, io.SeekStart); err != nil {
+		return "", err
+	}
+	return http.DetectContentType(buffer[:n]), nil
+}
+
+func uploadCOSLocalObject(ctx context.Context, s3Client *s3.S3, bucketName, objectKey, filePath string, contentTypes map[string]interface{}, partSize int64, concurrency int) error {
+	file, err := os.Open(filePath)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error opening COS object file (%s): %s", filePath, err)
+	}
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("[WARN] Failed closing COS object file (%s): %s", filePath, err)
+		}
+	}()
+
+	contentType, err := cosObjectContentType(file, filePath, contentTypes)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error reading COS object file (%s): %s", filePath, err)
+	}
+	if err := uploadCOSObject(ctx, s3Client, bucketName, objectKey, file, contentType, partSize, concurrency); err != nil {
+		return fmt.Errorf("[ERROR] Error putting object (%s) in COS bucket (%s): %s", objectKey, bucketName, err)
+	}
+	return nil
+}
+
+// deleteCOSObjects deletes the objects in batches of cosDeleteObjectsBatchSize keys
+func deleteCOSObjects(ctx context.Context, s3Client *s3.S3, bucketName string, keys []string) error {
+	for start := 0; start < len(keys); start += cosDeleteObjectsBatchSize {
+		end := start + cosDeleteObjectsBatchSize
+		if end > len(keys) {
+			end = len(keys)
+		}
+		var objects []*s3.ObjectIdentifier
+		for _, key := range keys[start:end] {
+			log.Printf("[INFO] Deleting COS Bucket (%s) Object (%s)", bucketName, key)
+			objects = append(objects, &s3.ObjectIdentifier{Key: aws.String(key)})
+		}
+		out, err := s3Client.DeleteObjectsWithContext(ctx, &s3.DeleteObjectsInput{
+			Bucket: aws.String(bucketName),
+			Delete: &s3.Delete{
+				Objects: objects,
+				Quiet:   aws.Bool(true),
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error deleting objects of COS bucket (%s): %s", bucketName, err)
+		}
+		if len(out.Errors) > 0 {
+			return fmt.Errorf("[ERROR] Error deleting object (%s) of COS bucket (%s): %s", aws.StringValue(out.Errors[0].Key), bucketName, aws.StringValue(out.Errors[0].Message))
+		}
+	}
+	return nil
+}
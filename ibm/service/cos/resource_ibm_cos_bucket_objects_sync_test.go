@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package cos_test
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCOSBucketObjectsSync_basic(t *testing.T) {
+	name := fmt.Sprintf("tf-testacc-cos-%d", acctest.RandIntRange(10, 100))
+	instanceCRN := acc.CosCRN
+	sourceDir := t.TempDir()
+	resourceName := "ibm_cos_bucket_objects_sync.testacc"
+
+	writeFile := func(name, content string) {
+		filePath := filepath.Join(sourceDir, name)
+		if err := os.MkdirAll(filepath.Dir(filePath), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(filePath, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html><body>Acceptance Testing</body></html>")
+	writeFile("docs/readme.md", "# Acceptance Testing")
+	writeFile("docs/.DS_Store", "excluded")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheckCOS(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "2"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.index.html"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.docs/readme.md"),
+				),
+			},
+			{
+				// A changed file and a removed file are synced
+				PreConfig: func() {
+					writeFile("index.html", "<html><body>Updated</body></html>")
+					if err := os.Remove(filepath.Join(sourceDir, "docs/readme.md")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "objects.index.html"),
+				),
+			},
+			{
+				// The object outside of the sync is only deleted with delete_extra_objects
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir, false) + testAccIBMCOSBucketObjectsSyncExtraConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "1"),
+				),
+			},
+			{
+				Config: testAccIBMCOSBucketObjectsSyncConfig(name, instanceCRN, sourceDir, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "objects.%", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccIBMCOSBucketObjectsSyncConfig(name string, instanceCRN string, sourceDir string, deleteExtra bool) string {
+	return fmt.Sprintf(`
+		resource "ibm_cos_bucket" "testacc" {
+			bucket_name          = "%[1]s"
+			resource_instance_id = "%[2]s"
+			region_location      = "us-east"
+			storage_class        = "standard"
+		}
+		resource "ibm_cos_bucket_objects_sync" "testacc" {
+			bucket_crn           = ibm_cos_bucket.testacc.crn
+			bucket_location      = ibm_cos_bucket.testacc.region_location
+			source_dir           = "%[3]s"
+			prefix               = "site/"
+			exclude              = [".DS_Store"]
+			delete_extra_objects = %[4]t
+			content_types = {
+				".md" = "text/markdown"
+			}
+		}
+	`, name, instanceCRN, sourceDir, deleteExtra)
+}
+
+func testAccIBMCOSBucketObjectsSyncExtraConfig() string {
+	return `
+		resource "ibm_cos_bucket_object" "extra" {
+			bucket_crn      = ibm_cos_bucket.testacc.crn
+			bucket_location = ibm_cos_bucket.testacc.region_location
+			content         = "Acceptance Testing"
+			key             = "site/extra.txt"
+		}
+	`
+}
@@ -0,0 +1,152 @@
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSBucketPublicAccessBlock() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketPublicAccessBlockCreate,
+		Read:     resourceIBMCOSBucketPublicAccessBlockRead,
+		Update:   resourceIBMCOSBucketPublicAccessBlockUpdate,
+		Delete:   resourceIBMCOSBucketPublicAccessBlockDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+				ForceNew:     true,
+			},
+			"block_public_acls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Reject requests that set a public ACL on the bucket or its objects.",
+			},
+			"ignore_public_acls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Ignore the public ACLs of the bucket and its objects.",
+			},
+		},
+	}
+}
+
+func putCOSBucketPublicAccessBlock(d *schema.ResourceData, meta interface{}) error {
+	s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
+	if err != nil {
+		return err
+	}
+
+	putPublicAccessBlockInput := &s3.PutPublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+		PublicAccessBlockConfiguration: &s3.PublicAccessBlockConfiguration{
+			BlockPublicAcls:  aws.Bool(d.Get("block_public_acls").(bool)),
+			IgnorePublicAcls: aws.Bool(d.Get("ignore_public_acls").(bool)),
+		},
+	}
+	_, err = s3Client.PutPublicAccessBlock(putPublicAccessBlockInput)
+	if err != nil {
+		return fmt.Errorf("failed to set the public access block on COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketPublicAccessBlockCreate(d *schema.ResourceData, meta interface{}) error {
+	id, err := cosBucketConfigurationId(d)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	if err := putCOSBucketPublicAccessBlock(d, meta); err != nil {
+		d.SetId("")
+		return err
+	}
+	return resourceIBMCOSBucketPublicAccessBlockRead(d, meta)
+}
+
+func resourceIBMCOSBucketPublicAccessBlockUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("block_public_acls") || d.HasChange("ignore_public_acls") {
+		if err := putCOSBucketPublicAccessBlock(d, meta); err != nil {
+			return err
+		}
+	}
+	return resourceIBMCOSBucketPublicAccessBlockRead(d, meta)
+}
+
+func resourceIBMCOSBucketPublicAccessBlockRead(d *schema.ResourceData, meta interface{}) error {
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", parseBucketReplId(d.Id(), "bucketCRN"))
+	d.Set("bucket_location", parseBucketReplId(d.Id(), "bucketLocation"))
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
+	if err != nil {
+		return err
+	}
+
+	publicAccessBlock, err := s3Client.GetPublicAccessBlock(&s3.GetPublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchPublicAccessBlockConfiguration") || strings.Contains(err.Error(), "NoSuchBucket") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to read the public access block of COS bucket %s, %v", bucketName, err)
+	}
+
+	if configuration := publicAccessBlock.PublicAccessBlockConfiguration; configuration != nil {
+		d.Set("block_public_acls", aws.BoolValue(configuration.BlockPublicAcls))
+		d.Set("ignore_public_acls", aws.BoolValue(configuration.IgnorePublicAcls))
+	}
+	return nil
+}
+
+func resourceIBMCOSBucketPublicAccessBlockDelete(d *schema.ResourceData, meta interface{}) error {
+	s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
+	if err != nil {
+		return err
+	}
+
+	_, err = s3Client.DeletePublicAccessBlock(&s3.DeletePublicAccessBlockInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete the public access block of COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
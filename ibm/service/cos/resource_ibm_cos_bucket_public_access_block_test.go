@@ -0,0 +1,54 @@
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucket_Public_Access_Block(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-pab%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_cos_bucket_public_access_block.public_access_block"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_publicAccessBlock(serviceName, bucketName, true, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "block_public_acls", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_public_acls", "true"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_publicAccessBlock(serviceName, bucketName, true, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "block_public_acls", "true"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_public_acls", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMCosBucket_publicAccessBlock(serviceName, bucketName string, blockPublicAcls, ignorePublicAcls bool) string {
+	return testAccCheckIBMCosBucket_configurationBucket(serviceName, bucketName) + fmt.Sprintf(`
+	resource "ibm_cos_bucket_public_access_block" "public_access_block" {
+		bucket_crn         = ibm_cos_bucket.bucket.crn
+		bucket_location    = ibm_cos_bucket.bucket.region_location
+		block_public_acls  = %t
+		ignore_public_acls = %t
+	}
+	`, blockPublicAcls, ignorePublicAcls)
+}
@@ -576,6 +576,47 @@ func TestAccIBMCosBucket_Object_Versioning(t *testing.T) {
 	})
 }
 
+func TestAccIBMCosBucket_Object_Lock(t *testing.T) {
+
+	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform%d", acctest.RandIntRange(10, 100))
+	bucketRegion := "us-east"
+	bucketClass := "standard"
+	bucketRegionType := "region_location"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMCosBucket_object_lock(cosServiceName, bucketName, bucketRegion, bucketClass, false, 1),
+				ExpectError: regexp.MustCompile("Object Lock requires object versioning"),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_object_lock(cosServiceName, bucketName, bucketRegion, bucketClass, true, 1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMCosBucketExists("ibm_resource_instance.instance", "ibm_cos_bucket.bucket", bucketRegionType, bucketRegion, bucketName),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.#", "1"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.object_lock_enabled", "Enabled"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.object_lock_rule.0.default_retention.0.mode", "COMPLIANCE"),
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.object_lock_rule.0.default_retention.0.days", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_object_lock(cosServiceName, bucketName, bucketRegion, bucketClass, true, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_cos_bucket.bucket", "object_lock_configuration.0.object_lock_rule.0.default_retention.0.days", "2"),
+				),
+			},
+			{
+				Config:      testAccCheckIBMCosBucket_object_lock(cosServiceName, bucketName, bucketRegion, bucketClass, false, 2),
+				ExpectError: regexp.MustCompile("Object Lock requires object versioning"),
+			},
+		},
+	})
+}
+
 func TestAccIBMCosBucket_Hard_Quota(t *testing.T) {
 
 	cosServiceName := fmt.Sprintf("cos_instance_%d", acctest.RandIntRange(10, 100))
@@ -1748,6 +1789,41 @@ func testAccCheckIBMCosBucket_object_versioning(cosServiceName string, bucketNam
 	`, cosServiceName, bucketName, region, storageClass)
 }
 
+func testAccCheckIBMCosBucket_object_lock(cosServiceName string, bucketName string, region string, storageClass string, versioning bool, days int) string {
+
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		name = "Default"
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+	}
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name           = "%s"
+		resource_instance_id  = ibm_resource_instance.instance.id
+		region_location       = "%s"
+		storage_class         = "%s"
+		object_versioning {
+			enable  = %t
+		}
+		object_lock_configuration {
+			object_lock_enabled = "Enabled"
+			object_lock_rule {
+				default_retention {
+					mode = "COMPLIANCE"
+					days = %d
+				}
+			}
+		}
+	}
+	`, cosServiceName, bucketName, region, storageClass, versioning, days)
+}
+
 func testAccCheckIBMCosBucket_hard_quota(cosServiceName string, bucketName string, regiontype string, region string, storageClass string, hardQuota int) string {
 
 	return fmt.Sprintf(`
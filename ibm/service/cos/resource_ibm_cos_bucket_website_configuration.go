@@ -0,0 +1,375 @@
+package cos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/ibm-cos-sdk-go/aws"
+	"github.com/IBM/ibm-cos-sdk-go/service/s3"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMCOSBucketWebsiteConfiguration() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMCOSBucketWebsiteConfigurationCreate,
+		Read:     resourceIBMCOSBucketWebsiteConfigurationRead,
+		Update:   resourceIBMCOSBucketWebsiteConfigurationUpdate,
+		Delete:   resourceIBMCOSBucketWebsiteConfigurationDelete,
+		Importer: &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+		Schema: map[string]*schema.Schema{
+			"bucket_crn": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket CRN",
+			},
+			"bucket_location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "COS bucket location",
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
+				Description:  "COS endpoint type: public, private, direct",
+				Default:      "public",
+				ForceNew:     true,
+			},
+			"website_configuration": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MaxItems:    1,
+				Description: "Configuration for hosting a static website on COS with public access.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"index_document": {
+							Type:         schema.TypeList,
+							Optional:     true,
+							MaxItems:     1,
+							ExactlyOneOf: []string{"website_configuration.0.index_document", "website_configuration.0.redirect_all_requests_to"},
+							Description:  "The document returned for a request to the root of the website or a subfolder.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"suffix": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The suffix appended to a request for a folder, for example index.html.",
+									},
+								},
+							},
+						},
+						"error_document": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"website_configuration.0.redirect_all_requests_to"},
+							Description:   "The document returned when an error occurs.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"key": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The object key of the error document.",
+									},
+								},
+							},
+						},
+						"redirect_all_requests_to": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							MaxItems:      1,
+							ConflictsWith: []string{"website_configuration.0.error_document", "website_configuration.0.routing_rule"},
+							Description:   "Redirect all requests to the website to another host.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"host_name": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The host name requests are redirected to.",
+									},
+									"protocol": {
+										Type:         schema.TypeString,
+										Optional:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{"http", "https"}),
+										Description:  "The protocol of the redirect, http or https. The protocol of the request is used when it is not set.",
+									},
+								},
+							},
+						},
+						"routing_rule": {
+							Type:          schema.TypeList,
+							Optional:      true,
+							ConflictsWith: []string{"website_configuration.0.redirect_all_requests_to"},
+							Description:   "Rules that redirect requests that match a condition.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"condition": {
+										Type:        schema.TypeList,
+										Optional:    true,
+										MaxItems:    1,
+										Description: "The condition a request must match to be redirected, all requests are redirected when it is not set.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"http_error_code_returned_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The HTTP error code of the request, for example 404.",
+												},
+												"key_prefix_equals": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The prefix of the object key of the request.",
+												},
+											},
+										},
+									},
+									"redirect": {
+										Type:        schema.TypeList,
+										Required:    true,
+										MaxItems:    1,
+										Description: "Where the request is redirected to.",
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"host_name": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The host name of the redirect.",
+												},
+												"http_redirect_code": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The HTTP redirect code of the response, for example 301.",
+												},
+												"protocol": {
+													Type:         schema.TypeString,
+													Optional:     true,
+													ValidateFunc: validate.ValidateAllowedStringValues([]string{"http", "https"}),
+													Description:  "The protocol of the redirect, http or https.",
+												},
+												"replace_key_prefix_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The object key prefix that replaces the key_prefix_equals of the condition.",
+												},
+												"replace_key_with": {
+													Type:        schema.TypeString,
+													Optional:    true,
+													Description: "The object key that replaces the object key of the request.",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"website_endpoint": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The endpoint of the static website.",
+			},
+		},
+	}
+}
+
+func websiteConfigurationSet(websiteList []interface{}) *s3.WebsiteConfiguration {
+	websiteConfiguration := &s3.WebsiteConfiguration{}
+	if len(websiteList) == 0 || websiteList[0] == nil {
+		return websiteConfiguration
+	}
+	websiteMap := websiteList[0].(map[string]interface{})
+
+	if indexList, ok := websiteMap["index_document"].([]interface{}); ok && len(indexList) > 0 && indexList[0] != nil {
+		index := indexList[0].(map[string]interface{})
+		websiteConfiguration.IndexDocument = &s3.IndexDocument{Suffix: aws.String(index["suffix"].(string))}
+	}
+	if errorList, ok := websiteMap["error_document"].([]interface{}); ok && len(errorList) > 0 && errorList[0] != nil {
+		errorDocument := errorList[0].(map[string]interface{})
+		websiteConfiguration.ErrorDocument = &s3.ErrorDocument{Key: aws.String(errorDocument["key"].(string))}
+	}
+	if redirectList, ok := websiteMap["redirect_all_requests_to"].([]interface{}); ok && len(redirectList) > 0 && redirectList[0] != nil {
+		redirect := redirectList[0].(map[string]interface{})
+		websiteConfiguration.RedirectAllRequestsTo = &s3.RedirectAllRequestsTo{HostName: aws.String(redirect["host_name"].(string))}
+		if protocol := redirect["protocol"].(string); protocol != "" {
+			websiteConfiguration.RedirectAllRequestsTo.Protocol = aws.String(protocol)
+		}
+	}
+	if ruleList, ok := websiteMap["routing_rule"].([]interface{}); ok {
+		for _, r := range ruleList {
+			ruleMap, _ := r.(map[string]interface{})
+			rule := &s3.RoutingRule{Redirect: &s3.Redirect{}}
+
+			if conditionList, ok := ruleMap["condition"].([]interface{}); ok && len(conditionList) > 0 && conditionList[0] != nil {
+				condition := conditionList[0].(map[string]interface{})
+				rule.Condition = &s3.Condition{}
+				if code := condition["http_error_code_returned_equals"].(string); code != "" {
+					rule.Condition.HttpErrorCodeReturnedEquals = aws.String(code)
+				}
+				if prefix := condition["key_prefix_equals"].(string); prefix != "" {
+					rule.Condition.KeyPrefixEquals = aws.String(prefix)
+				}
+			}
+			if redirectList, ok := ruleMap["redirect"].([]interface{}); ok && len(redirectList) > 0 && redirectList[0] != nil {
+				redirect := redirectList[0].(map[string]interface{})
+				if hostName := redirect["host_name"].(string); hostName != "" {
+					rule.Redirect.HostName = aws.String(hostName)
+				}
+				if code := redirect["http_redirect_code"].(string); code != "" {
+					rule.Redirect.HttpRedirectCode = aws.String(code)
+				}
+				if protocol := redirect["protocol"].(string); protocol != "" {
+					rule.Redirect.Protocol = aws.String(protocol)
+				}
+				if prefix := redirect["replace_key_prefix_with"].(string); prefix != "" {
+					rule.Redirect.ReplaceKeyPrefixWith = aws.String(prefix)
+				}
+				if key := redirect["replace_key_with"].(string); key != "" {
+					rule.Redirect.ReplaceKeyWith = aws.String(key)
+				}
+			}
+			websiteConfiguration.RoutingRules = append(websiteConfiguration.RoutingRules, rule)
+		}
+	}
+	return websiteConfiguration
+}
+
+func websiteConfigurationGet(website *s3.GetBucketWebsiteOutput) []map[string]interface{} {
+	websiteMap := map[string]interface{}{}
+	if website.IndexDocument != nil {
+		websiteMap["index_document"] = []map[string]interface{}{{"suffix": aws.StringValue(website.IndexDocument.Suffix)}}
+	}
+	if website.ErrorDocument != nil {
+		websiteMap["error_document"] = []map[string]interface{}{{"key": aws.StringValue(website.ErrorDocument.Key)}}
+	}
+	if website.RedirectAllRequestsTo != nil {
+		websiteMap["redirect_all_requests_to"] = []map[string]interface{}{{
+			"host_name": aws.StringValue(website.RedirectAllRequestsTo.HostName),
+			"protocol":  aws.StringValue(website.RedirectAllRequestsTo.Protocol),
+		}}
+	}
+	rules := []map[string]interface{}{}
+	for _, rule := range website.RoutingRules {
+		ruleMap := map[string]interface{}{}
+		if rule.Condition != nil {
+			ruleMap["condition"] = []map[string]interface{}{{
+				"http_error_code_returned_equals": aws.StringValue(rule.Condition.HttpErrorCodeReturnedEquals),
+				"key_prefix_equals":               aws.StringValue(rule.Condition.KeyPrefixEquals),
+			}}
+		}
+		if rule.Redirect != nil {
+			ruleMap["redirect"] = []map[string]interface{}{{
+				"host_name":               aws.StringValue(rule.Redirect.HostName),
+				"http_redirect_code":      aws.StringValue(rule.Redirect.HttpRedirectCode),
+				"protocol":                aws.StringValue(rule.Redirect.Protocol),
+				"replace_key_prefix_with": aws.StringValue(rule.Redirect.ReplaceKeyPrefixWith),
+				"replace_key_with":        aws.StringValue(rule.Redirect.ReplaceKeyWith),
+			}}
+		}
+		rules = append(rules, ruleMap)
+	}
+	websiteMap["routing_rule"] = rules
+	return []map[string]interface{}{websiteMap}
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
+	id, err := cosBucketConfigurationId(d)
+	if err != nil {
+		return err
+	}
+	d.SetId(id)
+
+	s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
+	if err != nil {
+		d.SetId("")
+		return err
+	}
+
+	putBucketWebsiteInput := &s3.PutBucketWebsiteInput{
+		Bucket:               aws.String(bucketName),
+		WebsiteConfiguration: websiteConfigurationSet(d.Get("website_configuration").([]interface{})),
+	}
+	_, err = s3Client.PutBucketWebsite(putBucketWebsiteInput)
+	if err != nil {
+		d.SetId("")
+		return fmt.Errorf("failed to create the website configuration on COS bucket %s, %v", bucketName, err)
+	}
+
+	return resourceIBMCOSBucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("website_configuration") {
+		s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
+		if err != nil {
+			return err
+		}
+
+		putBucketWebsiteInput := &s3.PutBucketWebsiteInput{
+			Bucket:               aws.String(bucketName),
+			WebsiteConfiguration: websiteConfigurationSet(d.Get("website_configuration").([]interface{})),
+		}
+		_, err = s3Client.PutBucketWebsite(putBucketWebsiteInput)
+		if err != nil {
+			return fmt.Errorf("failed to update the website configuration on COS bucket %s, %v", bucketName, err)
+		}
+	}
+	return resourceIBMCOSBucketWebsiteConfigurationRead(d, meta)
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationRead(d *schema.ResourceData, meta interface{}) error {
+	bucketLocation := parseBucketReplId(d.Id(), "bucketLocation")
+	endpointType := parseBucketReplId(d.Id(), "endpointType")
+
+	d.Set("bucket_crn", parseBucketReplId(d.Id(), "bucketCRN"))
+	d.Set("bucket_location", bucketLocation)
+	if endpointType != "" {
+		d.Set("endpoint_type", endpointType)
+	}
+
+	s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
+	if err != nil {
+		return err
+	}
+
+	website, err := s3Client.GetBucketWebsite(&s3.GetBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		if strings.Contains(err.Error(), "NoSuchWebsiteConfiguration") || strings.Contains(err.Error(), "NoSuchBucket") {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("failed to read the website configuration of COS bucket %s, %v", bucketName, err)
+	}
+
+	d.Set("website_configuration", websiteConfigurationGet(website))
+	d.Set("website_endpoint", fmt.Sprintf("%s.s3-web.%s.cloud-object-storage.appdomain.cloud", bucketName, bucketLocation))
+	return nil
+}
+
+func resourceIBMCOSBucketWebsiteConfigurationDelete(d *schema.ResourceData, meta interface{}) error {
+	s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
+	if err != nil {
+		return err
+	}
+
+	_, err = s3Client.DeleteBucketWebsite(&s3.DeleteBucketWebsiteInput{
+		Bucket: aws.String(bucketName),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete the website configuration of COS bucket %s, %v", bucketName, err)
+	}
+	return nil
+}
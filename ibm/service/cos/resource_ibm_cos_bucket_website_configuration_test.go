@@ -0,0 +1,96 @@
+package cos_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMCosBucket_Website_Configuration(t *testing.T) {
+	serviceName := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	bucketName := fmt.Sprintf("terraform-website%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_cos_bucket_website_configuration.website"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMCosBucketDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMCosBucket_website(serviceName, bucketName, "error.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "website_configuration.0.index_document.0.suffix", "index.html"),
+					resource.TestCheckResourceAttr(resourceName, "website_configuration.0.error_document.0.key", "error.html"),
+					resource.TestCheckResourceAttr(resourceName, "website_configuration.0.routing_rule.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "website_configuration.0.routing_rule.0.redirect.0.replace_key_prefix_with", "documents/"),
+					resource.TestCheckResourceAttr(resourceName, "website_endpoint", fmt.Sprintf("%s.s3-web.us-south.cloud-object-storage.appdomain.cloud", bucketName)),
+				),
+			},
+			{
+				Config: testAccCheckIBMCosBucket_website(serviceName, bucketName, "404.html"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "website_configuration.0.error_document.0.key", "404.html"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testAccCheckIBMCosBucket_configurationBucket creates the bucket that the
+// website, CORS and public access block tests configure.
+func testAccCheckIBMCosBucket_configurationBucket(serviceName, bucketName string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "cos_group" {
+		is_default = true
+	}
+
+	resource "ibm_resource_instance" "instance" {
+		name              = "%s"
+		resource_group_id = data.ibm_resource_group.cos_group.id
+		service           = "cloud-object-storage"
+		plan              = "standard"
+		location          = "global"
+	}
+
+	resource "ibm_cos_bucket" "bucket" {
+		bucket_name          = "%s"
+		resource_instance_id = ibm_resource_instance.instance.id
+		region_location      = "us-south"
+		storage_class        = "standard"
+	}
+	`, serviceName, bucketName)
+}
+
+func testAccCheckIBMCosBucket_website(serviceName, bucketName, errorDocument string) string {
+	return testAccCheckIBMCosBucket_configurationBucket(serviceName, bucketName) + fmt.Sprintf(`
+	resource "ibm_cos_bucket_website_configuration" "website" {
+		bucket_crn      = ibm_cos_bucket.bucket.crn
+		bucket_location = ibm_cos_bucket.bucket.region_location
+
+		website_configuration {
+			index_document {
+				suffix = "index.html"
+			}
+			error_document {
+				key = "%s"
+			}
+			routing_rule {
+				condition {
+					key_prefix_equals = "docs/"
+				}
+				redirect {
+					replace_key_prefix_with = "documents/"
+				}
+			}
+		}
+	}
+	`, errorDocument)
+}
@@ -52,6 +52,7 @@ func ResourceIBMCOSBucketReplicationConfiguration() *schema.Resource {
 				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private", "direct"}),
 				Description:  "COS endpoint type: public, private, direct",
 				Default:      "public",
+				ForceNew:     true,
 			},
 			"replication_rule": {
 				Type:        schema.TypeSet,
@@ -157,19 +158,16 @@ func replicationRuleSet(replicateList []interface{}) []*s3.ReplicationRule {
 }
 
 func resourceIBMCOSBucketReplicationConfigurationCreate(d *schema.ResourceData, meta interface{}) error {
-	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
-
-	bucketLocation := d.Get("bucket_location").(string)
-	endpointType := d.Get("endpoint_type").(string)
-
-	bxSession, err := meta.(conns.ClientSession).BluemixSession()
+	//Generating a fake id which contains every information about to get the bucket via s3 api
+	bktID, err := cosBucketConfigurationId(d)
 	if err != nil {
 		return err
 	}
 
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, bucketName, err := getS3ClientSessionFromBucketId(bktID, meta)
+	if err != nil {
+		return err
+	}
 	var rules []*s3.ReplicationRule
 
 	replication, ok := d.GetOk("replication_rule")
@@ -190,27 +188,13 @@ func resourceIBMCOSBucketReplicationConfigurationCreate(d *schema.ResourceData,
 		return fmt.Errorf("failed to create the replication rule on COS bucket %s, %v", bucketName, err)
 	}
 
-	//Generating a fake id which contains every information about to get the bucket via s3 api
-	bktID := fmt.Sprintf("%s:%s:%s:meta:%s:%s", strings.Replace(instanceCRN, "::", "", -1), "bucket", bucketName, bucketLocation, endpointType)
 	d.SetId(bktID)
 
 	return resourceIBMCOSBucketReplicationConfigurationRead(d, meta)
 }
 
 func resourceIBMCOSBucketReplicationConfigurationUpdate(d *schema.ResourceData, meta interface{}) error {
-	bucketCRN := d.Get("bucket_crn").(string)
-	bucketName := strings.Split(bucketCRN, ":bucket:")[1]
-	instanceCRN := fmt.Sprintf("%s::", strings.Split(bucketCRN, ":bucket:")[0])
-
-	bucketLocation := d.Get("bucket_location").(string)
-	endpointType := d.Get("endpoint_type").(string)
-
-	bxSession, err := meta.(conns.ClientSession).BluemixSession()
-	if err != nil {
-		return err
-	}
-
-	s3Client, err := getS3ClientSession(bxSession, bucketLocation, endpointType, instanceCRN)
+	s3Client, bucketName, err := getS3ClientSessionFromBucketId(d.Id(), meta)
 	if err != nil {
 		return err
 	}
@@ -0,0 +1,282 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+func DataSourceIBMDatabaseRestoreCheck() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMDatabaseRestoreCheckRead,
+
+		Schema: map[string]*schema.Schema{
+			"backup_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The CRN of the backup to restore.",
+			},
+			"plan": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"standard", "enterprise"}),
+				Description:  "The plan of the new deployment.",
+			},
+			"version": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The version of the new deployment. The version of the backed up deployment is checked when it is not set.",
+			},
+			"key_protect_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The CRN of the Key Protect or Hyper Protect Crypto Services key of the new deployment.",
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the backup can be restored to a deployment with the plan, version and key.",
+			},
+			"issues": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The reasons why the backup can not be restored.",
+			},
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the backed up deployment.",
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The service of the backed up deployment.",
+			},
+			"source_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The version of the backed up deployment.",
+			},
+		},
+	}
+}
+
+func dataSourceIBMDatabaseRestoreCheckRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	backupID := d.Get("backup_id").(string)
+	plan := d.Get("plan").(string)
+	issues := []string{}
+
+	getBackupInfoOptions := &clouddatabasesv5.GetBackupInfoOptions{}
+	getBackupInfoOptions.SetBackupID(backupID)
+	backupInfo, response, err := cloudDatabasesClient.GetBackupInfoWithContext(context, getBackupInfoOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] GetBackupInfo (%s) failed %s\n%s", backupID, err, response))
+	}
+	backup := backupInfo.Backup
+	if backup.Status == nil || *backup.Status != clouddatabasesv5.BackupStatusCompletedConst {
+		issues = append(issues, fmt.Sprintf("The backup is not completed, its status is %s", core.StringNilMapper(backup.Status)))
+	}
+	if backup.IsRestorable == nil || !*backup.IsRestorable {
+		issues = append(issues, "The backup is not restorable")
+	}
+
+	deploymentID := core.StringNilMapper(backup.DeploymentID)
+	service := databaseServiceFromCRN(deploymentID)
+	sourceVersion := ""
+
+	// The backups of a deleted deployment can still be restored
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{}
+	getDeploymentInfoOptions.SetID(deploymentID)
+	deploymentInfo, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return diag.FromErr(fmt.Errorf("[ERROR] GetDeploymentInfo (%s) failed %s\n%s", deploymentID, err, response))
+	}
+	if err == nil && deploymentInfo.Deployment != nil {
+		sourceVersion = core.StringNilMapper(deploymentInfo.Deployment.Version)
+	}
+
+	if service != "" {
+		planIssues, err := databaseRestoreCheckPlan(context, meta, deploymentID, service, plan)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		issues = append(issues, planIssues...)
+	}
+
+	version := d.Get("version").(string)
+	if version != "" || sourceVersion != "" {
+		versionIssues, err := databaseRestoreCheckVersion(context, cloudDatabasesClient, service, sourceVersion, version)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		issues = append(issues, versionIssues...)
+	}
+
+	if key, ok := d.GetOk("key_protect_key"); ok {
+		keyIssues, err := databaseRestoreCheckKey(context, meta, key.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		issues = append(issues, keyIssues...)
+	}
+
+	d.SetId(backupID)
+	d.Set("deployment_id", deploymentID)
+	d.Set("service", service)
+	d.Set("source_version", sourceVersion)
+	d.Set("valid", len(issues) == 0)
+	d.Set("issues", issues)
+	return nil
+}
+
+func databaseRestoreCheckPlan(context context.Context, meta interface{}, deploymentID, service, plan string) ([]string, error) {
+	issues := []string{}
+	rsCatClient, err := meta.(conns.ClientSession).ResourceCatalogAPI()
+	if err != nil {
+		return nil, err
+	}
+	rsCatRepo := rsCatClient.ResourceCatalog()
+
+	serviceOff, err := rsCatRepo.FindByName(service, true)
+	if err != nil || len(serviceOff) == 0 {
+		return nil, fmt.Errorf("[ERROR] Error retrieving database service offering %s: %s", service, err)
+	}
+	if _, err := rsCatRepo.GetServicePlanID(serviceOff[0], plan); err != nil {
+		issues = append(issues, fmt.Sprintf("The plan %s is not available for %s", plan, service))
+		return issues, nil
+	}
+
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+	instance, _, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{ID: &deploymentID})
+	if err != nil || instance.ResourcePlanID == nil {
+		// The plan of a deleted deployment is not known
+		return issues, nil
+	}
+	sourcePlan, err := rsCatRepo.GetServicePlanName(*instance.ResourcePlanID)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving plan: %s", err)
+	}
+	// Enterprise backups use the data format of the enterprise edition
+	if sourcePlan == "enterprise" && plan != "enterprise" {
+		issues = append(issues, fmt.Sprintf("A backup of an %s deployment can not be restored to a %s deployment", sourcePlan, plan))
+	}
+	return issues, nil
+}
+
+func databaseRestoreCheckVersion(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, service, sourceVersion, version string) ([]string, error) {
+	issues := []string{}
+	if version == "" {
+		version = sourceVersion
+	}
+
+	if sourceVersion != "" {
+		sourceMajor, errSource := strconv.Atoi(strings.SplitN(sourceVersion, ".", 2)[0])
+		targetMajor, errTarget := strconv.Atoi(strings.SplitN(version, ".", 2)[0])
+		if errSource == nil && errTarget == nil && targetMajor < sourceMajor {
+			issues = append(issues, fmt.Sprintf("A backup of version %s can not be restored to the older version %s", sourceVersion, version))
+		}
+	}
+
+	deployables, response, err := cloudDatabasesClient.ListDeployablesWithContext(context, &clouddatabasesv5.ListDeployablesOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] ListDeployables failed %s\n%s", err, response)
+	}
+	databaseType := service[strings.LastIndex(service, "-")+1:]
+	for _, deployable := range deployables.Deployables {
+		if core.StringNilMapper(deployable.Type) != databaseType {
+			continue
+		}
+		for _, v := range deployable.Versions {
+			if core.StringNilMapper(v.Version) == version {
+				if core.StringNilMapper(v.Status) == "deprecated" {
+					issues = append(issues, fmt.Sprintf("The version %s of %s is deprecated", version, service))
+				}
+				return issues, nil
+			}
+		}
+		issues = append(issues, fmt.Sprintf("The version %s of %s can not be deployed", version, service))
+	}
+	return issues, nil
+}
+
+func databaseRestoreCheckKey(context context.Context, meta interface{}, keyCRN string) ([]string, error) {
+	crn := strings.Split(keyCRN, ":")
+	if len(crn) < 10 || crn[0] != "crn" || (crn[4] != "kms" && crn[4] != "hs-crypto") || crn[8] != "key" {
+		return []string{fmt.Sprintf("The key_protect_key %s is not the CRN of a Key Protect or Hyper Protect Crypto Services key", keyCRN)}, nil
+	}
+	instanceID, keyID := crn[7], crn[9]
+
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, err
+	}
+	instance, response, err := rsConClient.GetResourceInstanceWithContext(context, &rc.GetResourceInstanceOptions{ID: &instanceID})
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return []string{fmt.Sprintf("The instance %s of the key_protect_key is not found", instanceID)}, nil
+		}
+		return nil, fmt.Errorf("[ERROR] Error retrieving resource instance %s: %s %s", instanceID, err, response)
+	}
+	if instance.State != nil && *instance.State != "active" {
+		return []string{fmt.Sprintf("The instance %s of the key_protect_key is %s", instanceID, *instance.State)}, nil
+	}
+
+	endpoints, ok := instance.Extensions["endpoints"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
+	if err != nil {
+		return nil, err
+	}
+	endpoint := endpoints["public"]
+	if strings.Contains(kpAPI.Config.BaseURL, "private") {
+		endpoint = endpoints["private"]
+	}
+	endpointURL, ok := endpoint.(string)
+	if !ok {
+		return nil, nil
+	}
+	kpURL, err := url.Parse(conns.EnvFallBack([]string{"IBMCLOUD_KP_API_ENDPOINT"}, endpointURL+"/api/v2/keys"))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error Parsing KMS EndpointURL")
+	}
+	kpAPI.URL = kpURL
+	kpAPI.Config.InstanceID = instanceID
+
+	key, err := kpAPI.GetKey(context, keyID)
+	if err != nil {
+		return []string{fmt.Sprintf("The key_protect_key %s can not be read: %s", keyID, err)}, nil
+	}
+	// Only active root keys can protect a deployment
+	if key.State != 1 {
+		return []string{fmt.Sprintf("The key_protect_key %s is not active", keyID)}, nil
+	}
+	if key.Extractable {
+		return []string{fmt.Sprintf("The key_protect_key %s is a standard key, a root key is required", keyID)}, nil
+	}
+	return nil, nil
+}
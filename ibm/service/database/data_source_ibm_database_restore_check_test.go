@@ -0,0 +1,56 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMDatabaseRestoreCheckDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMDatabaseRestoreCheckDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_database_restore_check.restore_check", "deployment_id"),
+					resource.TestCheckResourceAttrSet("data.ibm_database_restore_check.restore_check", "service"),
+					resource.TestCheckResourceAttr("data.ibm_database_restore_check.restore_check", "valid", "true"),
+					resource.TestCheckResourceAttr("data.ibm_database_restore_check.restore_check", "issues.#", "0"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIBMDatabaseRestoreCheckDataSourceConfigVersion("1.0"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.ibm_database_restore_check.restore_check", "valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseRestoreCheckDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		data "ibm_database_restore_check" "restore_check" {
+			backup_id = "%[1]s"
+			plan      = "standard"
+		}
+	`, acc.IcdDbBackupId)
+}
+
+func testAccCheckIBMDatabaseRestoreCheckDataSourceConfigVersion(version string) string {
+	return fmt.Sprintf(`
+		data "ibm_database_restore_check" "restore_check" {
+			backup_id = "%[1]s"
+			plan      = "standard"
+			version   = "%[2]s"
+		}
+	`, acc.IcdDbBackupId, version)
+}
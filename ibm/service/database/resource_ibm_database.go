@@ -103,7 +103,8 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 
 		CustomizeDiff: customdiff.All(
 			resourceIBMDatabaseInstanceDiff,
-			checkV5Groups),
+			checkV5Groups,
+			checkDatabaseConfigurationDiff),
 
 		Importer: &schema.ResourceImporter{},
 
@@ -193,6 +194,11 @@ func ResourceIBMDatabaseInstance() *schema.Resource {
 				Computed:    true,
 				Description: "The configuration schema in JSON format",
 			},
+			"configuration_restart_required": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the last change of the configuration restarts the database",
+			},
 			"version": {
 				Description: "The database version to provision if specified",
 				Type:        schema.TypeString,
@@ -1572,7 +1578,25 @@ func resourceIBMDatabaseInstanceCreate(context context.Context, d *schema.Resour
 		}
 	}
 
-	return resourceIBMDatabaseInstanceRead(context, d, meta)
+	var diags diag.Diagnostics
+	if s, ok := d.GetOk("configuration"); ok && databaseServiceIsConfigurable(serviceName) {
+		settings, changed, err := databaseConfigurationChange("", s.(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		restart, err := updateDatabaseConfiguration(d, meta, d.Id(), settings, changed, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags = append(diags, databaseConfigurationRestartWarning(d.Id(), restart)...)
+	} else if ok {
+		diags = append(diags, diag.Diagnostic{
+			Severity: diag.Warning,
+			Summary:  fmt.Sprintf("The configuration is not applied, %s is not configurable", serviceName),
+		})
+	}
+
+	return append(diags, resourceIBMDatabaseInstanceRead(context, d, meta)...)
 }
 
 func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
@@ -1734,7 +1758,7 @@ func resourceIBMDatabaseInstanceRead(context context.Context, d *schema.Resource
 	}
 	d.Set("connectionstrings", flex.FlattenConnectionStrings(connectionStrings))
 
-	if serviceOff == "databases-for-postgresql" || serviceOff == "databases-for-redis" || serviceOff == "databases-for-enterprisedb" {
+	if databaseServiceIsConfigurable(serviceOff) {
 		configSchema, err := icdClient.Configurations().GetConfiguration(icdId)
 		if err != nil {
 			return diag.FromErr(fmt.Errorf("[ERROR] Error getting database (%s) configuration schema : %s", icdId, err))
@@ -1816,22 +1840,21 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 			return diag.FromErr(err)
 		}
 	}
+	var diags diag.Diagnostics
 	if d.HasChange("configuration") {
 		service := d.Get("service").(string)
-		if service == "databases-for-postgresql" || service == "databases-for-redis" || service == "databases-for-enterprisedb" {
+		if databaseServiceIsConfigurable(service) {
 			if s, ok := d.GetOk("configuration"); ok {
-				var configuration interface{}
-				json.Unmarshal([]byte(s.(string)), &configuration)
-				configPayload := icdv4.ConfigurationReq{Configuration: configuration}
-				task, err := icdClient.Configurations().UpdateConfiguration(icdId, configPayload)
+				o, _ := d.GetChange("configuration")
+				settings, changed, err := databaseConfigurationChange(o.(string), s.(string))
 				if err != nil {
-					return diag.FromErr(fmt.Errorf("[ERROR] Error updating database (%s) configuration: %s", icdId, err))
+					return diag.FromErr(err)
 				}
-				_, err = waitForDatabaseTaskComplete(task.Id, d, meta, d.Timeout(schema.TimeoutUpdate))
+				restart, err := updateDatabaseConfiguration(d, meta, instanceID, settings, changed, d.Timeout(schema.TimeoutUpdate))
 				if err != nil {
-					return diag.FromErr(fmt.Errorf(
-						"[ERROR] Error waiting for database (%s) configuration update task to complete: %s", icdId, err))
+					return diag.FromErr(err)
 				}
+				diags = append(diags, databaseConfigurationRestartWarning(instanceID, restart)...)
 			}
 
 		} else {
@@ -2238,7 +2261,7 @@ func resourceIBMDatabaseInstanceUpdate(context context.Context, d *schema.Resour
 		}
 	}
 
-	return resourceIBMDatabaseInstanceRead(context, d, meta)
+	return append(diags, resourceIBMDatabaseInstanceRead(context, d, meta)...)
 }
 
 func horizontalScale(d *schema.ResourceData, meta interface{}, icdClient icdv4.ICDServiceAPI) error {
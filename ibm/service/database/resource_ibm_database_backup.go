@@ -0,0 +1,181 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+)
+
+func ResourceIBMDatabaseBackup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseBackupCreate,
+		ReadContext:   resourceIBMDatabaseBackupRead,
+		DeleteContext: resourceIBMDatabaseBackupDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the deployment to back up.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, takes a new backup.",
+			},
+			"backup_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the backup.",
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the task that took the backup.",
+			},
+			"type": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of backup.",
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the backup.",
+			},
+			"is_downloadable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Is this backup available to download?.",
+			},
+			"is_restorable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Can this backup be used to restore an instance?.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Date and time when the backup was created.",
+			},
+		},
+	}
+}
+
+func resourceIBMDatabaseBackupCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	startedAt := time.Now().UTC()
+
+	startOndemandBackupOptions := &clouddatabasesv5.StartOndemandBackupOptions{}
+	startOndemandBackupOptions.SetID(deploymentID)
+
+	startOndemandBackupResponse, response, err := cloudDatabasesClient.StartOndemandBackupWithContext(context, startOndemandBackupOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] StartOndemandBackup (%s) failed %s\n%s", deploymentID, err, response))
+	}
+
+	task := startOndemandBackupResponse.Task
+	d.Set("task_id", task.ID)
+	if task.CreatedAt != nil {
+		startedAt = time.Time(*task.CreatedAt)
+	}
+
+	_, err = waitForDatabaseTaskComplete(*task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) backup task to complete: %s", deploymentID, err))
+	}
+
+	// The task does not return the backup, it is the newest on demand backup
+	// that was created once the task started.
+	listDeploymentBackupsOptions := &clouddatabasesv5.ListDeploymentBackupsOptions{}
+	listDeploymentBackupsOptions.SetID(deploymentID)
+
+	backups, response, err := cloudDatabasesClient.ListDeploymentBackupsWithContext(context, listDeploymentBackupsOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] ListDeploymentBackups (%s) failed %s\n%s", deploymentID, err, response))
+	}
+
+	var backup *clouddatabasesv5.Backup
+	for i, b := range backups.Backups {
+		if b.Type == nil || *b.Type != clouddatabasesv5.BackupTypeOnDemandConst || b.CreatedAt == nil {
+			continue
+		}
+		createdAt := time.Time(*b.CreatedAt)
+		// Allow for the clock skew between the task and the backup
+		if createdAt.Before(startedAt.Add(-time.Minute)) {
+			continue
+		}
+		if backup == nil || createdAt.After(time.Time(*backup.CreatedAt)) {
+			backup = &backups.Backups[i]
+		}
+	}
+	if backup == nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] The backup task %s of database (%s) completed but no on demand backup was found", *task.ID, deploymentID))
+	}
+
+	d.SetId(*backup.ID)
+
+	return resourceIBMDatabaseBackupRead(context, d, meta)
+}
+
+func resourceIBMDatabaseBackupRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	getBackupInfoOptions := &clouddatabasesv5.GetBackupInfoOptions{}
+	getBackupInfoOptions.SetBackupID(d.Id())
+
+	backupInfo, response, err := cloudDatabasesClient.GetBackupInfoWithContext(context, getBackupInfoOptions)
+	if err != nil {
+		// On demand backups expire after 30 days
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing database backup (%s) from state because it's not found via the API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] GetBackupInfo (%s) failed %s\n%s", d.Id(), err, response))
+	}
+	backup := backupInfo.Backup
+
+	d.Set("backup_id", backup.ID)
+	d.Set("deployment_id", backup.DeploymentID)
+	d.Set("type", backup.Type)
+	d.Set("status", backup.Status)
+	d.Set("is_downloadable", backup.IsDownloadable)
+	d.Set("is_restorable", backup.IsRestorable)
+	d.Set("created_at", flex.DateTimeToString(backup.CreatedAt))
+	return nil
+}
+
+func resourceIBMDatabaseBackupDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// ICD does not implement a delete backup API, backups expire on their own
+	log.Println("Warning:  `terraform destroy` does not delete the database backup but only clears the state file.")
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,46 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMDatabaseBackupBasic(t *testing.T) {
+	resourceName := "ibm_database_backup.database_backup"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIBMDatabaseBackupConfigBasic("1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deployment_id", acc.IcdDbDeploymentId),
+					resource.TestCheckResourceAttrSet(resourceName, "backup_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_id"),
+					resource.TestCheckResourceAttr(resourceName, "type", "on_demand"),
+					resource.TestCheckResourceAttr(resourceName, "status", "completed"),
+					resource.TestCheckResourceAttr(resourceName, "is_restorable", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseBackupConfigBasic(trigger string) string {
+	return fmt.Sprintf(`
+		resource "ibm_database_backup" "database_backup" {
+			deployment_id = "%[1]s"
+			triggers = {
+				change = "%[2]s"
+			}
+		}
+	`, acc.IcdDbDeploymentId, trigger)
+}
@@ -0,0 +1,522 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"math"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/bluemix-go/api/icd/icdv4"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+)
+
+// Only these services implement the configuration API
+var databaseConfigurableServices = []string{"databases-for-postgresql", "databases-for-mysql", "databases-for-redis", "databases-for-enterprisedb"}
+
+type databaseConfigurationField struct {
+	name        string
+	key         string
+	valueType   schema.ValueType
+	description string
+}
+
+// The typed settings of each engine block, key is the name of the setting in
+// the configuration API when it is not a valid attribute name.
+var databaseConfigurationEngines = map[string][]databaseConfigurationField{
+	"postgresql": {
+		{name: "archive_timeout", valueType: schema.TypeInt, description: "Seconds after which the WAL is switched to a new segment."},
+		{name: "deadlock_timeout", valueType: schema.TypeInt, description: "Milliseconds to wait on a lock before checking for a deadlock."},
+		{name: "effective_io_concurrency", valueType: schema.TypeInt, description: "Number of concurrent disk I/O operations."},
+		{name: "idle_in_transaction_session_timeout", valueType: schema.TypeInt, description: "Milliseconds after which an idle session within a transaction is terminated."},
+		{name: "log_connections", valueType: schema.TypeString, description: "Log each connection to the database, `on` or `off`."},
+		{name: "log_disconnections", valueType: schema.TypeString, description: "Log the end of each session, `on` or `off`."},
+		{name: "log_min_duration_statement", valueType: schema.TypeInt, description: "Milliseconds after which a statement is logged, `-1` disables the logging."},
+		{name: "max_connections", valueType: schema.TypeInt, description: "Maximum number of concurrent connections."},
+		{name: "max_prepared_transactions", valueType: schema.TypeInt, description: "Maximum number of transactions in the prepared state."},
+		{name: "max_replication_slots", valueType: schema.TypeInt, description: "Maximum number of replication slots."},
+		{name: "max_wal_senders", valueType: schema.TypeInt, description: "Maximum number of concurrent WAL sender processes."},
+		{name: "shared_buffers", valueType: schema.TypeInt, description: "Amount of memory used for shared memory buffers, in 8 KB pages."},
+		{name: "synchronous_commit", valueType: schema.TypeString, description: "Whether a commit waits for the WAL to be written to disk, `local` or `off`."},
+		{name: "tcp_keepalives_count", valueType: schema.TypeInt, description: "Number of TCP keepalives that can be lost before the connection is dropped."},
+		{name: "tcp_keepalives_idle", valueType: schema.TypeInt, description: "Seconds of inactivity after which a TCP keepalive is sent."},
+		{name: "tcp_keepalives_interval", valueType: schema.TypeInt, description: "Seconds after which an unacknowledged TCP keepalive is sent again."},
+		{name: "wal_level", valueType: schema.TypeString, description: "Amount of information written to the WAL, `hot_standby` or `logical`."},
+		{name: "work_mem", valueType: schema.TypeInt, description: "Amount of memory used by internal sort operations, in KB."},
+	},
+	"mysql": {
+		{name: "default_authentication_plugin", valueType: schema.TypeString, description: "The default authentication plugin of new users."},
+		{name: "innodb_buffer_pool_size_percentage", valueType: schema.TypeInt, description: "Percentage of the memory used by the InnoDB buffer pool."},
+		{name: "innodb_flush_log_at_trx_commit", valueType: schema.TypeInt, description: "How the log buffer is written and flushed at each commit."},
+		{name: "innodb_log_buffer_size", valueType: schema.TypeInt, description: "Size of the InnoDB log buffer, in bytes."},
+		{name: "innodb_log_file_size", valueType: schema.TypeInt, description: "Size of each InnoDB log file, in bytes."},
+		{name: "innodb_lru_scan_depth", valueType: schema.TypeInt, description: "How far down the buffer pool LRU list the page cleaner scans."},
+		{name: "innodb_read_io_threads", valueType: schema.TypeInt, description: "Number of I/O threads for InnoDB read operations."},
+		{name: "innodb_write_io_threads", valueType: schema.TypeInt, description: "Number of I/O threads for InnoDB write operations."},
+		{name: "max_allowed_packet", valueType: schema.TypeInt, description: "Maximum size of a packet, in bytes."},
+		{name: "max_connections", valueType: schema.TypeInt, description: "Maximum number of concurrent connections."},
+		{name: "mysql_max_binlog_age_sec", valueType: schema.TypeInt, description: "Seconds after which binary log files are removed."},
+		{name: "net_read_timeout", valueType: schema.TypeInt, description: "Seconds to wait for more data from a connection before aborting the read."},
+		{name: "net_write_timeout", valueType: schema.TypeInt, description: "Seconds to wait for a block to be written to a connection before aborting the write."},
+		{name: "sql_mode", valueType: schema.TypeString, description: "The SQL modes of the server."},
+		{name: "wait_timeout", valueType: schema.TypeInt, description: "Seconds the server waits for activity on a connection before closing it."},
+	},
+	"redis": {
+		{name: "appendonly", valueType: schema.TypeString, description: "Whether the append only file is used for persistence, `yes` or `no`."},
+		{name: "maxmemory", valueType: schema.TypeInt, description: "Maximum amount of memory used for data, in bytes."},
+		{name: "maxmemory_policy", key: "maxmemory-policy", valueType: schema.TypeString, description: "How keys are evicted once maxmemory is reached."},
+		{name: "maxmemory_samples", key: "maxmemory-samples", valueType: schema.TypeInt, description: "Number of keys sampled by the eviction policy."},
+		{name: "stop_writes_on_bgsave_error", key: "stop-writes-on-bgsave-error", valueType: schema.TypeString, description: "Whether writes are refused when a background save fails, `yes` or `no`."},
+	},
+}
+
+// The services each engine block can configure
+var databaseConfigurationEngineServices = map[string][]string{
+	"postgresql": {"databases-for-postgresql", "databases-for-enterprisedb"},
+	"mysql":      {"databases-for-mysql"},
+	"redis":      {"databases-for-redis"},
+}
+
+func ResourceIBMDatabaseConfiguration() *schema.Resource {
+	engines := []string{}
+	for engine := range databaseConfigurationEngines {
+		engines = append(engines, engine)
+	}
+	sort.Strings(engines)
+
+	resourceSchema := map[string]*schema.Schema{
+		"deployment_id": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The CRN of the deployment to configure.",
+		},
+		"restart_required": {
+			Type:        schema.TypeBool,
+			Computed:    true,
+			Description: "Whether the last change of the configuration restarts the database.",
+		},
+	}
+	for _, engine := range engines {
+		fields := map[string]*schema.Schema{}
+		for _, field := range databaseConfigurationEngines[engine] {
+			fields[field.name] = &schema.Schema{
+				Type:        field.valueType,
+				Optional:    true,
+				Description: field.description,
+			}
+		}
+		resourceSchema[engine] = &schema.Schema{
+			Type:         schema.TypeList,
+			Optional:     true,
+			MaxItems:     1,
+			ExactlyOneOf: engines,
+			Elem:         &schema.Resource{Schema: fields},
+			Description:  fmt.Sprintf("The %s settings, for %s.", engine, strings.Join(databaseConfigurationEngineServices[engine], " and ")),
+		}
+	}
+
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseConfigurationCreate,
+		ReadContext:   resourceIBMDatabaseConfigurationRead,
+		UpdateContext: resourceIBMDatabaseConfigurationUpdate,
+		DeleteContext: resourceIBMDatabaseConfigurationDelete,
+
+		CustomizeDiff: customdiff.Sequence(resourceIBMDatabaseConfigurationDiff),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: resourceSchema,
+	}
+}
+
+func databaseServiceIsConfigurable(service string) bool {
+	for _, s := range databaseConfigurableServices {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+func databaseConfigurationFieldKey(field databaseConfigurationField) string {
+	if field.key != "" {
+		return field.key
+	}
+	return field.name
+}
+
+type databaseConfigurationGetter interface {
+	Get(string) interface{}
+	GetOk(string) (interface{}, bool)
+	GetRawConfig() cty.Value
+	HasChange(string) bool
+}
+
+// DatabaseConfigurationEngine returns the configured engine block and its
+// settings keyed by their configuration API names. Only the settings set in
+// the tf configuration are returned, the state holds the zero value of every
+// other setting. changed only holds the settings that differ from the state.
+func DatabaseConfigurationEngine(d databaseConfigurationGetter) (engine string, settings map[string]interface{}, changed map[string]bool) {
+	settings = map[string]interface{}{}
+	changed = map[string]bool{}
+	rawConfig := d.GetRawConfig()
+	if !rawConfig.IsKnown() || rawConfig.IsNull() {
+		return engine, settings, changed
+	}
+	for name, fields := range databaseConfigurationEngines {
+		if _, ok := d.GetOk(name); !ok {
+			continue
+		}
+		engine = name
+		block := rawConfig.GetAttr(name)
+		if !block.IsKnown() || block.IsNull() || block.LengthInt() == 0 {
+			continue
+		}
+		block = block.Index(cty.NumberIntVal(0))
+		for _, field := range fields {
+			// Unknown settings are validated once they are known at apply time
+			if v := block.GetAttr(field.name); !v.IsKnown() || v.IsNull() {
+				continue
+			}
+			attr := fmt.Sprintf("%s.0.%s", name, field.name)
+			settings[databaseConfigurationFieldKey(field)] = d.Get(attr)
+			if d.HasChange(attr) {
+				changed[databaseConfigurationFieldKey(field)] = true
+			}
+		}
+	}
+	return engine, settings, changed
+}
+
+func checkDatabaseConfigurationEngine(engine, service string) error {
+	if service == "" {
+		return nil
+	}
+	for _, s := range databaseConfigurationEngineServices[engine] {
+		if s == service {
+			return nil
+		}
+	}
+	return fmt.Errorf("[ERROR] The %s block can not configure a %s deployment, it configures %s", engine, service, strings.Join(databaseConfigurationEngineServices[engine], " and "))
+}
+
+// ValidateDatabaseConfiguration checks the settings against the
+// configuration schema of the deployment, as returned by the
+// /configuration/schema API, and returns the changed settings that restart the
+// database.
+func ValidateDatabaseConfiguration(configSchema interface{}, settings map[string]interface{}, changed map[string]bool) ([]string, error) {
+	schemaMap, ok := configSchema.(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+	properties, ok := schemaMap["schema"].(map[string]interface{})
+	if !ok {
+		return nil, nil
+	}
+
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	restart := []string{}
+	for _, key := range keys {
+		property, ok := properties[key].(map[string]interface{})
+		if !ok {
+			valid := make([]string, 0, len(properties))
+			for k := range properties {
+				valid = append(valid, k)
+			}
+			sort.Strings(valid)
+			return nil, fmt.Errorf("[ERROR] %s is not a configuration setting of the deployment, the settings are %s", key, strings.Join(valid, ", "))
+		}
+		if err := validateDatabaseConfigurationValue(key, property, settings[key]); err != nil {
+			return nil, err
+		}
+		if requiresRestart, _ := property["requires_restart"].(bool); requiresRestart && changed[key] {
+			restart = append(restart, key)
+		}
+	}
+	return restart, nil
+}
+
+func validateDatabaseConfigurationValue(key string, property map[string]interface{}, value interface{}) error {
+	propertyType, _ := property["type"].(string)
+	switch propertyType {
+	case "integer", "number", "float":
+		var number float64
+		switch v := value.(type) {
+		case int:
+			number = float64(v)
+		case float64:
+			number = v
+		default:
+			return fmt.Errorf("[ERROR] The configuration setting %s must be a number, got %v", key, value)
+		}
+		if propertyType == "integer" && number != math.Trunc(number) {
+			return fmt.Errorf("[ERROR] The configuration setting %s must be an integer, got %v", key, value)
+		}
+		min, hasMin := property["minimum"].(float64)
+		max, hasMax := property["maximum"].(float64)
+		if (hasMin && number < min) || (hasMax && number > max) {
+			return fmt.Errorf("[ERROR] The configuration setting %s must be between %v and %v, got %v", key, property["minimum"], property["maximum"], value)
+		}
+	case "string":
+		s, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("[ERROR] The configuration setting %s must be a string, got %v", key, value)
+		}
+		if choices, ok := property["choices"].([]interface{}); ok && len(choices) > 0 {
+			for _, choice := range choices {
+				if choice == s {
+					return nil
+				}
+			}
+			return fmt.Errorf("[ERROR] The configuration setting %s must be one of %v, got %s", key, choices, s)
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return fmt.Errorf("[ERROR] The configuration setting %s must be a boolean, got %v", key, value)
+		}
+	}
+	return nil
+}
+
+func getDatabaseConfigurationSchema(meta interface{}, deploymentID string) (interface{}, error) {
+	icdClient, err := meta.(conns.ClientSession).ICDAPI()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+	configSchema, err := icdClient.Configurations().GetConfiguration(flex.EscapeUrlParm(deploymentID))
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting database (%s) configuration schema : %s", deploymentID, err)
+	}
+	return configSchema, nil
+}
+
+// updateDatabaseConfiguration validates the settings against the
+// configuration schema of the deployment before they are applied, so that an
+// invalid setting fails before the configuration task is started. It returns
+// the applied settings that restarted the database.
+func updateDatabaseConfiguration(d *schema.ResourceData, meta interface{}, deploymentID string, settings map[string]interface{}, changed map[string]bool, timeout time.Duration) ([]string, error) {
+	configSchema, err := getDatabaseConfigurationSchema(meta, deploymentID)
+	if err != nil {
+		return nil, err
+	}
+	restart, err := ValidateDatabaseConfiguration(configSchema, settings, changed)
+	if err != nil {
+		return nil, err
+	}
+
+	icdClient, err := meta.(conns.ClientSession).ICDAPI()
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+	configPayload := icdv4.ConfigurationReq{Configuration: settings}
+	task, err := icdClient.Configurations().UpdateConfiguration(flex.EscapeUrlParm(deploymentID), configPayload)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error updating database (%s) configuration: %s", deploymentID, err)
+	}
+	_, err = waitForDatabaseTaskComplete(task.Id, d, meta, timeout)
+	if err != nil {
+		return nil, fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) configuration update task to complete: %s", deploymentID, err)
+	}
+	return restart, nil
+}
+
+// databaseConfigurationRestartWarning tells that the database restarted to
+// apply the settings, the restart is also planned as restart_required.
+func databaseConfigurationRestartWarning(deploymentID string, restart []string) diag.Diagnostics {
+	if len(restart) == 0 {
+		return nil
+	}
+	return diag.Diagnostics{{
+		Severity: diag.Warning,
+		Summary:  fmt.Sprintf("The database (%s) restarted to apply %s", deploymentID, strings.Join(restart, ", ")),
+	}}
+}
+
+func resourceIBMDatabaseConfigurationDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if !diff.NewValueKnown("deployment_id") {
+		return nil
+	}
+	deploymentID := diff.Get("deployment_id").(string)
+	engine, settings, changed := DatabaseConfigurationEngine(diff)
+	if engine == "" {
+		return nil
+	}
+	if err := checkDatabaseConfigurationEngine(engine, databaseServiceFromCRN(deploymentID)); err != nil {
+		return err
+	}
+	if len(changed) == 0 {
+		return nil
+	}
+
+	configSchema, err := getDatabaseConfigurationSchema(meta, deploymentID)
+	if err != nil {
+		return err
+	}
+	restart, err := ValidateDatabaseConfiguration(configSchema, settings, changed)
+	if err != nil {
+		return err
+	}
+	return diff.SetNew("restart_required", len(restart) > 0)
+}
+
+func resourceIBMDatabaseConfigurationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID := d.Get("deployment_id").(string)
+	engine, settings, changed := DatabaseConfigurationEngine(d)
+	if err := checkDatabaseConfigurationEngine(engine, databaseServiceFromCRN(deploymentID)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	var diags diag.Diagnostics
+	if len(settings) > 0 {
+		restart, err := updateDatabaseConfiguration(d, meta, deploymentID, settings, changed, d.Timeout(schema.TimeoutCreate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags = databaseConfigurationRestartWarning(deploymentID, restart)
+	}
+
+	d.SetId(deploymentID)
+
+	return append(diags, resourceIBMDatabaseConfigurationRead(context, d, meta)...)
+}
+
+func resourceIBMDatabaseConfigurationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	// ICD does not implement an API to read the configuration, the settings
+	// are populated from the tf configuration.
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{}
+	getDeploymentInfoOptions.SetID(d.Id())
+	_, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing database configuration (%s) from state because the deployment is not found via the API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] GetDeploymentInfo (%s) failed %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set("deployment_id", d.Id())
+	return nil
+}
+
+func resourceIBMDatabaseConfigurationUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	engine, settings, changed := DatabaseConfigurationEngine(d)
+	if err := checkDatabaseConfigurationEngine(engine, databaseServiceFromCRN(d.Id())); err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Only the changed settings are sent, so that unchanged settings that
+	// require a restart do not restart the database.
+	changedSettings := map[string]interface{}{}
+	for key := range changed {
+		changedSettings[key] = settings[key]
+	}
+	var diags diag.Diagnostics
+	if len(changedSettings) > 0 {
+		restart, err := updateDatabaseConfiguration(d, meta, d.Id(), changedSettings, changed, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		diags = databaseConfigurationRestartWarning(d.Id(), restart)
+	}
+
+	return append(diags, resourceIBMDatabaseConfigurationRead(context, d, meta)...)
+}
+
+func resourceIBMDatabaseConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The configuration can not be removed from a deployment
+	log.Println("Warning:  `terraform destroy` does not reset the database configuration but only clears the state file.")
+	d.SetId("")
+	return nil
+}
+
+// databaseConfigurationFromJSON decodes the configuration attribute of
+// ibm_database, numbers are decoded as float64 like the configuration schema.
+func databaseConfigurationFromJSON(s string) (map[string]interface{}, error) {
+	configuration := map[string]interface{}{}
+	if s == "" {
+		return configuration, nil
+	}
+	if err := json.Unmarshal([]byte(s), &configuration); err != nil {
+		return nil, fmt.Errorf("[ERROR] Error parsing the database configuration: %s", err)
+	}
+	return configuration, nil
+}
+
+// databaseConfigurationChange decodes the old and new configuration attribute
+// of ibm_database and returns the new settings and the ones that changed.
+func databaseConfigurationChange(o, n string) (map[string]interface{}, map[string]bool, error) {
+	oldSettings, err := databaseConfigurationFromJSON(o)
+	if err != nil {
+		return nil, nil, err
+	}
+	settings, err := databaseConfigurationFromJSON(n)
+	if err != nil {
+		return nil, nil, err
+	}
+	changed := map[string]bool{}
+	for key, value := range settings {
+		if !reflect.DeepEqual(oldSettings[key], value) {
+			changed[key] = true
+		}
+	}
+	return settings, changed, nil
+}
+
+// checkDatabaseConfigurationDiff validates the configuration attribute of
+// ibm_database against the configuration schema read from the deployment. The
+// schema of a new deployment is not known, its configuration is validated
+// before it is applied.
+func checkDatabaseConfigurationDiff(_ context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if diff.Id() == "" || !diff.HasChange("configuration") || !diff.NewValueKnown("configuration") {
+		return nil
+	}
+	s, ok := diff.GetOk("configuration_schema")
+	if !ok {
+		return nil
+	}
+	var configSchema interface{}
+	if err := json.Unmarshal([]byte(s.(string)), &configSchema); err != nil {
+		return nil
+	}
+
+	o, n := diff.GetChange("configuration")
+	settings, changed, err := databaseConfigurationChange(o.(string), n.(string))
+	if err != nil {
+		return err
+	}
+	restart, err := ValidateDatabaseConfiguration(configSchema, settings, changed)
+	if err != nil {
+		return err
+	}
+	return diff.SetNew("configuration_restart_required", len(restart) > 0)
+}
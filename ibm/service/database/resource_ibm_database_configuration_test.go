@@ -0,0 +1,220 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/database"
+
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMDatabaseConfigurationRedis(t *testing.T) {
+	resourceName := "ibm_database_configuration.configuration"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMDatabaseConfigurationPostgresConfig(),
+				ExpectError: regexp.MustCompile("block can not configure a databases-for-redis deployment"),
+			},
+			{
+				Config:      testAccCheckIBMDatabaseConfigurationRedisConfig("allkeys-typo"),
+				ExpectError: regexp.MustCompile("The configuration setting maxmemory-policy must be one of"),
+			},
+			{
+				Config: testAccCheckIBMDatabaseConfigurationRedisConfig("allkeys-lru"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "deployment_id", acc.IcdDbDeploymentId),
+					resource.TestCheckResourceAttr(resourceName, "redis.0.maxmemory_policy", "allkeys-lru"),
+					resource.TestCheckResourceAttr(resourceName, "restart_required", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseConfigurationRedisConfig("volatile-lru"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "redis.0.maxmemory_policy", "volatile-lru"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseConfigurationRedisConfig(policy string) string {
+	return fmt.Sprintf(`
+	resource "ibm_database_configuration" "configuration" {
+		deployment_id = "%s"
+
+		redis {
+			maxmemory_policy = "%s"
+		}
+	}
+	`, acc.IcdDbDeploymentId, policy)
+}
+
+func testAccCheckIBMDatabaseConfigurationPostgresConfig() string {
+	return fmt.Sprintf(`
+	resource "ibm_database_configuration" "configuration" {
+		deployment_id = "%s"
+
+		postgresql {
+			max_connections = 200
+		}
+	}
+	`, acc.IcdDbDeploymentId)
+}
+
+func TestValidateDatabaseConfiguration(t *testing.T) {
+	configSchema := map[string]interface{}{
+		"schema": map[string]interface{}{
+			"max_connections": map[string]interface{}{
+				"type":             "integer",
+				"minimum":          float64(115),
+				"maximum":          float64(5000),
+				"requires_restart": true,
+			},
+			"deadlock_timeout": map[string]interface{}{
+				"type":             "integer",
+				"minimum":          float64(100),
+				"requires_restart": false,
+			},
+			"maxmemory-policy": map[string]interface{}{
+				"type":    "string",
+				"choices": []interface{}{"noeviction", "allkeys-lru"},
+			},
+			"appendonly": map[string]interface{}{
+				"type":    "string",
+				"choices": []interface{}{"yes", "no"},
+			},
+		},
+	}
+
+	restart, err := database.ValidateDatabaseConfiguration(configSchema, map[string]interface{}{
+		"max_connections":  float64(200),
+		"deadlock_timeout": 1000,
+		"maxmemory-policy": "allkeys-lru",
+		"appendonly":       "yes",
+	}, map[string]bool{"max_connections": true, "deadlock_timeout": true})
+	if err != nil {
+		t.Fatalf("ValidateDatabaseConfiguration returned an error: %s", err)
+	}
+	if !reflect.DeepEqual(restart, []string{"max_connections"}) {
+		t.Errorf("restart = %v, want [max_connections]", restart)
+	}
+
+	restart, err = database.ValidateDatabaseConfiguration(configSchema, map[string]interface{}{
+		"max_connections": float64(200),
+	}, map[string]bool{})
+	if err != nil || len(restart) != 0 {
+		t.Errorf("unchanged settings: restart = %v, err = %v, want no restart", restart, err)
+	}
+
+	for setting, value := range map[string]interface{}{
+		"max_connections":  float64(100),
+		"deadlock_timeout": 150.5,
+		"maxmemory-policy": "allkeys-typo",
+		"appendonly":       "maybe",
+		"work_mem":         float64(1024),
+	} {
+		_, err = database.ValidateDatabaseConfiguration(configSchema, map[string]interface{}{setting: value}, map[string]bool{setting: true})
+		if err == nil {
+			t.Errorf("expected an error for %s = %v", setting, value)
+		}
+	}
+	_, err = database.ValidateDatabaseConfiguration(configSchema, map[string]interface{}{"max_connections": "200"}, nil)
+	if err == nil || !strings.Contains(err.Error(), "must be a number") {
+		t.Errorf("expected a string max_connections to fail as not a number, got %v", err)
+	}
+
+	restart, err = database.ValidateDatabaseConfiguration(nil, map[string]interface{}{"work_mem": float64(1024)}, nil)
+	if err != nil || restart != nil {
+		t.Errorf("without a schema: restart = %v, err = %v, want nothing", restart, err)
+	}
+}
+
+func TestDatabaseConfigurationEngine(t *testing.T) {
+	deploymentID := "crn:v1:bluemix:public:databases-for-postgresql:us-south:a/1234:5678::"
+	// After create the state holds the zero value of every setting that is not configured
+	state := &terraform.InstanceState{
+		ID: deploymentID,
+		Attributes: map[string]string{
+			"id":                                               deploymentID,
+			"deployment_id":                                    deploymentID,
+			"restart_required":                                 "false",
+			"postgresql.#":                                     "1",
+			"postgresql.0.archive_timeout":                     "0",
+			"postgresql.0.deadlock_timeout":                    "0",
+			"postgresql.0.log_connections":                     "",
+			"postgresql.0.max_connections":                     "200",
+			"postgresql.0.synchronous_commit":                  "",
+			"postgresql.0.tcp_keepalives_count":                "0",
+			"postgresql.0.idle_in_transaction_session_timeout": "0",
+		},
+	}
+
+	for _, c := range []struct {
+		name         string
+		config       map[string]interface{}
+		wantSettings map[string]interface{}
+		wantChanged  map[string]bool
+	}{
+		{
+			name:         "unchanged",
+			config:       map[string]interface{}{"max_connections": 200},
+			wantSettings: map[string]interface{}{"max_connections": 200},
+			wantChanged:  map[string]bool{},
+		},
+		{
+			name:         "edited",
+			config:       map[string]interface{}{"max_connections": 300, "deadlock_timeout": 1000},
+			wantSettings: map[string]interface{}{"max_connections": 300, "deadlock_timeout": 1000},
+			wantChanged:  map[string]bool{"max_connections": true, "deadlock_timeout": true},
+		},
+	} {
+		r := database.ResourceIBMDatabaseConfiguration()
+		var settings map[string]interface{}
+		var changed map[string]bool
+		r.CustomizeDiff = func(_ context.Context, diff *schema.ResourceDiff, _ interface{}) error {
+			_, settings, changed = database.DatabaseConfigurationEngine(diff)
+			return nil
+		}
+
+		raw := map[string]interface{}{
+			"deployment_id": deploymentID,
+			"postgresql":    []interface{}{c.config},
+		}
+		rawJSON, err := json.Marshal(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rawConfig, err := ctyjson.Unmarshal(rawJSON, r.CoreConfigSchema().ImpliedType())
+		if err != nil {
+			t.Fatal(err)
+		}
+		state.RawConfig = rawConfig
+
+		if _, err := r.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil); err != nil {
+			t.Fatalf("%s: Diff returned an error: %s", c.name, err)
+		}
+		if !reflect.DeepEqual(settings, c.wantSettings) {
+			t.Errorf("%s: settings = %v, want %v", c.name, settings, c.wantSettings)
+		}
+		if !reflect.DeepEqual(changed, c.wantChanged) {
+			t.Errorf("%s: changed = %v, want %v", c.name, changed, c.wantChanged)
+		}
+	}
+}
@@ -0,0 +1,196 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+)
+
+// Read-only replicas, and so their promotion, are only available for these engines
+var databaseReplicaPromotionServices = []string{"databases-for-postgresql", "databases-for-mysql", "databases-for-enterprisedb"}
+
+func ResourceIBMDatabaseReplicaPromotion() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseReplicaPromotionCreate,
+		ReadContext:   resourceIBMDatabaseReplicaPromotionRead,
+		DeleteContext: resourceIBMDatabaseReplicaPromotionDelete,
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				if !diff.NewValueKnown("deployment_id") {
+					return nil
+				}
+				return checkDatabaseReplicaPromotionService(diff.Get("deployment_id").(string))
+			},
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The CRN of the read-only replica to promote.",
+			},
+			"skip_initial_backup": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Skip the backup that is taken once the replica is promoted. The promotion completes faster, but there is no backup of the promoted deployment until the next scheduled backup.",
+			},
+			"mode": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "planned",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"planned", "unplanned"}),
+				Description:  "planned checks that the leader is available and still replicates to the replica before promoting it, unplanned promotes the replica without contacting the leader.",
+			},
+			"former_leader_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The CRN of the leader of the replica before the promotion.",
+			},
+			"task_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the promotion task.",
+			},
+		},
+	}
+}
+
+func databaseServiceSupportsReplicas(service string) bool {
+	for _, s := range databaseReplicaPromotionServices {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
+
+func checkDatabaseReplicaPromotionService(deploymentID string) error {
+	service := databaseServiceFromCRN(deploymentID)
+	if service == "" || databaseServiceSupportsReplicas(service) {
+		return nil
+	}
+	return fmt.Errorf("[ERROR] %s does not support read-only replicas and can not promote a replica, promotion is only supported by %s", service, strings.Join(databaseReplicaPromotionServices, ", "))
+}
+
+func resourceIBMDatabaseReplicaPromotionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	if err := checkDatabaseReplicaPromotionService(deploymentID); err != nil {
+		return diag.FromErr(err)
+	}
+
+	listRemotesOptions := &clouddatabasesv5.ListRemotesOptions{}
+	listRemotesOptions.SetID(deploymentID)
+	remotes, response, err := cloudDatabasesClient.ListRemotesWithContext(context, listRemotesOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] ListRemotes (%s) failed %s\n%s", deploymentID, err, response))
+	}
+	if remotes.Remotes == nil || remotes.Remotes.Leader == nil || *remotes.Remotes.Leader == "" {
+		return diag.FromErr(fmt.Errorf("[ERROR] The database (%s) is not a read-only replica and can not be promoted", deploymentID))
+	}
+	leaderID := *remotes.Remotes.Leader
+
+	if d.Get("mode").(string) == "planned" {
+		if err := checkDatabaseReplicaLeader(context, cloudDatabasesClient, leaderID, deploymentID); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	promoteReadOnlyReplicaOptions := &clouddatabasesv5.PromoteReadOnlyReplicaOptions{}
+	promoteReadOnlyReplicaOptions.SetID(deploymentID)
+	promoteReadOnlyReplicaOptions.SetPromotion(map[string]interface{}{
+		"skip_initial_backup": d.Get("skip_initial_backup").(bool),
+	})
+
+	promoteReadOnlyReplicaResponse, response, err := cloudDatabasesClient.PromoteReadOnlyReplicaWithContext(context, promoteReadOnlyReplicaOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] PromoteReadOnlyReplica (%s) failed %s\n%s", deploymentID, err, response))
+	}
+	taskID := *promoteReadOnlyReplicaResponse.Task.ID
+
+	_, err = waitForDatabaseTaskComplete(taskID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) promotion task to complete: %s", deploymentID, err))
+	}
+
+	d.SetId(deploymentID)
+	d.Set("former_leader_id", leaderID)
+	d.Set("task_id", taskID)
+
+	return resourceIBMDatabaseReplicaPromotionRead(context, d, meta)
+}
+
+// checkDatabaseReplicaLeader makes sure a planned promotion only starts while
+// the leader is available and still replicates to the replica.
+func checkDatabaseReplicaLeader(context context.Context, cloudDatabasesClient *clouddatabasesv5.CloudDatabasesV5, leaderID, replicaID string) error {
+	listRemotesOptions := &clouddatabasesv5.ListRemotesOptions{}
+	listRemotesOptions.SetID(leaderID)
+	remotes, response, err := cloudDatabasesClient.ListRemotesWithContext(context, listRemotesOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] The leader (%s) of the database (%s) is not available for a planned promotion, use the unplanned mode to promote the replica without the leader: %s\n%s", leaderID, replicaID, err, response)
+	}
+	if remotes.Remotes != nil {
+		for _, replica := range remotes.Remotes.Replicas {
+			if replica == replicaID {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("[ERROR] The leader (%s) does not list the database (%s) as a replica", leaderID, replicaID)
+}
+
+func resourceIBMDatabaseReplicaPromotionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	getDeploymentInfoOptions := &clouddatabasesv5.GetDeploymentInfoOptions{}
+	getDeploymentInfoOptions.SetID(d.Id())
+	_, response, err := cloudDatabasesClient.GetDeploymentInfoWithContext(context, getDeploymentInfoOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[WARN] Removing database replica promotion (%s) from state because the deployment is not found via the API", d.Id())
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] GetDeploymentInfo (%s) failed %s\n%s", d.Id(), err, response))
+	}
+
+	d.Set("deployment_id", d.Id())
+	return nil
+}
+
+func resourceIBMDatabaseReplicaPromotionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// A promotion can not be reverted
+	log.Println("Warning:  `terraform destroy` does not revert the promotion of the database but only clears the state file.")
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,92 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMDatabaseReplicaPromotionUnsupportedService(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMDatabaseReplicaPromotionConfig(fmt.Sprintf("%q", acc.IcdDbDeploymentId), "unplanned"),
+				ExpectError: regexp.MustCompile("does not support read-only replicas"),
+			},
+		},
+	})
+}
+
+func TestAccIBMDatabaseReplicaPromotionPostgres(t *testing.T) {
+	databaseResourceGroup := "default"
+	name := fmt.Sprintf("tf-postgres-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_database_replica_promotion.promotion"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMDatabaseReplicaPromotionLeaderConfig(databaseResourceGroup, name) +
+					testAccCheckIBMDatabaseReplicaPromotionConfig("ibm_database.replica.id", "planned"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(resourceName, "deployment_id", "ibm_database.replica", "id"),
+					resource.TestCheckResourceAttrPair(resourceName, "former_leader_id", "ibm_database.leader", "id"),
+					resource.TestCheckResourceAttrSet(resourceName, "task_id"),
+				),
+			},
+			{
+				// The promoted replica is a standalone deployment and shows no diff
+				Config: testAccCheckIBMDatabaseReplicaPromotionLeaderConfig(databaseResourceGroup, name) +
+					testAccCheckIBMDatabaseReplicaPromotionConfig("ibm_database.replica.id", "planned"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseReplicaPromotionLeaderConfig(databaseResourceGroup, name string) string {
+	return fmt.Sprintf(`
+	data "ibm_resource_group" "test_acc" {
+		name = "%[1]s"
+	}
+
+	resource "ibm_database" "leader" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+	}
+
+	resource "ibm_database" "replica" {
+		resource_group_id = data.ibm_resource_group.test_acc.id
+		name              = "%[2]s-replica"
+		service           = "databases-for-postgresql"
+		plan              = "standard"
+		location          = "%[3]s"
+		remote_leader_id  = ibm_database.leader.id
+	}
+	`, databaseResourceGroup, name, acc.IcdDbRegion)
+}
+
+func testAccCheckIBMDatabaseReplicaPromotionConfig(deploymentID, mode string) string {
+	return fmt.Sprintf(`
+	resource "ibm_database_replica_promotion" "promotion" {
+		deployment_id       = %s
+		mode                = "%s"
+		skip_initial_backup = true
+	}
+	`, deploymentID, mode)
+}
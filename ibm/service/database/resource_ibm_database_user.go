@@ -0,0 +1,397 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/IBM/go-sdk-core/v5/core"
+)
+
+// databaseUserTypeServices lists the engines supporting a user type, user types
+// that are not listed are supported by all engines.
+var databaseUserTypeServices = map[string][]string{
+	"ops_manager":       {"databases-for-mongodb"},
+	"read_only_replica": {"databases-for-postgresql"},
+}
+
+var databaseUserRoles = []string{"group_read_only", "group_data_access_admin"}
+
+type databaseUserPasswordRule struct {
+	minLength      int
+	maxLength      int
+	requireSpecial bool
+	allowed        *regexp.Regexp
+}
+
+const databaseUserPasswordSpecialChars = "~!@#$%^&*()-_=+[]{}|;:,.<>/?"
+
+var (
+	databaseUserPasswordRuleDefault = databaseUserPasswordRule{
+		minLength: 10,
+		maxLength: 32,
+		allowed:   regexp.MustCompile(`^[a-zA-Z0-9_-]+$`),
+	}
+	// Ops Manager enforces its own password policy
+	databaseUserPasswordRuleOpsManager = databaseUserPasswordRule{
+		minLength:      15,
+		maxLength:      32,
+		requireSpecial: true,
+		allowed:        regexp.MustCompile(`^[a-zA-Z0-9~!@#$%^&*()\-_=+\[\]{}|;:,.<>/?]+$`),
+	}
+	// Redis ACL passwords are used as they are in the connection string
+	databaseUserPasswordRuleRedis = databaseUserPasswordRule{
+		minLength: 15,
+		maxLength: 32,
+		allowed:   regexp.MustCompile(`^[a-zA-Z0-9_-]+$`),
+	}
+	databaseUserPasswordLetter = regexp.MustCompile(`[a-zA-Z]`)
+	databaseUserPasswordNumber = regexp.MustCompile(`[0-9]`)
+)
+
+func ResourceIBMDatabaseUser() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMDatabaseUserCreate,
+		ReadContext:   resourceIBMDatabaseUserRead,
+		UpdateContext: resourceIBMDatabaseUserUpdate,
+		DeleteContext: resourceIBMDatabaseUserDelete,
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceIBMDatabaseUserImport,
+		},
+
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMDatabaseUserDiff(diff)
+			},
+		),
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+			Update: schema.DefaultTimeout(20 * time.Minute),
+			Delete: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"deployment_id": {
+				Description: "The CRN of the database deployment",
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+			},
+			"name": {
+				Description:  "User name",
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringLenBetween(5, 32),
+			},
+			"type": {
+				Description:  "User type",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "database",
+				ValidateFunc: validation.StringInSlice([]string{"database", "ops_manager", "read_only_replica"}, false),
+			},
+			"role": {
+				Description:  "User role. Only available for ops_manager user type.",
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice(databaseUserRoles, false),
+			},
+			"password": {
+				Description:  "User password",
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				ValidateFunc: validation.StringLenBetween(10, 32),
+			},
+			"password_rotation_trigger": {
+				Description: "Arbitrary value that, when changed, sets the password of the user again",
+				Type:        schema.TypeString,
+				Optional:    true,
+			},
+		},
+	}
+}
+
+// resourceIBMDatabaseUserDiff enforces the password rules of the engine and
+// user type at plan time, the service only rejects the password once the
+// user task runs.
+func resourceIBMDatabaseUserDiff(diff *schema.ResourceDiff) error {
+	if !diff.NewValueKnown("deployment_id") || !diff.NewValueKnown("password") {
+		return nil
+	}
+	service := databaseServiceFromCRN(diff.Get("deployment_id").(string))
+	userType := diff.Get("type").(string)
+
+	if services, ok := databaseUserTypeServices[userType]; ok && service != "" {
+		supported := false
+		for _, s := range services {
+			if s == service {
+				supported = true
+			}
+		}
+		if !supported {
+			return fmt.Errorf("[ERROR] User type %s is only supported by %s, not by %s", userType, strings.Join(services, ", "), service)
+		}
+	}
+	if diff.Get("role").(string) != "" && userType != "ops_manager" {
+		return fmt.Errorf("[ERROR] role is only available for the ops_manager user type")
+	}
+
+	return ValidateDatabaseUserPassword(service, userType, diff.Get("password").(string))
+}
+
+func ValidateDatabaseUserPassword(service, userType, password string) error {
+	rule := databaseUserPasswordRuleDefault
+	if userType == "ops_manager" {
+		rule = databaseUserPasswordRuleOpsManager
+	} else if service == "databases-for-redis" {
+		rule = databaseUserPasswordRuleRedis
+	}
+
+	if len(password) < rule.minLength || len(password) > rule.maxLength {
+		return fmt.Errorf("[ERROR] The password of a %s user must be between %d and %d characters long", userType, rule.minLength, rule.maxLength)
+	}
+	if !databaseUserPasswordLetter.MatchString(password) || !databaseUserPasswordNumber.MatchString(password) {
+		return fmt.Errorf("[ERROR] The password of a %s user must contain at least one letter and one number", userType)
+	}
+	if rule.requireSpecial && !strings.ContainsAny(password, databaseUserPasswordSpecialChars) {
+		return fmt.Errorf("[ERROR] The password of a %s user must contain at least one of the special characters %s", userType, databaseUserPasswordSpecialChars)
+	}
+	if !rule.allowed.MatchString(password) {
+		if rule.requireSpecial {
+			return fmt.Errorf("[ERROR] The password of a %s user can only contain letters, numbers and the special characters %s", userType, databaseUserPasswordSpecialChars)
+		}
+		return fmt.Errorf("[ERROR] The password of a %s user can only contain letters, numbers, '-' and '_'", userType)
+	}
+	return nil
+}
+
+// databaseServiceFromCRN returns the service name of a deployment CRN such as
+// databases-for-postgresql, or "" when the ID is not a CRN.
+func databaseServiceFromCRN(crn string) string {
+	parts := strings.Split(crn, ":")
+	if len(parts) < 5 || parts[0] != "crn" {
+		return ""
+	}
+	return parts[4]
+}
+
+// The deployment ID is a CRN that contains "/", the user type and name are
+// taken from the end of the resource ID.
+func databaseUserParseID(id string) (deploymentID, userType, userName string, err error) {
+	parts := strings.Split(id, "/")
+	if len(parts) < 3 {
+		return "", "", "", fmt.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of deploymentID/userType/userName", id)
+	}
+	return strings.Join(parts[:len(parts)-2], "/"), parts[len(parts)-2], parts[len(parts)-1], nil
+}
+
+func resourceIBMDatabaseUserCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID := d.Get("deployment_id").(string)
+	userType := d.Get("type").(string)
+	userName := d.Get("name").(string)
+
+	user := &clouddatabasesv5.User{
+		Username: core.StringPtr(userName),
+		Password: core.StringPtr(d.Get("password").(string)),
+	}
+	// User Role only for ops_manager user type
+	if role := d.Get("role").(string); userType == "ops_manager" && role != "" {
+		user.Role = core.StringPtr(role)
+	}
+
+	createDatabaseUserOptions := &clouddatabasesv5.CreateDatabaseUserOptions{
+		ID:       &deploymentID,
+		UserType: &userType,
+		User:     user,
+	}
+
+	createDatabaseUserResponse, response, err := cloudDatabasesClient.CreateDatabaseUserWithContext(context, createDatabaseUserOptions)
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] CreateDatabaseUser (%s) failed %s\n%s", userName, err, response))
+	}
+
+	_, err = waitForDatabaseTaskComplete(*createDatabaseUserResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) user (%s) create task to complete: %s", deploymentID, userName, err))
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", deploymentID, userType, userName))
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	deploymentID, userType, userName, err := databaseUserParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	exists, err := databaseUserExists(context, meta, deploymentID, userType, userName)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if !exists {
+		log.Printf("[WARN] Removing database (%s) user (%s) from state because it's not found via the API", deploymentID, userName)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("deployment_id", deploymentID)
+	d.Set("type", userType)
+	d.Set("name", userName)
+	// ICD does not list users, the role of an ops_manager user is kept as it was
+	// created or imported
+	if userType != "ops_manager" {
+		d.Set("role", "")
+	}
+	return nil
+}
+
+// resourceIBMDatabaseUserImport takes the role of an ops_manager user from the
+// end of the import ID, ICD does not return it for an existing user.
+func resourceIBMDatabaseUserImport(context context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.Split(d.Id(), "/")
+	if len(parts) > 3 && parts[len(parts)-3] == "ops_manager" {
+		role := parts[len(parts)-1]
+		for _, r := range databaseUserRoles {
+			if r == role {
+				d.SetId(strings.Join(parts[:len(parts)-1], "/"))
+				d.Set("role", role)
+			}
+		}
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// ICD does not implement a GetUsers API, the connection of a user is only
+// returned while the user exists on the deployment.
+func databaseUserExists(context context.Context, meta interface{}, deploymentID, userType, userName string) (bool, error) {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return false, fmt.Errorf("[ERROR] Error getting database client settings: %s", err)
+	}
+
+	notFound := false
+	var lastErr, serverErr error
+	// The connection is only available on the enabled endpoints of the deployment,
+	// the user is only gone once no endpoint knows it
+	for _, endpointType := range []string{"public", "private"} {
+		getConnectionOptions := &clouddatabasesv5.GetConnectionOptions{}
+		getConnectionOptions.SetID(deploymentID)
+		getConnectionOptions.SetUserType(userType)
+		getConnectionOptions.SetUserID(userName)
+		getConnectionOptions.SetEndpointType(endpointType)
+
+		_, response, err := cloudDatabasesClient.GetConnectionWithContext(context, getConnectionOptions)
+		if err == nil {
+			return true, nil
+		}
+		lastErr = fmt.Errorf("[ERROR] Error getting database (%s) user (%s) connection: %s\n%s", deploymentID, userName, err, response)
+		switch {
+		case response != nil && response.StatusCode == 404:
+			notFound = true
+		case response == nil || response.StatusCode >= 500:
+			serverErr = lastErr
+		}
+	}
+	if notFound && serverErr == nil {
+		return false, nil
+	}
+	return false, lastErr
+}
+
+func resourceIBMDatabaseUserUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChange("password") || d.HasChange("password_rotation_trigger") {
+		cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+		}
+
+		deploymentID, userType, userName, err := databaseUserParseID(d.Id())
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		changeUserPasswordOptions := &clouddatabasesv5.ChangeUserPasswordOptions{
+			ID:       &deploymentID,
+			UserType: &userType,
+			Username: &userName,
+			User: &clouddatabasesv5.APasswordSettingUser{
+				Password: core.StringPtr(d.Get("password").(string)),
+			},
+		}
+
+		changeUserPasswordResponse, response, err := cloudDatabasesClient.ChangeUserPasswordWithContext(context, changeUserPasswordOptions)
+		if err != nil {
+			return diag.FromErr(fmt.Errorf("[ERROR] ChangeUserPassword (%s) failed %s\n%s", userName, err, response))
+		}
+
+		_, err = waitForDatabaseTaskComplete(*changeUserPasswordResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(fmt.Errorf(
+				"[ERROR] Error waiting for database (%s) user (%s) password update task to complete: %s", deploymentID, userName, err))
+		}
+	}
+
+	return resourceIBMDatabaseUserRead(context, d, meta)
+}
+
+func resourceIBMDatabaseUserDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	cloudDatabasesClient, err := meta.(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return diag.FromErr(fmt.Errorf("[ERROR] Error getting database client settings: %s", err))
+	}
+
+	deploymentID, userType, userName, err := databaseUserParseID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteDatabaseUserOptions := &clouddatabasesv5.DeleteDatabaseUserOptions{
+		ID:       &deploymentID,
+		UserType: &userType,
+		Username: &userName,
+	}
+
+	deleteDatabaseUserResponse, response, err := cloudDatabasesClient.DeleteDatabaseUserWithContext(context, deleteDatabaseUserOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(fmt.Errorf("[ERROR] DeleteDatabaseUser (%s) failed %s\n%s", userName, err, response))
+	}
+
+	_, err = waitForDatabaseTaskComplete(*deleteDatabaseUserResponse.Task.ID, d, meta, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(fmt.Errorf(
+			"[ERROR] Error waiting for database (%s) user (%s) delete task to complete: %s", deploymentID, userName, err))
+	}
+
+	d.SetId("")
+	return nil
+}
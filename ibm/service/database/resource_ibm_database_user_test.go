@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package database_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/database"
+
+	"github.com/IBM/cloud-databases-go-sdk/clouddatabasesv5"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMDatabaseUserBasic(t *testing.T) {
+	name := fmt.Sprintf("tfuser%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_database_user.user"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMDatabaseUserDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMDatabaseUserConfig(name, "password12", "1"),
+				ExpectError: regexp.MustCompile("must be between 15 and 32 characters long"),
+			},
+			{
+				Config: testAccCheckIBMDatabaseUserConfig(name, "password-for-tf-user1", "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "type", "database"),
+				),
+			},
+			{
+				Config: testAccCheckIBMDatabaseUserConfig(name, "password-for-tf-user2", "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMDatabaseUserExists(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "password_rotation_trigger", "2"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"password", "password_rotation_trigger"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMDatabaseUserConfig(name, password, trigger string) string {
+	return fmt.Sprintf(`
+	resource "ibm_database_user" "user" {
+		deployment_id             = "%s"
+		name                      = "%s"
+		password                  = "%s"
+		password_rotation_trigger = "%s"
+	}
+	`, acc.IcdDbDeploymentId, name, password, trigger)
+}
+
+func testAccCheckIBMDatabaseUserExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		exists, err := testAccIBMDatabaseUserConnectionFound(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return fmt.Errorf("Database user %s not found", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMDatabaseUserDestroy(s *terraform.State) error {
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_database_user" {
+			continue
+		}
+		exists, err := testAccIBMDatabaseUserConnectionFound(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if exists {
+			return fmt.Errorf("Database user %s still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccIBMDatabaseUserConnectionFound(id string) (bool, error) {
+	cloudDatabasesClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).CloudDatabasesV5()
+	if err != nil {
+		return false, err
+	}
+	parts := strings.Split(id, "/")
+	getConnectionOptions := &clouddatabasesv5.GetConnectionOptions{}
+	getConnectionOptions.SetID(strings.Join(parts[:len(parts)-2], "/"))
+	getConnectionOptions.SetUserType(parts[len(parts)-2])
+	getConnectionOptions.SetUserID(parts[len(parts)-1])
+	getConnectionOptions.SetEndpointType("public")
+
+	_, response, err := cloudDatabasesClient.GetConnection(getConnectionOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+func TestValidateDatabaseUserPassword(t *testing.T) {
+	cases := []struct {
+		service  string
+		userType string
+		password string
+		valid    bool
+	}{
+		{"databases-for-postgresql", "database", "password1234", true},
+		{"databases-for-postgresql", "database", "pass_word-12", true},
+		{"databases-for-postgresql", "database", "short1", false},
+		{"databases-for-postgresql", "database", "passwordonly", false},
+		{"databases-for-postgresql", "database", "12345678901", false},
+		{"databases-for-postgresql", "database", "password!1234", false},
+		{"databases-for-postgresql", "database", "password123456789012345678901234567", false},
+		{"databases-for-redis", "database", "password1234", false},
+		{"databases-for-redis", "database", "password12345678", true},
+		{"databases-for-mongodb", "ops_manager", "password12345678", false},
+		{"databases-for-mongodb", "ops_manager", "password!2345678", true},
+		{"databases-for-mongodb", "ops_manager", "password!234567\"", false},
+		{"", "database", "password1234", true},
+	}
+	for _, c := range cases {
+		err := database.ValidateDatabaseUserPassword(c.service, c.userType, c.password)
+		if c.valid && err != nil {
+			t.Errorf("%s %s %q: unexpected error %s", c.service, c.userType, c.password, err)
+		} else if !c.valid && err == nil {
+			t.Errorf("%s %s %q: expected an error", c.service, c.userType, c.password)
+		}
+	}
+}
@@ -0,0 +1,227 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMEventStreamsConsumerGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMEventStreamsConsumerGroupsRead,
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID or the CRN of the Event Streams service instance",
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with an Event Streams REST API",
+			},
+			"group_ids": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The IDs of the consumer groups, all consumer groups are returned when not set",
+			},
+			"topic": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only return the partitions of this topic",
+			},
+			"consumer_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The consumer groups of the instance",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"group_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the consumer group",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The state of the consumer group, e.g. Stable, Empty or PreparingRebalance",
+						},
+						"members": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The members of the consumer group",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"consumer_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The ID of the consumer",
+									},
+									"client_id": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The client ID of the consumer",
+									},
+									"host": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The host of the consumer",
+									},
+								},
+							},
+						},
+						"total_lag": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The lag of the consumer group over all partitions",
+						},
+						"partitions": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The offsets and the lag of the consumer group by partition",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic": {
+										Type:        schema.TypeString,
+										Computed:    true,
+										Description: "The name of the topic",
+									},
+									"partition": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The partition of the topic",
+									},
+									"current_offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The offset committed by the consumer group",
+									},
+									"end_offset": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The offset of the last message of the partition",
+									},
+									"lag": {
+										Type:        schema.TypeInt,
+										Computed:    true,
+										Description: "The number of messages of the partition that the consumer group did not consume",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMEventStreamsConsumerGroupsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, instanceCRN, err := createAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	groupIDs := flex.ExpandStringList(d.Get("group_ids").([]interface{}))
+	if len(groupIDs) == 0 {
+		groups, response, err := adminrestClient.ListConsumerGroupsWithContext(context, &adminrestv1.ListConsumerGroupsOptions{})
+		if err != nil {
+			log.Printf("[DEBUG] ListConsumerGroupsWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("ListConsumerGroupsWithContext failed %s\n%s", err, response))
+		}
+		groupIDs = groups
+	}
+
+	topic := d.Get("topic").(string)
+	consumerGroups := make([]map[string]interface{}, 0, len(groupIDs))
+	for _, groupID := range groupIDs {
+		getConsumerGroupOptions := &adminrestv1.GetConsumerGroupOptions{
+			GroupID: core.StringPtr(groupID),
+		}
+		group, response, err := adminrestClient.GetConsumerGroupWithContext(context, getConsumerGroupOptions)
+		if err != nil || group == nil {
+			log.Printf("[DEBUG] GetConsumerGroupWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("GetConsumerGroupWithContext %s failed %s\n%s", groupID, err, response))
+		}
+		consumerGroups = append(consumerGroups, FlattenEventStreamsConsumerGroup(group, topic))
+	}
+
+	d.SetId(getConsumerGroupsID(instanceCRN))
+	d.Set("consumer_groups", consumerGroups)
+
+	return nil
+}
+
+// FlattenEventStreamsConsumerGroup flattens the consumer group with the lag of
+// its partitions, the partitions are limited to the topic when it is set.
+func FlattenEventStreamsConsumerGroup(group *adminrestv1.GroupDetail, topic string) map[string]interface{} {
+	members := make([]map[string]interface{}, 0, len(group.Members))
+	for _, member := range group.Members {
+		members = append(members, map[string]interface{}{
+			"consumer_id": core.StringNilMapper(member.ConsumerID),
+			"client_id":   core.StringNilMapper(member.ClientID),
+			"host":        core.StringNilMapper(member.Host),
+		})
+	}
+
+	var totalLag int64
+	partitions := make([]map[string]interface{}, 0, len(group.Offsets))
+	for _, offset := range group.Offsets {
+		if topic != "" && core.StringNilMapper(offset.Topic) != topic {
+			continue
+		}
+		// A partition without a committed offset has a negative current offset
+		currentOffset := int64(-1)
+		if offset.CurrentOffset != nil {
+			currentOffset = *offset.CurrentOffset
+		}
+		var endOffset, partition int64
+		if offset.EndOffset != nil {
+			endOffset = *offset.EndOffset
+		}
+		if offset.Partition != nil {
+			partition = *offset.Partition
+		}
+		lag := endOffset
+		if currentOffset >= 0 {
+			lag = endOffset - currentOffset
+		}
+		if lag < 0 {
+			lag = 0
+		}
+		totalLag += lag
+		partitions = append(partitions, map[string]interface{}{
+			"topic":          core.StringNilMapper(offset.Topic),
+			"partition":      partition,
+			"current_offset": currentOffset,
+			"end_offset":     endOffset,
+			"lag":            lag,
+		})
+	}
+
+	return map[string]interface{}{
+		"group_id":   core.StringNilMapper(group.GroupID),
+		"state":      core.StringNilMapper(group.State),
+		"members":    members,
+		"total_lag":  totalLag,
+		"partitions": partitions,
+	}
+}
+
+func getConsumerGroupsID(instanceCRN string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "consumergroups"
+	crnSegments[9] = ""
+	return strings.Join(crnSegments, ":")
+}
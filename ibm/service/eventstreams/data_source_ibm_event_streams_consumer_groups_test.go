@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/eventstreams"
+
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsConsumerGroupsDataSourceWithExistingInstance(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsConsumerGroupsDataSource(existingInstanceName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_groups.es_consumer_groups", "id"),
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_groups.es_consumer_groups", "kafka_http_url"),
+					resource.TestCheckResourceAttrSet("data.ibm_event_streams_consumer_groups.es_consumer_groups", "consumer_groups.#"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsConsumerGroupsDataSource(instanceName string) string {
+	return getPlatformResource(instanceName) + `
+	data "ibm_event_streams_consumer_groups" "es_consumer_groups" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+	}`
+}
+
+func TestFlattenEventStreamsConsumerGroup(t *testing.T) {
+	group := &adminrestv1.GroupDetail{
+		GroupID: core.StringPtr("group"),
+		State:   core.StringPtr("Stable"),
+		Members: []adminrestv1.Member{{
+			ConsumerID: core.StringPtr("consumer-1"),
+			ClientID:   core.StringPtr("client-1"),
+			Host:       core.StringPtr("/10.0.0.1"),
+		}},
+		Offsets: []adminrestv1.TopicPartitionOffset{
+			{Topic: core.StringPtr("orders"), Partition: core.Int64Ptr(0), CurrentOffset: core.Int64Ptr(90), EndOffset: core.Int64Ptr(100)},
+			{Topic: core.StringPtr("orders"), Partition: core.Int64Ptr(1), CurrentOffset: core.Int64Ptr(-1), EndOffset: core.Int64Ptr(5)},
+			{Topic: core.StringPtr("payments"), Partition: core.Int64Ptr(0), EndOffset: core.Int64Ptr(7)},
+		},
+	}
+
+	flattened := eventstreams.FlattenEventStreamsConsumerGroup(group, "")
+	if flattened["group_id"] != "group" || flattened["state"] != "Stable" {
+		t.Errorf("group_id = %v, state = %v, want group and Stable", flattened["group_id"], flattened["state"])
+	}
+	if flattened["total_lag"] != int64(22) {
+		t.Errorf("total_lag = %v, want 22", flattened["total_lag"])
+	}
+	if partitions := flattened["partitions"].([]map[string]interface{}); len(partitions) != 3 {
+		t.Errorf("got %d partitions, want 3", len(partitions))
+	}
+	if clientID := flattened["members"].([]map[string]interface{})[0]["client_id"]; clientID != "client-1" {
+		t.Errorf("client_id = %v, want client-1", clientID)
+	}
+
+	flattened = eventstreams.FlattenEventStreamsConsumerGroup(group, "orders")
+	if flattened["total_lag"] != int64(15) {
+		t.Errorf("total_lag of orders = %v, want 15", flattened["total_lag"])
+	}
+	partitions := flattened["partitions"].([]map[string]interface{})
+	if len(partitions) != 2 {
+		t.Fatalf("got %d partitions of orders, want 2", len(partitions))
+	}
+	if partitions[1]["current_offset"] != int64(-1) || partitions[1]["lag"] != int64(5) {
+		t.Errorf("current_offset = %v, lag = %v, want -1 and 5", partitions[1]["current_offset"], partitions[1]["lag"])
+	}
+}
@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMEventStreamsMirroringConfig() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsMirroringConfigUpdate,
+		ReadContext:   resourceIBMEventStreamsMirroringConfigRead,
+		UpdateContext: resourceIBMEventStreamsMirroringConfigUpdate,
+		DeleteContext: resourceIBMEventStreamsMirroringConfigDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The ID or the CRN of the Event Streams service instance that is the target of the mirroring",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with an Event Streams REST API",
+			},
+			"mirroring_topic_patterns": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString, ValidateFunc: validateMirroringTopicPattern},
+				Description: "The regular expressions of the topics of the source instance that are mirrored, e.g. orders\\..*",
+			},
+			"mirroring_active_topics": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The topics that are currently mirrored",
+			},
+		},
+	}
+}
+
+func validateMirroringTopicPattern(v interface{}, k string) (ws []string, errors []error) {
+	if _, err := regexp.Compile(v.(string)); err != nil {
+		errors = append(errors, fmt.Errorf("%q is not a valid regular expression: %s", k, err))
+	}
+	return
+}
+
+func resourceIBMEventStreamsMirroringConfigUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, instanceCRN, err := createAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	replaceOptions := adminrestClient.NewReplaceMirroringTopicSelectionOptions()
+	replaceOptions.SetIncludes(flex.ExpandStringList(d.Get("mirroring_topic_patterns").([]interface{})))
+
+	_, response, err := adminrestClient.ReplaceMirroringTopicSelectionWithContext(context, replaceOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ReplaceMirroringTopicSelectionWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ReplaceMirroringTopicSelectionWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(getMirroringConfigID(instanceCRN))
+
+	return resourceIBMEventStreamsMirroringConfigRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsMirroringConfigRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, instanceCRN, err := createAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	selection, response, err := adminrestClient.GetMirroringTopicSelectionWithContext(context, adminrestClient.NewGetMirroringTopicSelectionOptions())
+	if err != nil {
+		log.Printf("[DEBUG] GetMirroringTopicSelectionWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetMirroringTopicSelectionWithContext failed %s\n%s", err, response))
+	}
+
+	// resource_instance_id is only known from the ID after an import
+	if _, ok := d.GetOk("resource_instance_id"); !ok {
+		d.Set("resource_instance_id", instanceCRN)
+	}
+	includes := []string{}
+	if selection != nil && selection.Includes != nil {
+		includes = selection.Includes
+	}
+	d.Set("mirroring_topic_patterns", includes)
+
+	activeTopics, response, err := adminrestClient.GetMirroringActiveTopicsWithContext(context, adminrestClient.NewGetMirroringActiveTopicsOptions())
+	if err != nil {
+		log.Printf("[DEBUG] GetMirroringActiveTopicsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetMirroringActiveTopicsWithContext failed %s\n%s", err, response))
+	}
+	if activeTopics != nil {
+		d.Set("mirroring_active_topics", activeTopics.ActiveTopics)
+	}
+
+	return nil
+}
+
+func resourceIBMEventStreamsMirroringConfigDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, _, err := createAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The topic selection can not be deleted, no topic is mirrored anymore
+	replaceOptions := adminrestClient.NewReplaceMirroringTopicSelectionOptions()
+	replaceOptions.SetIncludes([]string{})
+
+	_, response, err := adminrestClient.ReplaceMirroringTopicSelectionWithContext(context, replaceOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ReplaceMirroringTopicSelectionWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ReplaceMirroringTopicSelectionWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+func getMirroringConfigID(instanceCRN string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "mirroring"
+	crnSegments[9] = "topic-selection"
+	return strings.Join(crnSegments, ":")
+}
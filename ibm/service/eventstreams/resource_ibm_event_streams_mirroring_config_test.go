@@ -0,0 +1,60 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+// existingMirroringInstanceName is an enterprise instance that is the target of a mirroring
+var existingMirroringInstanceName = "hyperion-preprod-spp-mirroring-target"
+
+func TestAccIBMEventStreamsMirroringConfigResourceWithExistingInstance(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMEventStreamsMirroringConfigWithExistingInstance(existingMirroringInstanceName, []string{"orders\\\\.(.*"}),
+				ExpectError: regexp.MustCompile("is not a valid regular expression"),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsMirroringConfigWithExistingInstance(existingMirroringInstanceName, []string{"orders\\\\..*"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_mirroring_config.es_mirroring_config", "id"),
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring_config", "mirroring_topic_patterns.#", "1"),
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring_config", "mirroring_topic_patterns.0", "orders\\..*"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsMirroringConfigWithExistingInstance(existingMirroringInstanceName, []string{"orders\\\\..*", "payments"}),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring_config", "mirroring_topic_patterns.#", "2"),
+					resource.TestCheckResourceAttr("ibm_event_streams_mirroring_config.es_mirroring_config", "mirroring_topic_patterns.1", "payments"),
+				),
+			},
+			{
+				ResourceName:            "ibm_event_streams_mirroring_config.es_mirroring_config",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_instance_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsMirroringConfigWithExistingInstance(instanceName string, patterns []string) string {
+	return getPlatformResource(instanceName) + fmt.Sprintf(`
+	resource "ibm_event_streams_mirroring_config" "es_mirroring_config" {
+		resource_instance_id     = data.ibm_resource_instance.es_instance.id
+		mirroring_topic_patterns = ["%s"]
+	}`, strings.Join(patterns, `", "`))
+}
@@ -0,0 +1,256 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/eventstreams-go-sdk/pkg/adminrestv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func ResourceIBMEventStreamsQuota() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMEventStreamsQuotaCreate,
+		ReadContext:   resourceIBMEventStreamsQuotaRead,
+		UpdateContext: resourceIBMEventStreamsQuotaUpdate,
+		DeleteContext: resourceIBMEventStreamsQuotaDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"resource_instance_id": {
+				Type:        schema.TypeString,
+				Description: "The ID or the CRN of the Event Streams service instance",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"kafka_http_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The API endpoint for interacting with an Event Streams REST API",
+			},
+			"entity": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The entity of the quota, either default or the IAM ID of a user or service ID, e.g. iam-ServiceId-00001111-2222-3333-4444-555566667777",
+			},
+			"producer_byte_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+				Description:  "The producer quota in bytes per second, -1 means no producer quota",
+			},
+			"consumer_byte_rate": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      -1,
+				ValidateFunc: validation.IntAtLeast(-1),
+				Description:  "The consumer quota in bytes per second, -1 means no consumer quota",
+			},
+		},
+	}
+}
+
+func resourceIBMEventStreamsQuotaCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, instanceCRN, err := createAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entity := d.Get("entity").(string)
+	producerByteRate, consumerByteRate := expandEventStreamsQuota(d)
+	if producerByteRate == nil && consumerByteRate == nil {
+		return diag.FromErr(fmt.Errorf("at least one of producer_byte_rate and consumer_byte_rate must be 0 or more"))
+	}
+
+	createQuotaOptions := &adminrestv1.CreateQuotaOptions{
+		EntityName:       &entity,
+		ProducerByteRate: producerByteRate,
+		ConsumerByteRate: consumerByteRate,
+	}
+	response, err := adminrestClient.CreateQuotaWithContext(context, createQuotaOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateQuotaWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateQuotaWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(getQuotaID(instanceCRN, entity))
+
+	return resourceIBMEventStreamsQuotaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, instanceCRN, err := createAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entity := getQuotaEntity(d.Id())
+
+	getQuotaOptions := &adminrestv1.GetQuotaOptions{
+		EntityName: &entity,
+	}
+	quota, response, err := adminrestClient.GetQuotaWithContext(context, getQuotaOptions)
+	if err != nil || quota == nil {
+		if response != nil && response.StatusCode == 404 {
+			log.Printf("[INFO] resourceIBMEventStreamsQuotaRead quota %s does not exist", entity)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetQuotaWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetQuotaWithContext failed %s\n%s", err, response))
+	}
+
+	// resource_instance_id is only known from the ID after an import
+	if _, ok := d.GetOk("resource_instance_id"); !ok {
+		d.Set("resource_instance_id", instanceCRN)
+	}
+	d.Set("entity", entity)
+	d.Set("producer_byte_rate", flattenEventStreamsByteRate(quota.ProducerByteRate))
+	d.Set("consumer_byte_rate", flattenEventStreamsByteRate(quota.ConsumerByteRate))
+
+	return nil
+}
+
+func resourceIBMEventStreamsQuotaUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if !d.HasChanges("producer_byte_rate", "consumer_byte_rate") {
+		return resourceIBMEventStreamsQuotaRead(context, d, meta)
+	}
+	adminrestClient, _, err := createAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entity := d.Get("entity").(string)
+	producerByteRate, consumerByteRate := expandEventStreamsQuota(d)
+	if producerByteRate == nil && consumerByteRate == nil {
+		return diag.FromErr(fmt.Errorf("at least one of producer_byte_rate and consumer_byte_rate must be 0 or more"))
+	}
+
+	// A removed rate can not be patched, the quota is replaced instead
+	if producerByteRate == nil || consumerByteRate == nil {
+		deleteQuotaOptions := &adminrestv1.DeleteQuotaOptions{
+			EntityName: &entity,
+		}
+		response, err := adminrestClient.DeleteQuotaWithContext(context, deleteQuotaOptions)
+		if err != nil && (response == nil || response.StatusCode != 404) {
+			log.Printf("[DEBUG] DeleteQuotaWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("DeleteQuotaWithContext failed %s\n%s", err, response))
+		}
+		createQuotaOptions := &adminrestv1.CreateQuotaOptions{
+			EntityName:       &entity,
+			ProducerByteRate: producerByteRate,
+			ConsumerByteRate: consumerByteRate,
+		}
+		response, err = adminrestClient.CreateQuotaWithContext(context, createQuotaOptions)
+		if err != nil {
+			log.Printf("[DEBUG] CreateQuotaWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("CreateQuotaWithContext failed %s\n%s", err, response))
+		}
+	} else {
+		updateQuotaOptions := &adminrestv1.UpdateQuotaOptions{
+			EntityName:       &entity,
+			ProducerByteRate: producerByteRate,
+			ConsumerByteRate: consumerByteRate,
+		}
+		response, err := adminrestClient.UpdateQuotaWithContext(context, updateQuotaOptions)
+		if err != nil {
+			log.Printf("[DEBUG] UpdateQuotaWithContext failed %s\n%s", err, response)
+			return diag.FromErr(fmt.Errorf("UpdateQuotaWithContext failed %s\n%s", err, response))
+		}
+	}
+
+	return resourceIBMEventStreamsQuotaRead(context, d, meta)
+}
+
+func resourceIBMEventStreamsQuotaDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	adminrestClient, _, err := createAdminRestClient(d, meta)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	entity := getQuotaEntity(d.Id())
+
+	deleteQuotaOptions := &adminrestv1.DeleteQuotaOptions{
+		EntityName: &entity,
+	}
+	response, err := adminrestClient.DeleteQuotaWithContext(context, deleteQuotaOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		log.Printf("[DEBUG] DeleteQuotaWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteQuotaWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}
+
+// expandEventStreamsQuota returns the producer and the consumer byte rates,
+// a negative rate is not set.
+func expandEventStreamsQuota(d *schema.ResourceData) (*int64, *int64) {
+	var producerByteRate, consumerByteRate *int64
+	if rate := int64(d.Get("producer_byte_rate").(int)); rate >= 0 {
+		producerByteRate = &rate
+	}
+	if rate := int64(d.Get("consumer_byte_rate").(int)); rate >= 0 {
+		consumerByteRate = &rate
+	}
+	return producerByteRate, consumerByteRate
+}
+
+func flattenEventStreamsByteRate(rate *int64) int64 {
+	if rate == nil {
+		return -1
+	}
+	return *rate
+}
+
+// createAdminRestClient returns a copy of the admin REST client that is
+// configured with the kafka_http_url of the instance.
+func createAdminRestClient(d *schema.ResourceData, meta interface{}) (*adminrestv1.AdminrestV1, string, error) {
+	adminrestClient, err := meta.(conns.ClientSession).ESadminRestSession()
+	if err != nil {
+		return nil, "", err
+	}
+	instanceCRN := d.Get("resource_instance_id").(string)
+	if len(instanceCRN) == 0 {
+		id := d.Id()
+		if len(id) == 0 || !strings.Contains(id, ":") {
+			log.Printf("[DEBUG] createAdminRestClient resource_instance_id is missing")
+			return nil, "", fmt.Errorf("resource_instance_id is required")
+		}
+		instanceCRN = getInstanceCRN(id)
+	}
+	instance, err := getInstanceDetails(instanceCRN, meta)
+	if err != nil {
+		return nil, "", err
+	}
+	adminURL, ok := instance.Extensions["kafka_http_url"].(string)
+	if !ok || adminURL == "" {
+		return nil, "", fmt.Errorf("the Event Streams instance %s has no kafka_http_url", instanceCRN)
+	}
+	d.Set("kafka_http_url", adminURL)
+	log.Printf("[INFO] createAdminRestClient kafka_http_url is set to %s", adminURL)
+
+	adminrestClient = adminrestClient.Clone()
+	if err := adminrestClient.SetServiceURL(adminURL); err != nil {
+		return nil, "", err
+	}
+	return adminrestClient, *instance.CRN, nil
+}
+
+func getQuotaID(instanceCRN string, entity string) string {
+	crnSegments := strings.Split(instanceCRN, ":")
+	crnSegments[8] = "quota"
+	crnSegments[9] = entity
+	return strings.Join(crnSegments, ":")
+}
+
+func getQuotaEntity(id string) string {
+	return strings.Split(id, ":")[9]
+}
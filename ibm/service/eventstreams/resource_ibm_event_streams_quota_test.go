@@ -0,0 +1,66 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package eventstreams_test
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMEventStreamsQuotaResourceWithExistingInstance(t *testing.T) {
+	producerByteRate := 1048576
+	consumerByteRate := 2097152
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMEventStreamsQuotaWithExistingInstance(existingInstanceName, "default", producerByteRate, -1),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_event_streams_quota.es_quota", "id"),
+					resource.TestCheckResourceAttrSet("ibm_event_streams_quota.es_quota", "kafka_http_url"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "entity", "default"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", strconv.Itoa(producerByteRate)),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", "-1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMEventStreamsQuotaWithExistingInstance(existingInstanceName, "default", producerByteRate, consumerByteRate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", strconv.Itoa(producerByteRate)),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", strconv.Itoa(consumerByteRate)),
+				),
+			},
+			{
+				// Removing the producer quota replaces the quota
+				Config: testAccCheckIBMEventStreamsQuotaWithExistingInstance(existingInstanceName, "default", -1, consumerByteRate),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "producer_byte_rate", "-1"),
+					resource.TestCheckResourceAttr("ibm_event_streams_quota.es_quota", "consumer_byte_rate", strconv.Itoa(consumerByteRate)),
+				),
+			},
+			{
+				ResourceName:            "ibm_event_streams_quota.es_quota",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"resource_instance_id"},
+			},
+		},
+	})
+}
+
+func testAccCheckIBMEventStreamsQuotaWithExistingInstance(instanceName, entity string, producerByteRate, consumerByteRate int) string {
+	return getPlatformResource(instanceName) + fmt.Sprintf(`
+	resource "ibm_event_streams_quota" "es_quota" {
+		resource_instance_id = data.ibm_resource_instance.es_instance.id
+		entity               = "%s"
+		producer_byte_rate   = %d
+		consumer_byte_rate   = %d
+	}`, entity, producerByteRate, consumerByteRate)
+}
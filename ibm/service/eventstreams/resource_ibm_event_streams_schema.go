@@ -190,7 +190,7 @@ func resourceIBMEventStreamsSchemaCreate(context context.Context, d *schema.Reso
 		createSchemaOptions.Schema = schema
 	}
 	if _, ok := d.GetOk("schema_id"); ok {
-		createSchemaOptions.SetID(d.Get("schema_id").(string))
+		createSchemaOptions.SetXRegistryArtifactID(d.Get("schema_id").(string))
 	}
 
 	schemaMetadata, response, err := schemaregistryClient.CreateSchemaWithContext(context, createSchemaOptions)
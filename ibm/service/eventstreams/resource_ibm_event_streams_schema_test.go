@@ -187,7 +187,7 @@ func testAccCheckIBMEventStreamsSchemaExists(n string, obj map[string]interface{
 			return err
 		}
 
-		obj = avroSchema
+		obj = avroSchema.Schema
 		return nil
 	}
 }
@@ -4,15 +4,21 @@
 package iamidentity
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"log"
 	"strconv"
+	"time"
 
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	homedir "github.com/mitchellh/go-homedir"
 )
@@ -26,6 +32,12 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 		Exists:   resourceIBMIAMServiceAPIKeyExists,
 		Importer: &schema.ResourceImporter{},
 
+		CustomizeDiff: customdiff.Sequence(
+			func(_ context.Context, diff *schema.ResourceDiff, v interface{}) error {
+				return resourceIBMIAMServiceAPIKeyRotationDiff(diff)
+			},
+		),
+
 		Schema: map[string]*schema.Schema{
 			"name": {
 				Type:        schema.TypeString,
@@ -58,10 +70,63 @@ func ResourceIBMIAMServiceAPIKey() *schema.Resource {
 				Optional:    true,
 				Computed:    true,
 				Sensitive:   true,
-				ForceNew:    true,
 				Description: "API key value for this API key",
 			},
 
+			"rotation": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				ConflictsWith: []string{"apikey"},
+				Description:   "Rotates the API key on apply once the interval has passed, keeping the previous key during the overlap",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"interval_days": {
+							Type:         schema.TypeInt,
+							Required:     true,
+							ValidateFunc: validate.ValidateAllowedRangeInt(1, 3650),
+							Description:  "Number of days after which the API key is rotated",
+						},
+						"overlap_days": {
+							Type:         schema.TypeInt,
+							Optional:     true,
+							Default:      0,
+							ValidateFunc: validate.ValidateAllowedRangeInt(0, 3650),
+							Description:  "Number of days the previous API key stays valid after a rotation, with 0 it is deleted by the next apply",
+						},
+						"triggers": {
+							Type:        schema.TypeMap,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Arbitrary map of values that, when changed, rotates the API key on the next apply",
+						},
+						"secrets_manager_secret_id": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "ID of an arbitrary Secrets Manager secret that receives every new API key as a new version",
+						},
+					},
+				},
+			},
+
+			"current_apikey_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the API key in use",
+			},
+
+			"previous_apikey_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the API key replaced by the last rotation, while the overlap lasts",
+			},
+
+			"rotated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date and time the API key was last rotated",
+			},
+
 			"locked": {
 				Type:             schema.TypeBool,
 				Optional:         true,
@@ -178,6 +243,13 @@ func resourceIBMIAMServiceAPIkeyCreate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if _, ok := d.GetOk("rotation"); ok {
+		d.Set("rotated_at", time.Now().UTC().Format(time.RFC3339))
+		if err := pushServiceAPIKeyToSecretsManager(d, meta, *apiKey.Apikey); err != nil {
+			return err
+		}
+	}
+
 	return resourceIBMIAMServiceAPIKeyRead(d, meta)
 }
 
@@ -186,7 +258,7 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 	if err != nil {
 		return err
 	}
-	apiKeyID := d.Id()
+	apiKeyID := serviceAPIKeyID(d)
 
 	getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
 		ID: &apiKeyID,
@@ -233,6 +305,20 @@ func resourceIBMIAMServiceAPIKeyRead(d *schema.ResourceData, meta interface{}) e
 	if apiKey.ModifiedAt != nil {
 		d.Set("modified_at", apiKey.ModifiedAt.String())
 	}
+	d.Set("current_apikey_id", *apiKey.ID)
+
+	if previousID := d.Get("previous_apikey_id").(string); previousID != "" {
+		_, response, err := iamIdentityClient.GetAPIKey(&iamidentityv1.GetAPIKeyOptions{
+			ID: &previousID,
+		})
+		if err != nil {
+			if response != nil && response.StatusCode == 404 {
+				d.Set("previous_apikey_id", "")
+			} else {
+				return fmt.Errorf("[DEBUG] Error retrieving previous Service API Key: %s\n%s", err, response)
+			}
+		}
+	}
 
 	return nil
 }
@@ -243,7 +329,28 @@ func resourceIBMIAMServiceAPIKeyUpdate(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	apiKeyID := d.Id()
+
+	// The diff marks the key IDs as unknown when a rotation or the end of the overlap is due
+	if d.HasChange("current_apikey_id") {
+		if err := rotateServiceAPIKey(d, meta); err != nil {
+			return err
+		}
+	} else if d.HasChange("previous_apikey_id") {
+		previousID, _ := d.GetChange("previous_apikey_id")
+		if err := deleteServiceAPIKey(iamIdentityClient, previousID.(string)); err != nil {
+			return err
+		}
+		d.Set("previous_apikey_id", "")
+	}
+	if d.HasChange("rotation.0.secrets_manager_secret_id") && !d.HasChange("current_apikey_id") {
+		if apikey := d.Get("apikey").(string); apikey != "" {
+			if err := pushServiceAPIKeyToSecretsManager(d, meta, apikey); err != nil {
+				return err
+			}
+		}
+	}
+
+	apiKeyID := serviceAPIKeyID(d)
 
 	getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
 		ID: &apiKeyID,
@@ -288,7 +395,12 @@ func resourceIBMIAMServiceAPIKeyDelete(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
-	apiKeyID := d.Id()
+	if previousID := d.Get("previous_apikey_id").(string); previousID != "" {
+		if err := deleteServiceAPIKey(iamIdentityClient, previousID); err != nil {
+			return err
+		}
+	}
+	apiKeyID := serviceAPIKeyID(d)
 
 	getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
 		ID: &apiKeyID,
@@ -321,7 +433,7 @@ func resourceIBMIAMServiceAPIKeyExists(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return false, err
 	}
-	apiKeyID := d.Id()
+	apiKeyID := serviceAPIKeyID(d)
 
 	getAPIKeyOptions := &iamidentityv1.GetAPIKeyOptions{
 		ID: &apiKeyID,
@@ -364,3 +476,150 @@ func saveToFile(apiKey *iamidentityv1.APIKey, filePath string) error {
 
 	return err
 }
+
+// serviceAPIKeyID returns the ID of the API key in use. Once the key was rotated it differs from the ID of the resource.
+func serviceAPIKeyID(d *schema.ResourceData) string {
+	old, new := d.GetChange("current_apikey_id")
+	if new.(string) != "" {
+		return new.(string)
+	}
+	if old.(string) != "" {
+		return old.(string)
+	}
+	return d.Id()
+}
+
+func resourceIBMIAMServiceAPIKeyRotationDiff(diff *schema.ResourceDiff) error {
+	// A configured API key value can only be set on creation
+	if diff.Id() != "" && diff.HasChange("apikey") {
+		return diff.ForceNew("apikey")
+	}
+
+	rotation, ok := diff.GetOk("rotation")
+	if !ok || len(rotation.([]interface{})) == 0 || rotation.([]interface{})[0] == nil {
+		return nil
+	}
+	rotationMap := rotation.([]interface{})[0].(map[string]interface{})
+	intervalDays := rotationMap["interval_days"].(int)
+	overlapDays := rotationMap["overlap_days"].(int)
+	if overlapDays >= intervalDays {
+		return fmt.Errorf("[ERROR] rotation.overlap_days (%d) must be lower than rotation.interval_days (%d)", overlapDays, intervalDays)
+	}
+	if diff.Id() == "" {
+		return nil
+	}
+
+	rotatedAt := diff.Get("rotated_at").(string)
+	if rotatedAt == "" {
+		rotatedAt = diff.Get("created_at").(string)
+	}
+	lastRotation, err := time.Parse(time.RFC3339, rotatedAt)
+	if err != nil {
+		log.Printf("[WARN] Unable to parse the last rotation date %q of the Service API Key: %s", rotatedAt, err)
+		return nil
+	}
+
+	oldRotation, _ := diff.GetChange("rotation")
+	triggered := len(oldRotation.([]interface{})) > 0 && diff.HasChange("rotation.0.triggers")
+
+	now := time.Now()
+	if triggered || now.After(lastRotation.AddDate(0, 0, intervalDays)) {
+		for _, key := range []string{"apikey", "current_apikey_id", "previous_apikey_id", "rotated_at", "entity_tag", "crn", "created_at", "modified_at"} {
+			if err := diff.SetNewComputed(key); err != nil {
+				return err
+			}
+		}
+	} else if diff.Get("previous_apikey_id").(string) != "" && now.After(lastRotation.AddDate(0, 0, overlapDays)) {
+		return diff.SetNewComputed("previous_apikey_id")
+	}
+	return nil
+}
+
+// rotateServiceAPIKey creates a new API key for the service ID. The replaced key is kept as previous key
+// until an apply after the overlap, a previous key that is still around is deleted.
+func rotateServiceAPIKey(d *schema.ResourceData, meta interface{}) error {
+	iamIdentityClient, err := meta.(conns.ClientSession).IAMIdentityV1API()
+	if err != nil {
+		return err
+	}
+	currentID, _ := d.GetChange("current_apikey_id")
+	if currentID.(string) == "" {
+		currentID = d.Id()
+	}
+	previousID, _ := d.GetChange("previous_apikey_id")
+	if previousID.(string) != "" {
+		if err := deleteServiceAPIKey(iamIdentityClient, previousID.(string)); err != nil {
+			return err
+		}
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return err
+	}
+	createAPIKeyOptions := &iamidentityv1.CreateAPIKeyOptions{
+		Name:      core.StringPtr(d.Get("name").(string)),
+		IamID:     core.StringPtr(d.Get("iam_service_id").(string)),
+		AccountID: &userDetails.UserAccount,
+	}
+	if des, ok := d.GetOk("description"); ok {
+		createAPIKeyOptions.Description = core.StringPtr(des.(string))
+	}
+	if strvalue, ok := d.GetOk("store_value"); ok {
+		createAPIKeyOptions.StoreValue = core.BoolPtr(strvalue.(bool))
+	}
+	if lock, ok := d.GetOk("locked"); ok {
+		createAPIKeyOptions.EntityLock = core.StringPtr(strconv.FormatBool(lock.(bool)))
+	}
+
+	apiKey, response, err := iamIdentityClient.CreateAPIKey(createAPIKeyOptions)
+	if err != nil || apiKey == nil {
+		return fmt.Errorf("[DEBUG] Service API Key rotation Error: %s\n%s", err, response)
+	}
+	d.Set("apikey", *apiKey.Apikey)
+	d.Set("current_apikey_id", *apiKey.ID)
+	d.Set("rotated_at", time.Now().UTC().Format(time.RFC3339))
+
+	d.Set("previous_apikey_id", currentID.(string))
+
+	if keyfile, ok := d.GetOk("file"); ok {
+		if err := saveToFile(apiKey, keyfile.(string)); err != nil {
+			log.Printf("Error writing API Key Details to file: %s", err)
+		}
+	}
+	return pushServiceAPIKeyToSecretsManager(d, meta, *apiKey.Apikey)
+}
+
+func deleteServiceAPIKey(iamIdentityClient *iamidentityv1.IamIdentityV1, apiKeyID string) error {
+	deleteAPIKeyOptions := &iamidentityv1.DeleteAPIKeyOptions{
+		ID: &apiKeyID,
+	}
+	response, err := iamIdentityClient.DeleteAPIKey(deleteAPIKeyOptions)
+	if err != nil && (response == nil || response.StatusCode != 404) {
+		return fmt.Errorf("[DEBUG] Error deleting Service API Key %s: %s\n%s", apiKeyID, err, response)
+	}
+	return nil
+}
+
+// pushServiceAPIKeyToSecretsManager stores the API key as a new version of the configured arbitrary secret
+func pushServiceAPIKeyToSecretsManager(d *schema.ResourceData, meta interface{}, apikey string) error {
+	secretID := d.Get("rotation.0.secrets_manager_secret_id").(string)
+	if secretID == "" {
+		return nil
+	}
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(secretID)
+	createSecretVersionOptions.SetSecretVersionPrototype(&secretsmanagerv2.ArbitrarySecretVersionPrototype{
+		Payload: core.StringPtr(apikey),
+	})
+	_, response, err := secretsManagerClient.CreateSecretVersionWithContext(context.Background(), createSecretVersionOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error storing the Service API Key in secret %s: %s\n%s", secretID, err, response)
+	}
+	return nil
+}
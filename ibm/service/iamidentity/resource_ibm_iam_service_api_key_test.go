@@ -4,11 +4,15 @@
 package iamidentity_test
 
 import (
+	"context"
 	"fmt"
+	"regexp"
 	"testing"
+	"time"
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iamidentity"
 
 	"github.com/IBM/platform-services-go-sdk/iamidentityv1"
 
@@ -82,6 +86,159 @@ func TestAccIBMIAMServiceAPIKey_import(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMServiceAPIKey_Rotation(t *testing.T) {
+	var apiKey string
+	serviceName := fmt.Sprintf("terraform_iam_ser_%d", acctest.RandIntRange(10, 100))
+	name := fmt.Sprintf("terraform_iam_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_service_api_key.testacc_apiKey"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMServiceAPIKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, 7, 7, "1"),
+				ExpectError: regexp.MustCompile("must be lower than rotation.interval_days"),
+			},
+			{
+				Config: testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, 30, 0, "1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMServiceAPIKeyExists(resourceName, apiKey),
+					resource.TestCheckResourceAttrPair(resourceName, "current_apikey_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "previous_apikey_id", ""),
+					resource.TestCheckResourceAttrSet(resourceName, "rotated_at"),
+					resource.TestCheckResourceAttr(resourceName, "rotation.0.interval_days", "30"),
+				),
+			},
+			{
+				// Changing the triggers rotates the key, without overlap the previous key is due right away
+				Config: testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, 30, 0, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMServiceAPIKeyRotated(resourceName),
+					resource.TestCheckResourceAttrPair(resourceName, "previous_apikey_id", resourceName, "id"),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				// The apply after the overlap deletes the previous key
+				Config: testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name, 30, 0, "2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIBMIAMServiceAPIKeyRotated(resourceName),
+					resource.TestCheckResourceAttr(resourceName, "previous_apikey_id", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceIBMIAMServiceAPIKeyRotationDiff(t *testing.T) {
+	now := time.Now().UTC()
+	state := func(rotatedAt time.Time, previousID string) *terraform.InstanceState {
+		return &terraform.InstanceState{
+			ID: "ApiKey-1",
+			Attributes: map[string]string{
+				"id":                       "ApiKey-1",
+				"name":                     "my-key",
+				"iam_service_id":           "iam-ServiceId-1",
+				"apikey":                   "secret",
+				"current_apikey_id":        "ApiKey-1",
+				"previous_apikey_id":       previousID,
+				"rotated_at":               rotatedAt.Format(time.RFC3339),
+				"rotation.#":               "1",
+				"rotation.0.interval_days": "30",
+				"rotation.0.overlap_days":  "7",
+				"rotation.0.triggers.%":    "1",
+				"rotation.0.triggers.run":  "1",
+			},
+		}
+	}
+	config := func(intervalDays, overlapDays int, trigger string) *terraform.ResourceConfig {
+		return terraform.NewResourceConfigRaw(map[string]interface{}{
+			"name":           "my-key",
+			"iam_service_id": "iam-ServiceId-1",
+			"rotation": []interface{}{map[string]interface{}{
+				"interval_days": intervalDays,
+				"overlap_days":  overlapDays,
+				"triggers":      map[string]interface{}{"run": trigger},
+			}},
+		})
+	}
+	diff := func(s *terraform.InstanceState, c *terraform.ResourceConfig) (*terraform.InstanceDiff, error) {
+		return iamidentity.ResourceIBMIAMServiceAPIKey().Diff(context.Background(), s, c, nil)
+	}
+	newComputed := func(d *terraform.InstanceDiff, key string) bool {
+		return d != nil && d.Attributes[key] != nil && d.Attributes[key].NewComputed
+	}
+
+	for _, c := range []struct {
+		name     string
+		state    *terraform.InstanceState
+		config   *terraform.ResourceConfig
+		computed map[string]bool
+	}{
+		{
+			name:     "not due yet",
+			state:    state(now.AddDate(0, 0, -1), ""),
+			config:   config(30, 7, "1"),
+			computed: map[string]bool{"current_apikey_id": false, "previous_apikey_id": false},
+		},
+		{
+			name:     "interval passed",
+			state:    state(now.AddDate(0, 0, -31), "ApiKey-0"),
+			config:   config(30, 7, "1"),
+			computed: map[string]bool{"current_apikey_id": true, "apikey": true},
+		},
+		{
+			name:     "trigger changed before the interval passed",
+			state:    state(now.AddDate(0, 0, -1), ""),
+			config:   config(30, 7, "2"),
+			computed: map[string]bool{"current_apikey_id": true},
+		},
+		{
+			// Only the previous key goes away
+			name:     "overlap passed",
+			state:    state(now.AddDate(0, 0, -8), "ApiKey-0"),
+			config:   config(30, 7, "1"),
+			computed: map[string]bool{"current_apikey_id": false, "previous_apikey_id": true},
+		},
+		{
+			name:     "within the overlap",
+			state:    state(now.AddDate(0, 0, -6), "ApiKey-0"),
+			config:   config(30, 7, "1"),
+			computed: map[string]bool{"previous_apikey_id": false},
+		},
+	} {
+		d, err := diff(c.state, c.config)
+		if err != nil {
+			t.Errorf("%s: Diff returned an error: %s", c.name, err)
+			continue
+		}
+		for attr, want := range c.computed {
+			if got := newComputed(d, attr); got != want {
+				t.Errorf("%s: %s new computed = %t, want %t", c.name, attr, got, want)
+			}
+		}
+	}
+
+	if _, err := diff(state(now, ""), config(7, 7, "1")); err == nil {
+		t.Errorf("expected an error for an overlap that is not shorter than the interval")
+	}
+}
+
+func testAccCheckIBMIAMServiceAPIKeyRotated(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+		if rs.Primary.Attributes["current_apikey_id"] == rs.Primary.ID {
+			return fmt.Errorf("API key %s was not rotated", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIBMIAMServiceAPIKeyDestroy(s *terraform.State) error {
 	rsContClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMIdentityV1API()
 	if err != nil {
@@ -191,3 +348,23 @@ func testAccCheckIBMIAMServiceAPIKeyImport(serviceName, name string) string {
 	  	}
 	`, serviceName, name)
 }
+
+func testAccCheckIBMIAMServiceAPIKeyRotation(serviceName, name string, intervalDays, overlapDays int, trigger string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%s"
+		}
+		resource "ibm_iam_service_api_key" "testacc_apiKey" {
+			name           = "%s"
+			iam_service_id = ibm_iam_service_id.serviceID.iam_id
+			rotation {
+				interval_days = %d
+				overlap_days  = %d
+				triggers = {
+					run = "%s"
+				}
+			}
+		}
+	`, serviceName, name, intervalDays, overlapDays, trigger)
+}
@@ -0,0 +1,428 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iamaccessgroupsv2"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Data source to resolve the access an IAM subject is granted, directly or through its access groups
+func DataSourceIBMIAMEffectiveAccess() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMIAMEffectiveAccessRead,
+
+		Schema: map[string]*schema.Schema{
+			"iam_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "IAM ID of the user, service ID or trusted profile",
+			},
+			"account_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "ID of the account, defaults to the account of the provider",
+			},
+			"service": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Service name of the target",
+			},
+			"resource_group_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "ID of the resource group of the target",
+			},
+			"crn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "CRN of the target resource",
+			},
+			"access_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Access groups the subject is a member of",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"policies": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Policies granting the subject access to the target",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"description": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"source_type": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "How the policy applies to the subject, one of user, service_id, trusted_profile or access_group",
+						},
+						"source_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "IAM ID or access group ID the policy is attached to",
+						},
+						"source_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the access group the policy is attached to",
+						},
+						"resource_attributes": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Description: "Resource attributes of the policy",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"roles": {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "Roles granted by the policy",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"name": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"role_id": {
+										Type:     schema.TypeString,
+										Computed: true,
+									},
+									"actions": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem:     &schema.Schema{Type: schema.TypeString},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"roles": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Role names granted on the target across all policies",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"actions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Actions granted on the target across all policies",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+type effectiveAccessPolicy struct {
+	policy     iampolicymanagementv1.Policy
+	sourceType string
+	sourceID   string
+	sourceName string
+}
+
+func dataSourceIBMIAMEffectiveAccessRead(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	iamAccessGroupsClient, err := meta.(conns.ClientSession).IAMAccessGroupsV2()
+	if err != nil {
+		return err
+	}
+
+	iamID := d.Get("iam_id").(string)
+	accountID := d.Get("account_id").(string)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return err
+		}
+		accountID = userDetails.UserAccount
+	}
+
+	target, err := EffectiveAccessTarget(d)
+	if err != nil {
+		return err
+	}
+
+	// Policies attached to the subject itself
+	listPoliciesOptions := &iampolicymanagementv1.ListPoliciesOptions{
+		AccountID: core.StringPtr(accountID),
+		IamID:     core.StringPtr(iamID),
+		Type:      core.StringPtr("access"),
+	}
+	policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+	if err != nil {
+		return fmt.Errorf("[ERROR] Error listing policies of %s: %s\n%s", iamID, err, resp)
+	}
+	candidates := []effectiveAccessPolicy{}
+	for _, policy := range policyList.Policies {
+		candidates = append(candidates, effectiveAccessPolicy{
+			policy:     policy,
+			sourceType: IAMIDSourceType(iamID),
+			sourceID:   iamID,
+		})
+	}
+
+	// Policies inherited from the access groups the subject is a member of
+	offset := int64(0)
+	limit := int64(100)
+	listAccessGroupsOptions := iamAccessGroupsClient.NewListAccessGroupsOptions(accountID)
+	listAccessGroupsOptions.SetIamID(iamID)
+	listAccessGroupsOptions.SetLimit(limit)
+	groups := []iamaccessgroupsv2.Group{}
+	for {
+		listAccessGroupsOptions.SetOffset(offset)
+		groupsList, resp, err := iamAccessGroupsClient.ListAccessGroups(listAccessGroupsOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing access groups of %s: %s\n%s", iamID, err, resp)
+		}
+		groups = append(groups, groupsList.Groups...)
+		offset = offset + limit
+		if len(groupsList.Groups) == 0 || len(groups) >= flex.IntValue(groupsList.TotalCount) {
+			break
+		}
+	}
+
+	accessGroups := make([]map[string]interface{}, 0, len(groups))
+	for _, group := range groups {
+		accessGroups = append(accessGroups, map[string]interface{}{
+			"id":   *group.ID,
+			"name": *group.Name,
+		})
+		listPoliciesOptions := &iampolicymanagementv1.ListPoliciesOptions{
+			AccountID:     core.StringPtr(accountID),
+			AccessGroupID: group.ID,
+			Type:          core.StringPtr("access"),
+		}
+		policyList, resp, err := iamPolicyManagementClient.ListPolicies(listPoliciesOptions)
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error listing policies of access group %s: %s\n%s", *group.ID, err, resp)
+		}
+		for _, policy := range policyList.Policies {
+			candidates = append(candidates, effectiveAccessPolicy{
+				policy:     policy,
+				sourceType: "access_group",
+				sourceID:   *group.ID,
+				sourceName: *group.Name,
+			})
+		}
+	}
+
+	// Roles and their actions are resolved per service, fetch each service once
+	serviceRoles := map[string][]iampolicymanagementv1.Role{}
+	policies := []map[string]interface{}{}
+	allRoles := map[string]bool{}
+	allActions := map[string]bool{}
+	for _, candidate := range candidates {
+		if len(candidate.policy.Resources) == 0 || !EffectiveAccessPolicyMatches(candidate.policy.Resources[0], target) {
+			continue
+		}
+		resource := candidate.policy.Resources[0]
+
+		serviceName := ""
+		if v := flex.GetResourceAttribute("serviceName", resource); v != nil {
+			serviceName = *v
+		}
+		if serviceName == "" {
+			serviceName = target["serviceName"]
+		}
+		roleList, ok := serviceRoles[serviceName]
+		if !ok {
+			roleList, err = listEffectiveAccessRoles(iamPolicyManagementClient, accountID, serviceName)
+			if err != nil {
+				return err
+			}
+			serviceRoles[serviceName] = roleList
+		}
+
+		roles := make([]map[string]interface{}, 0, len(candidate.policy.Roles))
+		for _, role := range candidate.policy.Roles {
+			roleName := core.StringNilMapper(role.DisplayName)
+			actions := []string{}
+			if roleName != "" {
+				actions = flex.FlattenActionbyDisplayName(roleName, roleList)
+				allRoles[roleName] = true
+			}
+			roles = append(roles, map[string]interface{}{
+				"name":    roleName,
+				"role_id": core.StringNilMapper(role.RoleID),
+				"actions": actions,
+			})
+			for _, action := range actions {
+				allActions[action] = true
+			}
+		}
+
+		attributes := map[string]string{}
+		for _, attr := range resource.Attributes {
+			if attr.Name == nil || attr.Value == nil {
+				continue
+			}
+			attributes[*attr.Name] = *attr.Value
+		}
+		policies = append(policies, map[string]interface{}{
+			"id":                  *candidate.policy.ID,
+			"description":         core.StringNilMapper(candidate.policy.Description),
+			"source_type":         candidate.sourceType,
+			"source_id":           candidate.sourceID,
+			"source_name":         candidate.sourceName,
+			"resource_attributes": attributes,
+			"roles":               roles,
+		})
+	}
+
+	d.SetId(iamID)
+	d.Set("account_id", accountID)
+	if err := d.Set("access_groups", accessGroups); err != nil {
+		return fmt.Errorf("[ERROR] Error setting access_groups: %s", err)
+	}
+	if err := d.Set("policies", policies); err != nil {
+		return fmt.Errorf("[ERROR] Error setting policies: %s", err)
+	}
+	d.Set("roles", sortedKeys(allRoles))
+	d.Set("actions", sortedKeys(allActions))
+
+	return nil
+}
+
+func IAMIDSourceType(iamID string) string {
+	switch {
+	case strings.HasPrefix(iamID, "iam-ServiceId-"):
+		return "service_id"
+	case strings.HasPrefix(iamID, "iam-Profile-"):
+		return "trusted_profile"
+	default:
+		return "user"
+	}
+}
+
+// EffectiveAccessTarget maps the target arguments to the resource attribute names used by policies
+func EffectiveAccessTarget(d *schema.ResourceData) (map[string]string, error) {
+	target := map[string]string{}
+	if v, ok := d.GetOk("crn"); ok {
+		// crn:version:cname:ctype:service-name:location:scope:service-instance:resource-type:resource
+		parts := strings.Split(v.(string), ":")
+		if len(parts) != 10 || parts[0] != "crn" {
+			return nil, fmt.Errorf("[ERROR] Invalid CRN %s", v.(string))
+		}
+		for name, value := range map[string]string{
+			"serviceName":     parts[4],
+			"region":          parts[5],
+			"serviceInstance": parts[7],
+			"resourceType":    parts[8],
+			"resource":        parts[9],
+		} {
+			if value != "" {
+				target[name] = value
+			}
+		}
+	}
+	if v, ok := d.GetOk("service"); ok {
+		target["serviceName"] = v.(string)
+	}
+	if v, ok := d.GetOk("resource_group_id"); ok {
+		target["resourceGroupId"] = v.(string)
+	}
+	return target, nil
+}
+
+// A policy grants access on the target unless one of its attributes contradicts
+// the target. Attributes the target does not name, such as an instance when only
+// a service is given, are kept so that narrower grants are still reported.
+func EffectiveAccessPolicyMatches(resource iampolicymanagementv1.PolicyResource, target map[string]string) bool {
+	for _, attr := range resource.Attributes {
+		if attr.Name == nil {
+			continue
+		}
+		name := *attr.Name
+		if name == "accountId" || name == "serviceType" {
+			continue
+		}
+		targetValue, ok := target[name]
+		if !ok {
+			continue
+		}
+		operator := "stringEquals"
+		if attr.Operator != nil {
+			operator = *attr.Operator
+		}
+		value := core.StringNilMapper(attr.Value)
+		switch operator {
+		case "stringMatch":
+			if matched, err := path.Match(value, targetValue); err != nil || !matched {
+				return false
+			}
+		case "stringExists":
+			continue
+		default:
+			if value != targetValue {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func listEffectiveAccessRoles(iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, accountID, serviceName string) ([]iampolicymanagementv1.Role, error) {
+	if serviceName == "" {
+		serviceName = "alliamserviceroles"
+	}
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   &accountID,
+		ServiceName: &serviceName,
+	}
+	roleList, resp, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing roles of %s: %s\n%s", serviceName, err, resp)
+	}
+	roles := append([]iampolicymanagementv1.Role{}, roleList.SystemRoles...)
+	roles = append(roles, roleList.ServiceRoles...)
+	for _, customRole := range roleList.CustomRoles {
+		roles = append(roles, iampolicymanagementv1.Role{
+			DisplayName: customRole.DisplayName,
+			Description: customRole.Description,
+			Actions:     customRole.Actions,
+			CRN:         customRole.CRN,
+		})
+	}
+	return roles, nil
+}
+
+func sortedKeys(set map[string]bool) []string {
+	keys := make([]string, 0, len(set))
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,171 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/iampolicy"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func TestAccIBMIAMEffectiveAccessDataSource_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	dataSourceName := "data.ibm_iam_effective_access.access"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMEffectiveAccessDataSourceConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "access_groups.#", "1"),
+					resource.TestCheckResourceAttr(dataSourceName, "access_groups.0.name", name),
+					resource.TestCheckResourceAttr(dataSourceName, "policies.#", "2"),
+					resource.TestCheckResourceAttr(dataSourceName, "roles.#", "2"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "actions.0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMEffectiveAccessDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_service_id" "serviceID" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%[1]s"
+		}
+
+		resource "ibm_iam_access_group_members" "members" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			iam_service_ids = [ibm_iam_service_id.serviceID.id]
+		}
+
+		resource "ibm_iam_access_group_policy" "group_policy" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			roles           = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+		}
+
+		resource "ibm_iam_service_policy" "direct_policy" {
+			iam_service_id = ibm_iam_service_id.serviceID.id
+			roles          = ["Reader"]
+			resources {
+				service = "kms"
+			}
+		}
+
+		resource "ibm_iam_service_policy" "other_policy" {
+			iam_service_id = ibm_iam_service_id.serviceID.id
+			roles          = ["Viewer"]
+			resources {
+				service = "cloud-object-storage"
+			}
+		}
+
+		data "ibm_iam_effective_access" "access" {
+			iam_id  = ibm_iam_service_id.serviceID.iam_id
+			service = "kms"
+
+			depends_on = [
+				ibm_iam_access_group_members.members,
+				ibm_iam_access_group_policy.group_policy,
+				ibm_iam_service_policy.direct_policy,
+				ibm_iam_service_policy.other_policy,
+			]
+		}
+	`, name)
+}
+
+func TestIAMIDSourceType(t *testing.T) {
+	for iamID, want := range map[string]string{
+		"iam-ServiceId-1234": "service_id",
+		"iam-Profile-1234":   "trusted_profile",
+		"IBMid-1234":         "user",
+	} {
+		if got := iampolicy.IAMIDSourceType(iamID); got != want {
+			t.Errorf("IAMIDSourceType(%q) = %q, want %q", iamID, got, want)
+		}
+	}
+}
+
+func TestEffectiveAccessTarget(t *testing.T) {
+	resourceSchema := iampolicy.DataSourceIBMIAMEffectiveAccess().Schema
+
+	d := schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"iam_id":            "IBMid-1234",
+		"crn":               "crn:v1:bluemix:public:cloud-object-storage:global:a/1234:instance-1:bucket:my-bucket",
+		"resource_group_id": "rg-1",
+	})
+	target, err := iampolicy.EffectiveAccessTarget(d)
+	if err != nil {
+		t.Fatalf("EffectiveAccessTarget returned an error: %s", err)
+	}
+	want := map[string]string{
+		"serviceName":     "cloud-object-storage",
+		"region":          "global",
+		"serviceInstance": "instance-1",
+		"resourceType":    "bucket",
+		"resource":        "my-bucket",
+		"resourceGroupId": "rg-1",
+	}
+	if !reflect.DeepEqual(target, want) {
+		t.Errorf("EffectiveAccessTarget = %v, want %v", target, want)
+	}
+
+	d = schema.TestResourceDataRaw(t, resourceSchema, map[string]interface{}{
+		"iam_id": "IBMid-1234",
+		"crn":    "crn:v1:bluemix",
+	})
+	if _, err := iampolicy.EffectiveAccessTarget(d); err == nil {
+		t.Errorf("EffectiveAccessTarget accepted an invalid CRN")
+	}
+}
+
+func TestEffectiveAccessPolicyMatches(t *testing.T) {
+	target := map[string]string{"serviceName": "cloud-object-storage", "serviceInstance": "instance-1"}
+	attribute := func(name, value, operator string) iampolicymanagementv1.ResourceAttribute {
+		attr := iampolicymanagementv1.ResourceAttribute{Name: core.StringPtr(name), Value: core.StringPtr(value)}
+		if operator != "" {
+			attr.Operator = core.StringPtr(operator)
+		}
+		return attr
+	}
+	cases := []struct {
+		name       string
+		attributes []iampolicymanagementv1.ResourceAttribute
+		want       bool
+	}{
+		{"account wide", []iampolicymanagementv1.ResourceAttribute{attribute("accountId", "1234", "")}, true},
+		{"same service", []iampolicymanagementv1.ResourceAttribute{attribute("serviceName", "cloud-object-storage", "")}, true},
+		{"other service", []iampolicymanagementv1.ResourceAttribute{attribute("serviceName", "kms", "")}, false},
+		{"wildcard", []iampolicymanagementv1.ResourceAttribute{attribute("serviceInstance", "instance-*", "stringMatch")}, true},
+		{"wildcard mismatch", []iampolicymanagementv1.ResourceAttribute{attribute("serviceInstance", "other-*", "stringMatch")}, false},
+		{"exists", []iampolicymanagementv1.ResourceAttribute{attribute("serviceInstance", "true", "stringExists")}, true},
+		{"narrower", []iampolicymanagementv1.ResourceAttribute{attribute("resource", "my-bucket", "")}, true},
+		{"no value", []iampolicymanagementv1.ResourceAttribute{{Name: core.StringPtr("serviceName")}}, false},
+		{"no name", []iampolicymanagementv1.ResourceAttribute{{Value: core.StringPtr("kms")}}, true},
+	}
+	for _, c := range cases {
+		resource := iampolicymanagementv1.PolicyResource{Attributes: c.attributes}
+		if got := iampolicy.EffectiveAccessPolicyMatches(resource, target); got != c.want {
+			t.Errorf("%s: EffectiveAccessPolicyMatches = %t, want %t", c.name, got, c.want)
+		}
+	}
+}
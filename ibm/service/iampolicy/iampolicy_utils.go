@@ -0,0 +1,528 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// The platform services SDK in use only models the v1 policy API, so v2
+// policies are sent as plain JSON through the v1 client's base service.
+type policyV2 struct {
+	ID          *string           `json:"id,omitempty"`
+	Type        *string           `json:"type"`
+	Description *string           `json:"description,omitempty"`
+	Subject     *policyV2Subject  `json:"subject"`
+	Control     *policyV2Control  `json:"control"`
+	Resource    *policyV2Resource `json:"resource"`
+	Pattern     *string           `json:"pattern,omitempty"`
+	Rule        *policyV2Rule     `json:"rule,omitempty"`
+	Href        *string           `json:"href,omitempty"`
+	State       *string           `json:"state,omitempty"`
+}
+
+type policyV2Subject struct {
+	Attributes []policyV2Attribute `json:"attributes"`
+}
+
+type policyV2Resource struct {
+	Attributes []policyV2Attribute `json:"attributes"`
+	Tags       []policyV2Attribute `json:"tags,omitempty"`
+}
+
+type policyV2Attribute struct {
+	Key      *string     `json:"key"`
+	Operator *string     `json:"operator,omitempty"`
+	Value    interface{} `json:"value"`
+}
+
+type policyV2Control struct {
+	Grant *policyV2Grant `json:"grant"`
+}
+
+type policyV2Grant struct {
+	Roles []policyV2Role `json:"roles"`
+}
+
+type policyV2Role struct {
+	RoleID *string `json:"role_id"`
+}
+
+// A rule is either a single condition or a list of conditions joined by operator
+type policyV2Rule struct {
+	Key        *string             `json:"key,omitempty"`
+	Operator   *string             `json:"operator"`
+	Value      interface{}         `json:"value,omitempty"`
+	Conditions []policyV2Condition `json:"conditions,omitempty"`
+}
+
+type policyV2Condition struct {
+	Key      *string     `json:"key"`
+	Operator *string     `json:"operator"`
+	Value    interface{} `json:"value"`
+}
+
+var policyRuleConditionOperators = []string{
+	"stringEquals", "stringMatch", "stringEqualsAnyOf", "stringMatchAnyOf",
+	"timeLessThan", "timeLessThanOrEquals", "timeGreaterThan", "timeGreaterThanOrEquals",
+	"dateTimeLessThan", "dateTimeLessThanOrEquals", "dateTimeGreaterThan", "dateTimeGreaterThanOrEquals",
+	"dayOfWeekEquals", "dayOfWeekAnyOf",
+}
+
+func policyRuleConditionsSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeSet,
+		Optional:    true,
+		Description: "Rule conditions enforced by the policy. Setting conditions creates the policy through the v2 API.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"key": {
+					Type:        schema.TypeString,
+					Required:    true,
+					Description: "Key of the condition, for example {{environment.attributes.day_of_week}}.",
+				},
+				"operator": {
+					Type:         schema.TypeString,
+					Required:     true,
+					ValidateFunc: validate.ValidateAllowedStringValues(policyRuleConditionOperators),
+					Description:  "Operator of the condition.",
+				},
+				"value": {
+					Type:        schema.TypeList,
+					Required:    true,
+					MinItems:    1,
+					Elem:        &schema.Schema{Type: schema.TypeString},
+					Description: "Value of the condition. Operators ending in AnyOf accept several values.",
+				},
+			},
+		},
+	}
+}
+
+func policyRuleOperatorSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		Computed:     true,
+		ValidateFunc: validate.ValidateAllowedStringValues([]string{"and", "or"}),
+		Description:  "Operator used to join the rule conditions, defaults to and when there is more than one condition.",
+	}
+}
+
+func policyPatternSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		Description: "Pattern rule follows for time-based or attribute-based conditions, for example time-based-conditions:weekly:custom-hours.",
+	}
+}
+
+func expandPolicyV2Rule(d *schema.ResourceData) (*policyV2Rule, error) {
+	conditionsSet, ok := d.GetOk("rule_conditions")
+	if !ok {
+		return nil, nil
+	}
+	conditions := []policyV2Condition{}
+	for _, conditionIntf := range conditionsSet.(*schema.Set).List() {
+		c := conditionIntf.(map[string]interface{})
+		operator := c["operator"].(string)
+		values := flex.ExpandStringList(c["value"].([]interface{}))
+		condition := policyV2Condition{
+			Key:      core.StringPtr(c["key"].(string)),
+			Operator: core.StringPtr(operator),
+		}
+		if strings.HasSuffix(operator, "AnyOf") {
+			condition.Value = values
+		} else if len(values) == 1 {
+			condition.Value = values[0]
+		} else {
+			return nil, fmt.Errorf("[ERROR] Rule condition %s with operator %s takes exactly one value", c["key"], operator)
+		}
+		conditions = append(conditions, condition)
+	}
+
+	ruleOperator := d.Get("rule_operator").(string)
+	if len(conditions) == 1 && ruleOperator == "" {
+		return &policyV2Rule{
+			Key:      conditions[0].Key,
+			Operator: conditions[0].Operator,
+			Value:    conditions[0].Value,
+		}, nil
+	}
+	if ruleOperator == "" {
+		ruleOperator = "and"
+	}
+	return &policyV2Rule{
+		Operator:   core.StringPtr(ruleOperator),
+		Conditions: conditions,
+	}, nil
+}
+
+func flattenPolicyV2RuleValue(value interface{}) []string {
+	switch v := value.(type) {
+	case []interface{}:
+		values := make([]string, len(v))
+		for i, item := range v {
+			values[i] = fmt.Sprint(item)
+		}
+		return values
+	case nil:
+		return []string{}
+	default:
+		return []string{fmt.Sprint(v)}
+	}
+}
+
+func setPolicyV2Rule(d *schema.ResourceData, policy *policyV2) error {
+	conditions := []map[string]interface{}{}
+	ruleOperator := ""
+	if rule := policy.Rule; rule != nil {
+		if rule.Key != nil {
+			conditions = append(conditions, map[string]interface{}{
+				"key":      *rule.Key,
+				"operator": *rule.Operator,
+				"value":    flattenPolicyV2RuleValue(rule.Value),
+			})
+		} else {
+			ruleOperator = *rule.Operator
+			for _, condition := range rule.Conditions {
+				conditions = append(conditions, map[string]interface{}{
+					"key":      *condition.Key,
+					"operator": *condition.Operator,
+					"value":    flattenPolicyV2RuleValue(condition.Value),
+				})
+			}
+		}
+	}
+	if err := d.Set("rule_conditions", conditions); err != nil {
+		return fmt.Errorf("[ERROR] Error setting rule_conditions: %s", err)
+	}
+	d.Set("rule_operator", ruleOperator)
+	d.Set("pattern", policy.Pattern)
+	return nil
+}
+
+func newPolicyV2Attribute(name, value, operator *string) policyV2Attribute {
+	if operator == nil || *operator == "" {
+		operator = core.StringPtr("stringEquals")
+	}
+	var attrValue interface{} = *value
+	// stringExists compares against a boolean rather than a string
+	if *operator == "stringExists" {
+		attrValue = *value == "true"
+	}
+	return policyV2Attribute{Key: name, Operator: operator, Value: attrValue}
+}
+
+func expandPolicyV2(policyType *string, description *string, subjects []iampolicymanagementv1.PolicySubject, roles []iampolicymanagementv1.PolicyRole, resources []iampolicymanagementv1.PolicyResource) *policyV2 {
+	policy := &policyV2{
+		Type:        policyType,
+		Description: description,
+		Subject:     &policyV2Subject{Attributes: []policyV2Attribute{}},
+		Control:     &policyV2Control{Grant: &policyV2Grant{Roles: []policyV2Role{}}},
+		Resource:    &policyV2Resource{Attributes: []policyV2Attribute{}},
+	}
+	for _, subject := range subjects {
+		for _, attr := range subject.Attributes {
+			policy.Subject.Attributes = append(policy.Subject.Attributes, newPolicyV2Attribute(attr.Name, attr.Value, nil))
+		}
+	}
+	for _, role := range roles {
+		policy.Control.Grant.Roles = append(policy.Control.Grant.Roles, policyV2Role{RoleID: role.RoleID})
+	}
+	for _, resource := range resources {
+		for _, attr := range resource.Attributes {
+			policy.Resource.Attributes = append(policy.Resource.Attributes, newPolicyV2Attribute(attr.Name, attr.Value, attr.Operator))
+		}
+		for _, tag := range resource.Tags {
+			policy.Resource.Tags = append(policy.Resource.Tags, newPolicyV2Attribute(tag.Name, tag.Value, tag.Operator))
+		}
+	}
+	return policy
+}
+
+func policyV2AttributeValue(attr policyV2Attribute) *string {
+	return core.StringPtr(fmt.Sprint(attr.Value))
+}
+
+func getPolicyV2ResourceAttribute(name string, policy *policyV2) string {
+	if policy.Resource == nil {
+		return ""
+	}
+	for _, attr := range policy.Resource.Attributes {
+		if *attr.Key == name {
+			return *policyV2AttributeValue(attr)
+		}
+	}
+	return ""
+}
+
+// v2 policies only carry role CRNs, the display names the resources work with
+// are looked up from the roles available for the policy's service
+func getPolicyV2RoleNames(iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, policy *policyV2, meta interface{}) ([]iampolicymanagementv1.PolicyRole, error) {
+	accountID := getPolicyV2ResourceAttribute("accountId", policy)
+	if accountID == "" {
+		userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+		if err != nil {
+			return nil, err
+		}
+		accountID = userDetails.UserAccount
+	}
+	serviceName := getPolicyV2ResourceAttribute("serviceName", policy)
+	if serviceName == "" {
+		serviceName = "alliamserviceroles"
+	}
+
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   &accountID,
+		ServiceName: &serviceName,
+	}
+	roleList, res, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing roles: %s\n%s", err, res)
+	}
+	displayNames := map[string]*string{}
+	for _, role := range flex.MapRoleListToPolicyRoles(*roleList) {
+		displayNames[*role.RoleID] = role.DisplayName
+	}
+
+	roles := []iampolicymanagementv1.PolicyRole{}
+	if policy.Control == nil || policy.Control.Grant == nil {
+		return roles, nil
+	}
+	for _, role := range policy.Control.Grant.Roles {
+		displayName, ok := displayNames[*role.RoleID]
+		if !ok {
+			displayName = role.RoleID
+		}
+		roles = append(roles, iampolicymanagementv1.PolicyRole{RoleID: role.RoleID, DisplayName: displayName})
+	}
+	return roles, nil
+}
+
+func requestPolicyV2(iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, method string, path string, pathParams map[string]string, headers map[string]string, body *policyV2) (*policyV2, *core.DetailedResponse, error) {
+	builder := core.NewRequestBuilder(method)
+	builder.EnableGzipCompression = iamPolicyManagementClient.GetEnableGzipCompression()
+	_, err := builder.ResolveRequestURL(iamPolicyManagementClient.Service.Options.URL, path, pathParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	for headerName, headerValue := range headers {
+		builder.AddHeader(headerName, headerValue)
+	}
+	builder.AddHeader("Accept", "application/json")
+	if body != nil {
+		builder.AddHeader("Content-Type", "application/json")
+		if _, err = builder.SetBodyContentJSON(body); err != nil {
+			return nil, nil, err
+		}
+	}
+
+	request, err := builder.Build()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if method == core.DELETE {
+		response, err := iamPolicyManagementClient.Service.Request(request, nil)
+		return nil, response, err
+	}
+	var result *policyV2
+	response, err := iamPolicyManagementClient.Service.Request(request, &result)
+	return result, response, err
+}
+
+func createPolicyV2(iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, policy *policyV2, headers map[string]string) (*policyV2, *core.DetailedResponse, error) {
+	return requestPolicyV2(iamPolicyManagementClient, core.POST, "/v2/policies", nil, headers, policy)
+}
+
+func getPolicyV2(iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, policyID string, headers map[string]string) (*policyV2, *core.DetailedResponse, error) {
+	return requestPolicyV2(iamPolicyManagementClient, core.GET, "/v2/policies/{id}", map[string]string{"id": policyID}, headers, nil)
+}
+
+func replacePolicyV2(iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, policyID string, etag string, policy *policyV2, headers map[string]string) (*policyV2, *core.DetailedResponse, error) {
+	replaceHeaders := map[string]string{"If-Match": etag}
+	for headerName, headerValue := range headers {
+		replaceHeaders[headerName] = headerValue
+	}
+	return requestPolicyV2(iamPolicyManagementClient, core.PUT, "/v2/policies/{id}", map[string]string{"id": policyID}, replaceHeaders, policy)
+}
+
+func deletePolicyV2(iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1, policyID string, headers map[string]string) (*core.DetailedResponse, error) {
+	_, response, err := requestPolicyV2(iamPolicyManagementClient, core.DELETE, "/v2/policies/{id}", map[string]string{"id": policyID}, headers, nil)
+	return response, err
+}
+
+func policyV2HasConditions(policy *policyV2) bool {
+	return policy.Rule != nil || policy.Pattern != nil
+}
+
+// iamPolicyClient lets the v1 based policy resources keep building v1 options
+// while sending policies that use rule conditions or a pattern through v2.
+type iamPolicyClient struct {
+	*iampolicymanagementv1.IamPolicyManagementV1
+	d    *schema.ResourceData
+	meta interface{}
+
+	// policyV2 is the last policy read through the v2 API
+	policyV2 *policyV2
+}
+
+func newIAMPolicyClient(d *schema.ResourceData, meta interface{}) (*iamPolicyClient, error) {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return nil, err
+	}
+	return &iamPolicyClient{IamPolicyManagementV1: iamPolicyManagementClient, d: d, meta: meta}, nil
+}
+
+func (c *iamPolicyClient) useV2() bool {
+	if c.policyV2 != nil && policyV2HasConditions(c.policyV2) {
+		return true
+	}
+	if _, ok := c.d.GetOk("rule_conditions"); ok {
+		return true
+	}
+	if _, ok := c.d.GetOk("pattern"); ok {
+		return true
+	}
+	// Conditions that are being removed still have to be replaced through v2
+	return c.d.HasChange("rule_conditions") || c.d.HasChange("pattern")
+}
+
+func (c *iamPolicyClient) expandPolicyV2(policyType *string, description *string, subjects []iampolicymanagementv1.PolicySubject, roles []iampolicymanagementv1.PolicyRole, resources []iampolicymanagementv1.PolicyResource) (*policyV2, error) {
+	policy := expandPolicyV2(policyType, description, subjects, roles, resources)
+	rule, err := expandPolicyV2Rule(c.d)
+	if err != nil {
+		return nil, err
+	}
+	policy.Rule = rule
+	if pattern, ok := c.d.GetOk("pattern"); ok {
+		policy.Pattern = core.StringPtr(pattern.(string))
+	}
+	return policy, nil
+}
+
+func (c *iamPolicyClient) flattenPolicyV2(policy *policyV2) (*iampolicymanagementv1.Policy, error) {
+	roles, err := getPolicyV2RoleNames(c.IamPolicyManagementV1, policy, c.meta)
+	if err != nil {
+		return nil, err
+	}
+	subject := iampolicymanagementv1.PolicySubject{Attributes: []iampolicymanagementv1.SubjectAttribute{}}
+	if policy.Subject != nil {
+		for _, attr := range policy.Subject.Attributes {
+			subject.Attributes = append(subject.Attributes, iampolicymanagementv1.SubjectAttribute{
+				Name:  attr.Key,
+				Value: policyV2AttributeValue(attr),
+			})
+		}
+	}
+	resource := iampolicymanagementv1.PolicyResource{Attributes: []iampolicymanagementv1.ResourceAttribute{}}
+	if policy.Resource != nil {
+		for _, attr := range policy.Resource.Attributes {
+			resource.Attributes = append(resource.Attributes, iampolicymanagementv1.ResourceAttribute{
+				Name:     attr.Key,
+				Value:    policyV2AttributeValue(attr),
+				Operator: attr.Operator,
+			})
+		}
+		for _, tag := range policy.Resource.Tags {
+			resource.Tags = append(resource.Tags, iampolicymanagementv1.ResourceTag{
+				Name:     tag.Key,
+				Value:    policyV2AttributeValue(tag),
+				Operator: tag.Operator,
+			})
+		}
+	}
+	return &iampolicymanagementv1.Policy{
+		ID:          policy.ID,
+		Type:        policy.Type,
+		Description: policy.Description,
+		Subjects:    []iampolicymanagementv1.PolicySubject{subject},
+		Roles:       roles,
+		Resources:   []iampolicymanagementv1.PolicyResource{resource},
+		Href:        policy.Href,
+		State:       policy.State,
+	}, nil
+}
+
+func (c *iamPolicyClient) CreatePolicy(createPolicyOptions *iampolicymanagementv1.CreatePolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	if !c.useV2() {
+		return c.IamPolicyManagementV1.CreatePolicy(createPolicyOptions)
+	}
+	body, err := c.expandPolicyV2(createPolicyOptions.Type, createPolicyOptions.Description, createPolicyOptions.Subjects, createPolicyOptions.Roles, createPolicyOptions.Resources)
+	if err != nil {
+		return nil, nil, err
+	}
+	policy, res, err := createPolicyV2(c.IamPolicyManagementV1, body, createPolicyOptions.Headers)
+	if err != nil || policy == nil {
+		return nil, res, err
+	}
+	return &iampolicymanagementv1.Policy{ID: policy.ID}, res, nil
+}
+
+// GetPolicy reads the stored policy through v2, which returns policies with
+// and without rule conditions, so imported and refreshed policies keep their
+// rule conditions even when the configuration does not know about them yet
+func (c *iamPolicyClient) GetPolicy(getPolicyOptions *iampolicymanagementv1.GetPolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	policy, res, err := getPolicyV2(c.IamPolicyManagementV1, *getPolicyOptions.PolicyID, getPolicyOptions.Headers)
+	if res != nil && res.StatusCode == 404 {
+		return nil, res, err
+	}
+	if err != nil || policy == nil {
+		log.Printf("[WARN] Error reading policy %s through the v2 API, reading it through v1: %s", *getPolicyOptions.PolicyID, err)
+		return c.IamPolicyManagementV1.GetPolicy(getPolicyOptions)
+	}
+	c.policyV2 = policy
+	v1Policy, err := c.flattenPolicyV2(policy)
+	return v1Policy, res, err
+}
+
+func (c *iamPolicyClient) UpdatePolicy(updatePolicyOptions *iampolicymanagementv1.UpdatePolicyOptions) (*iampolicymanagementv1.Policy, *core.DetailedResponse, error) {
+	if !c.useV2() {
+		return c.IamPolicyManagementV1.UpdatePolicy(updatePolicyOptions)
+	}
+	body, err := c.expandPolicyV2(updatePolicyOptions.Type, updatePolicyOptions.Description, updatePolicyOptions.Subjects, updatePolicyOptions.Roles, updatePolicyOptions.Resources)
+	if err != nil {
+		return nil, nil, err
+	}
+	policy, res, err := replacePolicyV2(c.IamPolicyManagementV1, *updatePolicyOptions.PolicyID, *updatePolicyOptions.IfMatch, body, updatePolicyOptions.Headers)
+	if err != nil || policy == nil {
+		return nil, res, err
+	}
+	return &iampolicymanagementv1.Policy{ID: policy.ID}, res, nil
+}
+
+func (c *iamPolicyClient) DeletePolicy(deletePolicyOptions *iampolicymanagementv1.DeletePolicyOptions) (*core.DetailedResponse, error) {
+	// Delete runs on a client that has not read the policy yet, the stored
+	// policy decides the API version when the configuration does not
+	if c.policyV2 == nil && !c.useV2() {
+		policy, _, err := getPolicyV2(c.IamPolicyManagementV1, *deletePolicyOptions.PolicyID, deletePolicyOptions.Headers)
+		if err == nil && policy != nil {
+			c.policyV2 = policy
+		}
+	}
+	if !c.useV2() {
+		return c.IamPolicyManagementV1.DeletePolicy(deletePolicyOptions)
+	}
+	return deletePolicyV2(c.IamPolicyManagementV1, *deletePolicyOptions.PolicyID, deletePolicyOptions.Headers)
+}
+
+// setRule records the rule of the last policy read through v2, policies read
+// through v1 have no rule to record
+func (c *iamPolicyClient) setRule() error {
+	if c.policyV2 == nil {
+		return nil
+	}
+	return setPolicyV2Rule(c.d, c.policyV2)
+}
@@ -181,6 +181,12 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 				Description: "Description of the Policy",
 			},
 
+			"rule_conditions": policyRuleConditionsSchema(),
+
+			"rule_operator": policyRuleOperatorSchema(),
+
+			"pattern": policyPatternSchema(),
+
 			"transaction_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -197,7 +203,7 @@ func ResourceIBMIAMAccessGroupPolicy() *schema.Resource {
 }
 
 func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -290,7 +296,7 @@ func resourceIBMIAMAccessGroupPolicyCreate(d *schema.ResourceData, meta interfac
 
 func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{}) error {
 
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -372,16 +378,20 @@ func resourceIBMIAMAccessGroupPolicyRead(d *schema.ResourceData, meta interface{
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
 
+	if err := iamPolicyManagementClient.setRule(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
 			return err
@@ -447,7 +457,7 @@ func resourceIBMIAMAccessGroupPolicyUpdate(d *schema.ResourceData, meta interfac
 }
 
 func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -478,7 +488,7 @@ func resourceIBMIAMAccessGroupPolicyDelete(d *schema.ResourceData, meta interfac
 }
 
 func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return false, err
 	}
@@ -514,7 +524,7 @@ func resourceIBMIAMAccessGroupPolicyExists(d *schema.ResourceData, meta interfac
 }
 func importAccessGroupPolicy(d *schema.ResourceData, meta interface{}) (interface{}, interface{}, error) {
 
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return nil, nil, err
 	}
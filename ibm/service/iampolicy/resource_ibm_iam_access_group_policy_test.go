@@ -371,6 +371,36 @@ func TestAccIBMIAMAccessGroupPolicy_With_Transaction_Id(t *testing.T) {
 	})
 }
 
+func TestAccIBMIAMAccessGroupPolicy_With_Rule_Conditions(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMAccessGroupPolicyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMAccessGroupPolicyRuleConditions(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group.accgrp", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "pattern", "time-based-conditions:weekly:custom-hours"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "rule_operator", "and"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "rule_conditions.#", "3"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "roles.#", "1"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMAccessGroupPolicyRuleConditionsUpdate(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_iam_access_group.accgrp", "name", name),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "rule_conditions.#", "3"),
+					resource.TestCheckResourceAttr("ibm_iam_access_group_policy.policy", "roles.#", "2"),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMIAMAccessGroupPolicyDestroy(s *terraform.State) error {
 	iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
 	if err != nil {
@@ -828,3 +858,68 @@ func testAccCheckIBMIAMAccessGroupPolicyTransactionIdUpdate(name string) string
 	  	}
 	`, name)
 }
+
+func testAccCheckIBMIAMAccessGroupPolicyRuleConditions(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policy" "policy" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			roles           = ["Viewer"]
+			resources {
+				service = "kms"
+			}
+			pattern = "time-based-conditions:weekly:custom-hours"
+			rule_conditions {
+				key      = "{{environment.attributes.day_of_week}}"
+				operator = "dayOfWeekAnyOf"
+				value    = ["1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeGreaterThanOrEquals"
+				value    = ["09:00:00+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeLessThanOrEquals"
+				value    = ["17:00:00+00:00"]
+			}
+		}
+	`, name)
+}
+
+func testAccCheckIBMIAMAccessGroupPolicyRuleConditionsUpdate(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_access_group_policy" "policy" {
+			access_group_id = ibm_iam_access_group.accgrp.id
+			roles           = ["Viewer", "Manager"]
+			resources {
+				service = "kms"
+			}
+			pattern       = "time-based-conditions:weekly:custom-hours"
+			rule_operator = "and"
+			rule_conditions {
+				key      = "{{environment.attributes.day_of_week}}"
+				operator = "dayOfWeekAnyOf"
+				value    = ["1+00:00", "2+00:00", "3+00:00", "4+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeGreaterThanOrEquals"
+				value    = ["08:00:00+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeLessThanOrEquals"
+				value    = ["18:00:00+00:00"]
+			}
+		}
+	`, name)
+}
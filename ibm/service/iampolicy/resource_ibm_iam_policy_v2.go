@@ -0,0 +1,391 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy
+
+import (
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/platform-services-go-sdk/iampolicymanagementv1"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMIAMPolicyV2() *schema.Resource {
+	return &schema.Resource{
+		Create:   resourceIBMIAMPolicyV2Create,
+		Read:     resourceIBMIAMPolicyV2Read,
+		Update:   resourceIBMIAMPolicyV2Update,
+		Delete:   resourceIBMIAMPolicyV2Delete,
+		Importer: &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "access",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"access", "authorization"}),
+				Description:  "Type of the policy.",
+			},
+
+			"subject_attributes": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Attributes identifying the subject of the policy, such as iam_id or access_group_id.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of attribute.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of attribute.",
+						},
+					},
+				},
+			},
+
+			"roles": {
+				Type:        schema.TypeList,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Role names of the policy definition",
+			},
+
+			"resource_attributes": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Description: "Attributes identifying the resources the policy applies to.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of attribute.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of attribute.",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "stringEquals",
+							Description: "Operator of attribute, for example stringEquals, stringMatch or stringExists.",
+						},
+					},
+				},
+			},
+
+			"resource_tags": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Set access management tags.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Name of attribute.",
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "Value of attribute.",
+						},
+						"operator": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Default:     "stringEquals",
+							Description: "Operator of attribute.",
+						},
+					},
+				},
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Description of the Policy",
+			},
+
+			"rule_conditions": policyRuleConditionsSchema(),
+
+			"rule_operator": policyRuleOperatorSchema(),
+
+			"pattern": policyPatternSchema(),
+
+			"transaction_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Set transactionID for debug",
+			},
+
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "State of the policy.",
+			},
+
+			"href": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The href link back to the policy.",
+			},
+
+			"version": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+		},
+	}
+}
+
+func expandIBMIAMPolicyV2(d *schema.ResourceData, meta interface{}, iamPolicyManagementClient *iampolicymanagementv1.IamPolicyManagementV1) (*policyV2, error) {
+	policy := &policyV2{
+		Type:     core.StringPtr(d.Get("type").(string)),
+		Subject:  &policyV2Subject{Attributes: []policyV2Attribute{}},
+		Control:  &policyV2Control{Grant: &policyV2Grant{Roles: []policyV2Role{}}},
+		Resource: &policyV2Resource{Attributes: []policyV2Attribute{}},
+	}
+	if desc, ok := d.GetOk("description"); ok {
+		policy.Description = core.StringPtr(desc.(string))
+	}
+
+	for _, attribute := range d.Get("subject_attributes").(*schema.Set).List() {
+		a := attribute.(map[string]interface{})
+		policy.Subject.Attributes = append(policy.Subject.Attributes,
+			newPolicyV2Attribute(core.StringPtr(a["name"].(string)), core.StringPtr(a["value"].(string)), nil))
+	}
+
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return nil, err
+	}
+	accountID := userDetails.UserAccount
+	serviceName := ""
+	for _, attribute := range d.Get("resource_attributes").(*schema.Set).List() {
+		a := attribute.(map[string]interface{})
+		name := a["name"].(string)
+		value := a["value"].(string)
+		switch name {
+		case "accountId":
+			accountID = value
+		case "serviceName":
+			serviceName = value
+		}
+		policy.Resource.Attributes = append(policy.Resource.Attributes,
+			newPolicyV2Attribute(core.StringPtr(name), core.StringPtr(value), core.StringPtr(a["operator"].(string))))
+	}
+	// Access policies are always scoped to an account, default to the one of the provider
+	if getPolicyV2ResourceAttribute("accountId", policy) == "" {
+		policy.Resource.Attributes = append(policy.Resource.Attributes,
+			newPolicyV2Attribute(core.StringPtr("accountId"), core.StringPtr(accountID), nil))
+	}
+	if r, ok := d.GetOk("resource_tags"); ok {
+		for _, attribute := range r.(*schema.Set).List() {
+			a := attribute.(map[string]interface{})
+			policy.Resource.Tags = append(policy.Resource.Tags,
+				newPolicyV2Attribute(core.StringPtr(a["name"].(string)), core.StringPtr(a["value"].(string)), core.StringPtr(a["operator"].(string))))
+		}
+	}
+
+	if serviceName == "" {
+		serviceName = "alliamserviceroles"
+	}
+	listRoleOptions := &iampolicymanagementv1.ListRolesOptions{
+		AccountID:   &accountID,
+		ServiceName: &serviceName,
+	}
+	roleList, res, err := iamPolicyManagementClient.ListRoles(listRoleOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing roles: %s\n%s", err, res)
+	}
+	policyRoles, err := flex.GetRolesFromRoleNames(flex.ExpandStringList(d.Get("roles").([]interface{})), flex.MapRoleListToPolicyRoles(*roleList))
+	if err != nil {
+		return nil, err
+	}
+	for _, role := range policyRoles {
+		policy.Control.Grant.Roles = append(policy.Control.Grant.Roles, policyV2Role{RoleID: role.RoleID})
+	}
+
+	policy.Rule, err = expandPolicyV2Rule(d)
+	if err != nil {
+		return nil, err
+	}
+	if pattern, ok := d.GetOk("pattern"); ok {
+		policy.Pattern = core.StringPtr(pattern.(string))
+	}
+
+	return policy, nil
+}
+
+func policyV2Headers(d *schema.ResourceData) map[string]string {
+	if transactionID, ok := d.GetOk("transaction_id"); ok {
+		return map[string]string{"Transaction-Id": transactionID.(string)}
+	}
+	return nil
+}
+
+func resourceIBMIAMPolicyV2Create(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	policy, err := expandIBMIAMPolicyV2(d, meta, iamPolicyManagementClient)
+	if err != nil {
+		return err
+	}
+
+	createdPolicy, res, err := createPolicyV2(iamPolicyManagementClient, policy, policyV2Headers(d))
+	if err != nil || createdPolicy == nil {
+		return fmt.Errorf("[ERROR] Error creating policy: %s\n%s", err, res)
+	}
+
+	d.SetId(*createdPolicy.ID)
+
+	return resourceIBMIAMPolicyV2Read(d, meta)
+}
+
+func resourceIBMIAMPolicyV2Read(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	policy, res, err := getPolicyV2(iamPolicyManagementClient, d.Id(), policyV2Headers(d))
+	if err != nil || policy == nil {
+		if res != nil && res.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error retrieving policy: %s\n%s", err, res)
+	}
+	if policy.State != nil && *policy.State == "deleted" {
+		d.SetId("")
+		return nil
+	}
+
+	roles, err := getPolicyV2RoleNames(iamPolicyManagementClient, policy, meta)
+	if err != nil {
+		return err
+	}
+	roleNames := make([]string, len(roles))
+	for i, role := range roles {
+		roleNames[i] = *role.DisplayName
+	}
+	d.Set("roles", roleNames)
+
+	d.Set("type", policy.Type)
+	d.Set("description", policy.Description)
+	d.Set("state", policy.State)
+	d.Set("href", policy.Href)
+	d.Set("version", res.Headers.Get("ETag"))
+
+	subjectAttributes := []map[string]interface{}{}
+	if policy.Subject != nil {
+		for _, attr := range policy.Subject.Attributes {
+			subjectAttributes = append(subjectAttributes, map[string]interface{}{
+				"name":  *attr.Key,
+				"value": *policyV2AttributeValue(attr),
+			})
+		}
+	}
+	d.Set("subject_attributes", subjectAttributes)
+
+	// The accountId added on create is only tracked when it was configured
+	_, accountIDConfigured := configuredIBMIAMPolicyV2Attributes(d.Get("resource_attributes").(*schema.Set).List())["accountId"]
+	resourceAttributes := []map[string]interface{}{}
+	resourceTags := []map[string]interface{}{}
+	if policy.Resource != nil {
+		for _, attr := range policy.Resource.Attributes {
+			if *attr.Key == "accountId" && !accountIDConfigured {
+				continue
+			}
+			resourceAttributes = append(resourceAttributes, map[string]interface{}{
+				"name":     *attr.Key,
+				"value":    *policyV2AttributeValue(attr),
+				"operator": core.StringNilMapper(attr.Operator),
+			})
+		}
+		for _, tag := range policy.Resource.Tags {
+			resourceTags = append(resourceTags, map[string]interface{}{
+				"name":     *tag.Key,
+				"value":    *policyV2AttributeValue(tag),
+				"operator": core.StringNilMapper(tag.Operator),
+			})
+		}
+	}
+	d.Set("resource_attributes", resourceAttributes)
+	d.Set("resource_tags", resourceTags)
+
+	if err := setPolicyV2Rule(d, policy); err != nil {
+		return err
+	}
+
+	if len(res.Headers["Transaction-Id"]) > 0 && res.Headers["Transaction-Id"][0] != "" {
+		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
+	}
+
+	return nil
+}
+
+func configuredIBMIAMPolicyV2Attributes(attributes []interface{}) map[string]string {
+	attributeMap := map[string]string{}
+	for _, attribute := range attributes {
+		a := attribute.(map[string]interface{})
+		attributeMap[a["name"].(string)] = a["value"].(string)
+	}
+	return attributeMap
+}
+
+func resourceIBMIAMPolicyV2Update(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	if d.HasChange("subject_attributes") || d.HasChange("roles") || d.HasChange("resource_attributes") || d.HasChange("resource_tags") || d.HasChange("description") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
+		policy, err := expandIBMIAMPolicyV2(d, meta, iamPolicyManagementClient)
+		if err != nil {
+			return err
+		}
+
+		_, res, err := replacePolicyV2(iamPolicyManagementClient, d.Id(), d.Get("version").(string), policy, policyV2Headers(d))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error updating policy: %s\n%s", err, res)
+		}
+	}
+
+	return resourceIBMIAMPolicyV2Read(d, meta)
+}
+
+func resourceIBMIAMPolicyV2Delete(d *schema.ResourceData, meta interface{}) error {
+	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+
+	res, err := deletePolicyV2(iamPolicyManagementClient, d.Id(), policyV2Headers(d))
+	if err != nil {
+		if res != nil && res.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("[ERROR] Error deleting policy: %s\n%s", err, res)
+	}
+
+	d.SetId("")
+
+	return nil
+}
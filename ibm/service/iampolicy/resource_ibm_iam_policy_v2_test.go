@@ -0,0 +1,162 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package iampolicy_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+)
+
+func TestAccIBMIAMPolicyV2_Basic(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_policy_v2.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMPolicyV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyV2Basic(name, "Viewer", "17:00:00+00:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "access"),
+					resource.TestCheckResourceAttr(resourceName, "roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttr(resourceName, "pattern", "time-based-conditions:weekly:custom-hours"),
+					resource.TestCheckResourceAttr(resourceName, "rule_operator", "and"),
+					resource.TestCheckResourceAttr(resourceName, "rule_conditions.#", "3"),
+					resource.TestCheckResourceAttrSet(resourceName, "version"),
+				),
+			},
+			{
+				Config: testAccCheckIBMIAMPolicyV2Basic(name, "Editor", "18:00:00+00:00"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "roles.0", "Editor"),
+					resource.TestCheckResourceAttr(resourceName, "rule_conditions.#", "3"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"transaction_id"},
+			},
+		},
+	})
+}
+
+func TestAccIBMIAMPolicyV2_StringMatch(t *testing.T) {
+	name := fmt.Sprintf("terraform_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_iam_policy_v2.policy"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMIAMPolicyV2Destroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMIAMPolicyV2StringMatch(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "resource_attributes.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rule_conditions.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "description", "Policy on prefixed secrets"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMIAMPolicyV2Destroy(s *terraform.State) error {
+	iamPolicyManagementClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).IAMPolicyManagementV1API()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_iam_policy_v2" {
+			continue
+		}
+
+		getPolicyOptions := iamPolicyManagementClient.NewGetPolicyOptions(
+			rs.Primary.ID,
+		)
+
+		destroyedPolicy, response, err := iamPolicyManagementClient.GetPolicy(getPolicyOptions)
+		if err == nil && destroyedPolicy.State != nil && *destroyedPolicy.State != "deleted" {
+			return fmt.Errorf("Policy still exists: %s\n", rs.Primary.ID)
+		} else if err != nil && response != nil && response.StatusCode != 404 {
+			return fmt.Errorf("[ERROR] Error waiting for policy (%s) to be destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMIAMPolicyV2Basic(name, role, endTime string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_policy_v2" "policy" {
+			roles = ["%s"]
+			subject_attributes {
+				name  = "access_group_id"
+				value = ibm_iam_access_group.accgrp.id
+			}
+			resource_attributes {
+				name  = "serviceName"
+				value = "kms"
+			}
+			pattern = "time-based-conditions:weekly:custom-hours"
+			rule_conditions {
+				key      = "{{environment.attributes.day_of_week}}"
+				operator = "dayOfWeekAnyOf"
+				value    = ["1+00:00", "2+00:00", "3+00:00", "4+00:00", "5+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeGreaterThanOrEquals"
+				value    = ["09:00:00+00:00"]
+			}
+			rule_conditions {
+				key      = "{{environment.attributes.current_time}}"
+				operator = "timeLessThanOrEquals"
+				value    = ["%s"]
+			}
+		}
+	`, name, role, endTime)
+}
+
+func testAccCheckIBMIAMPolicyV2StringMatch(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_iam_access_group" "accgrp" {
+			name = "%s"
+		}
+
+		resource "ibm_iam_policy_v2" "policy" {
+			roles       = ["Viewer"]
+			description = "Policy on prefixed secrets"
+			subject_attributes {
+				name  = "access_group_id"
+				value = ibm_iam_access_group.accgrp.id
+			}
+			resource_attributes {
+				name  = "serviceName"
+				value = "secrets-manager"
+			}
+			resource_attributes {
+				name     = "resource"
+				value    = "prod-*"
+				operator = "stringMatch"
+			}
+		}
+	`, name)
+}
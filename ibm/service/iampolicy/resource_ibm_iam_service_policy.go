@@ -190,6 +190,12 @@ func ResourceIBMIAMServicePolicy() *schema.Resource {
 				Description: "Description of the Policy",
 			},
 
+			"rule_conditions": policyRuleConditionsSchema(),
+
+			"rule_operator": policyRuleOperatorSchema(),
+
+			"pattern": policyPatternSchema(),
+
 			"transaction_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -253,7 +259,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 		Tags:       flex.SetTags(d),
 	}
 
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -326,7 +332,7 @@ func resourceIBMIAMServicePolicyCreate(d *schema.ResourceData, meta interface{})
 
 func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) error {
 
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -405,12 +411,16 @@ func resourceIBMIAMServicePolicyRead(d *schema.ResourceData, meta interface{}) e
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
 
+	if err := iamPolicyManagementClient.setRule(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
@@ -473,7 +483,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 			Attributes: []iampolicymanagementv1.SubjectAttribute{*subjectAttribute},
 		}
 
-		iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+		iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 		if err != nil {
 			return err
 		}
@@ -524,7 +534,7 @@ func resourceIBMIAMServicePolicyUpdate(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -554,7 +564,7 @@ func resourceIBMIAMServicePolicyDelete(d *schema.ResourceData, meta interface{})
 }
 
 func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return false, err
 	}
@@ -591,7 +601,7 @@ func resourceIBMIAMServicePolicyExists(d *schema.ResourceData, meta interface{})
 
 func importServicePolicy(d *schema.ResourceData, meta interface{}) (interface{}, interface{}, error) {
 
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -190,6 +190,12 @@ func ResourceIBMIAMTrustedProfilePolicy() *schema.Resource {
 				Description: "Description of the Policy",
 			},
 
+			"rule_conditions": policyRuleConditionsSchema(),
+
+			"rule_operator": policyRuleOperatorSchema(),
+
+			"pattern": policyPatternSchema(),
+
 			"transaction_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -253,7 +259,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 		Tags:       flex.SetTags(d),
 	}
 
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -326,7 +332,7 @@ func resourceIBMIAMTrustedProfilePolicyCreate(d *schema.ResourceData, meta inter
 
 func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interface{}) error {
 
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -403,12 +409,16 @@ func resourceIBMIAMTrustedProfilePolicyRead(d *schema.ResourceData, meta interfa
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
 
+	if err := iamPolicyManagementClient.setRule(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta interface{}) error {
 
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
@@ -466,7 +476,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 			Attributes: []iampolicymanagementv1.SubjectAttribute{*subjectAttribute},
 		}
 
-		iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+		iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 		if err != nil {
 			return err
 		}
@@ -518,7 +528,7 @@ func resourceIBMIAMTrustedProfilePolicyUpdate(d *schema.ResourceData, meta inter
 }
 
 func resourceIBMIAMTrustedProfilePolicyDelete(d *schema.ResourceData, meta interface{}) error {
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -548,7 +558,7 @@ func resourceIBMIAMTrustedProfilePolicyDelete(d *schema.ResourceData, meta inter
 }
 
 func resourceIBMIAMTrustedProfilePolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return false, err
 	}
@@ -585,7 +595,7 @@ func resourceIBMIAMTrustedProfilePolicyExists(d *schema.ResourceData, meta inter
 
 func importTrustedProfilePolicy(d *schema.ResourceData, meta interface{}) (interface{}, interface{}, error) {
 
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -180,6 +180,12 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 				Description: "Description of the Policy",
 			},
 
+			"rule_conditions": policyRuleConditionsSchema(),
+
+			"rule_operator": policyRuleOperatorSchema(),
+
+			"pattern": policyPatternSchema(),
+
 			"transaction_id": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -191,7 +197,7 @@ func ResourceIBMIAMUserPolicy() *schema.Resource {
 }
 
 func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) error {
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -292,7 +298,7 @@ func resourceIBMIAMUserPolicyCreate(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) error {
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -367,15 +373,19 @@ func resourceIBMIAMUserPolicyRead(d *schema.ResourceData, meta interface{}) erro
 		d.Set("transaction_id", res.Headers["Transaction-Id"][0])
 	}
 
+	if err := iamPolicyManagementClient.setRule(); err != nil {
+		return err
+	}
+
 	return nil
 }
 
 func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) error {
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
-	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") {
+	if d.HasChange("roles") || d.HasChange("resources") || d.HasChange("resource_attributes") || d.HasChange("account_management") || d.HasChange("description") || d.HasChange("resource_tags") || d.HasChange("rule_conditions") || d.HasChange("rule_operator") || d.HasChange("pattern") {
 		parts, err := flex.IdParts(d.Id())
 		if err != nil {
 			return err
@@ -464,7 +474,7 @@ func resourceIBMIAMUserPolicyUpdate(d *schema.ResourceData, meta interface{}) er
 
 func resourceIBMIAMUserPolicyDelete(d *schema.ResourceData, meta interface{}) error {
 
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return err
 	}
@@ -492,7 +502,7 @@ func resourceIBMIAMUserPolicyDelete(d *schema.ResourceData, meta interface{}) er
 }
 
 func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (bool, error) {
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return false, err
 	}
@@ -530,7 +540,7 @@ func resourceIBMIAMUserPolicyExists(d *schema.ResourceData, meta interface{}) (b
 
 func importUserPolicy(d *schema.ResourceData, meta interface{}) (interface{}, interface{}, error) {
 
-	iamPolicyManagementClient, err := meta.(conns.ClientSession).IAMPolicyManagementV1API()
+	iamPolicyManagementClient, err := newIAMPolicyClient(d, meta)
 	if err != nil {
 		return nil, nil, err
 	}
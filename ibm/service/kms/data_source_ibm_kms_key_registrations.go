@@ -0,0 +1,141 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func DataSourceIBMKMSKeyRegistrations() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMKMSKeyRegistrationsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID or alias of the key",
+			},
+			"crn_filter": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the registrations of resources matching the CRN. The CRN can contain * wildcards",
+			},
+			"resource_crns": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The CRNs of the resources registered against the key",
+			},
+			"registrations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The registrations of the key",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"resource_crn": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The CRN of the resource protected by the key",
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The description of the registration",
+						},
+						"prevent_key_deletion": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "If set to true, the key can not be deleted while the registration exists",
+						},
+						"key_version_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key version used by the resource",
+						},
+						"created_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource that created the registration",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the registration was created. The date format follows RFC 3339.",
+						},
+						"updated_by": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier of the resource that updated the registration",
+						},
+						"last_update_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the registration was updated. The date format follows RFC 3339.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMKMSKeyRegistrationsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, _, err := getKmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keyID := d.Get("key_id").(string)
+
+	registrations, err := kpAPI.ListRegistrations(context, keyID, d.Get("crn_filter").(string))
+	if err != nil {
+		return diag.Errorf("[ERROR] Error while listing the registrations of key %s: %s", keyID, err)
+	}
+
+	crns := make([]string, 0, len(registrations.Registrations))
+	registrationList := make([]map[string]interface{}, 0, len(registrations.Registrations))
+	for _, registration := range registrations.Registrations {
+		crns = append(crns, registration.ResourceCrn)
+		r := map[string]interface{}{
+			"resource_crn":         registration.ResourceCrn,
+			"description":          registration.Description,
+			"prevent_key_deletion": registration.PreventKeyDeletion,
+			"key_version_id":       registration.KeyVersion.ID,
+			"created_by":           registration.CreatedBy,
+			"updated_by":           registration.UpdatedBy,
+		}
+		if registration.CreationDate != nil {
+			r["creation_date"] = registration.CreationDate.Format(time.RFC3339)
+		}
+		if registration.LastUpdateDate != nil {
+			r["last_update_date"] = registration.LastUpdateDate.Format(time.RFC3339)
+		}
+		registrationList = append(registrationList, r)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", kpAPI.Config.InstanceID, keyID))
+	d.Set("instance_id", kpAPI.Config.InstanceID)
+	d.Set("resource_crns", crns)
+	d.Set("registrations", registrationList)
+	return nil
+}
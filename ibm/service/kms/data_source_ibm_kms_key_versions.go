@@ -0,0 +1,112 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+const kmsKeyVersionsPageLimit = 200
+
+func DataSourceIBMKMSKeyVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMKMSKeyVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				Default:      "public",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID or alias of the key",
+			},
+			"versions": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The versions of the key, the current version first",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the key version",
+						},
+						"creation_date": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date the key version was created. The date format follows RFC 3339.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+type kmsKeyVersions struct {
+	Metadata struct {
+		CollectionTotal int `json:"collectionTotal"`
+		TotalCount      int `json:"totalCount"`
+	} `json:"metadata"`
+	Resources []kp.KeyVersion `json:"resources"`
+}
+
+func dataSourceIBMKMSKeyVersionsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, _, err := getKmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keyID := d.Get("key_id").(string)
+
+	versions := make([]map[string]interface{}, 0)
+	for offset := 0; ; offset += kmsKeyVersionsPageLimit {
+		query := url.Values{}
+		query.Set("limit", strconv.Itoa(kmsKeyVersionsPageLimit))
+		query.Set("offset", strconv.Itoa(offset))
+		query.Set("totalCount", "true")
+
+		response := kmsKeyVersions{}
+		err = kmsRequest(context, kpAPI, meta, "GET", fmt.Sprintf("keys/%s/versions?%s", url.PathEscape(keyID), query.Encode()), nil, &response)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error while listing versions of key %s: %s", keyID, err)
+		}
+		for _, version := range response.Resources {
+			keyVersion := map[string]interface{}{
+				"id": version.ID,
+			}
+			if version.CreationDate != nil {
+				keyVersion["creation_date"] = version.CreationDate.Format(time.RFC3339)
+			}
+			versions = append(versions, keyVersion)
+		}
+		if len(response.Resources) < kmsKeyVersionsPageLimit || len(versions) >= response.Metadata.TotalCount {
+			break
+		}
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", kpAPI.Config.InstanceID, keyID))
+	d.Set("instance_id", kpAPI.Config.InstanceID)
+	d.Set("versions", versions)
+	return nil
+}
@@ -0,0 +1,218 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	kp "github.com/IBM/keyprotect-go-client"
+	rc "github.com/IBM/platform-services-go-sdk/resourcecontrollerv2"
+)
+
+const (
+	kmipAdapterCollectionType     = "application/vnd.ibm.kms.kmip_adapter+json"
+	kmipCertificateCollectionType = "application/vnd.ibm.kms.kmip_client_certificate+json"
+)
+
+// getKmsInstanceClient returns a key management client pointing to the key protect or hpcs
+// instance, together with the CRN of the instance.
+func getKmsInstanceClient(meta interface{}, instanceID, endpointType string) (*kp.Client, string, error) {
+	kpAPI, err := meta.(conns.ClientSession).KeyManagementAPI()
+	if err != nil {
+		return nil, "", err
+	}
+	CrnInstanceID := strings.Split(instanceID, ":")
+	if len(CrnInstanceID) > 3 {
+		instanceID = CrnInstanceID[len(CrnInstanceID)-3]
+	}
+
+	rsConClient, err := meta.(conns.ClientSession).ResourceControllerV2API()
+	if err != nil {
+		return nil, "", err
+	}
+	resourceInstanceGet := rc.GetResourceInstanceOptions{
+		ID: &instanceID,
+	}
+	instanceData, resp, err := rsConClient.GetResourceInstance(&resourceInstanceGet)
+	if err != nil || instanceData == nil {
+		return nil, "", fmt.Errorf("[ERROR] Error retrieving resource instance: %s with resp code: %s", err, resp)
+	}
+	URL, err := KmsEndpointURL(kpAPI, endpointType, instanceData.Extensions)
+	if err != nil {
+		return nil, "", err
+	}
+	kpAPI.URL = URL
+	kpAPI.Config.InstanceID = instanceID
+
+	return kpAPI, *instanceData.CRN, nil
+}
+
+func kmsEndpointTypeFromClient(kpAPI *kp.Client) string {
+	if strings.Contains((kpAPI.URL).String(), "private") || strings.Contains(kpAPI.Config.BaseURL, "private") {
+		return "private"
+	}
+	return "public"
+}
+
+type kmipMetadata struct {
+	CollectionType  string `json:"collectionType"`
+	CollectionTotal int    `json:"collectionTotal"`
+}
+
+type kmipAdapter struct {
+	ID          string            `json:"id,omitempty"`
+	Name        string            `json:"name,omitempty"`
+	Description string            `json:"description,omitempty"`
+	Profile     string            `json:"profile,omitempty"`
+	ProfileData map[string]string `json:"profile_data,omitempty"`
+	CreatedBy   string            `json:"created_by,omitempty"`
+	CreatedAt   string            `json:"created_at,omitempty"`
+	UpdatedBy   string            `json:"updated_by,omitempty"`
+	UpdatedAt   string            `json:"updated_at,omitempty"`
+}
+
+type kmipAdapters struct {
+	Metadata  *kmipMetadata `json:"metadata,omitempty"`
+	Resources []kmipAdapter `json:"resources"`
+}
+
+type kmipClientCertificate struct {
+	ID          string `json:"id,omitempty"`
+	Name        string `json:"name,omitempty"`
+	Certificate string `json:"certificate,omitempty"`
+	CreatedBy   string `json:"created_by,omitempty"`
+	CreatedAt   string `json:"created_at,omitempty"`
+}
+
+type kmipClientCertificates struct {
+	Metadata  *kmipMetadata           `json:"metadata,omitempty"`
+	Resources []kmipClientCertificate `json:"resources"`
+}
+
+// kmipRequest calls the KMIP API of the instance the client points to.
+func kmipRequest(ctx context.Context, kpAPI *kp.Client, meta interface{}, method, path string, body, result interface{}) error {
+	return kmsRequest(ctx, kpAPI, meta, method, "../v4/"+path, body, result)
+}
+
+// kmsRequest calls an API of the instance the client points to that the keyprotect-go-client does
+// not support. The path is relative to the keys endpoint of the client, like the paths of the client
+// itself. The request is sent with the http client and the instance of the key management client,
+// failures are returned as *kp.Error like the client does. When the access token has expired it is
+// refreshed and the request is sent once more.
+func kmsRequest(ctx context.Context, kpAPI *kp.Client, meta interface{}, method, path string, body, result interface{}) error {
+	u, err := kpAPI.URL.Parse(path)
+	if err != nil {
+		return err
+	}
+	var reqBody []byte
+	if body != nil {
+		reqBody, err = json.Marshal(body)
+		if err != nil {
+			return err
+		}
+	}
+
+	bluemixSession, err := meta.(conns.ClientSession).BluemixSession()
+	if err != nil {
+		return err
+	}
+	send := func() (*http.Response, []byte, error) {
+		req, err := http.NewRequestWithContext(ctx, method, u.String(), bytes.NewReader(reqBody))
+		if err != nil {
+			return nil, nil, err
+		}
+		req.Header.Set("accept", "application/json")
+		if body != nil {
+			req.Header.Set("content-type", "application/json")
+		}
+		req.Header.Set("authorization", bluemixSession.Config.IAMAccessToken)
+		req.Header.Set("bluemix-instance", kpAPI.Config.InstanceID)
+
+		response, err := kpAPI.HttpClient.Do(req)
+		if err != nil {
+			return nil, nil, err
+		}
+		defer response.Body.Close()
+		resBody, err := ioutil.ReadAll(response.Body)
+		return response, resBody, err
+	}
+
+	response, resBody, err := send()
+	if err != nil {
+		return err
+	}
+	if response.StatusCode == http.StatusUnauthorized && bluemixSession.Config.IAMRefreshToken != "" {
+		if err := conns.RefreshToken(bluemixSession); err != nil {
+			return fmt.Errorf("[ERROR] Error Refreshing Authentication Token: %s", err)
+		}
+		response, resBody, err = send()
+		if err != nil {
+			return err
+		}
+	}
+
+	if response.StatusCode >= 300 {
+		kpError := &kp.Error{
+			URL:           u.String(),
+			StatusCode:    response.StatusCode,
+			Message:       http.StatusText(response.StatusCode),
+			BodyContent:   resBody,
+			CorrelationID: response.Header.Get("correlation-id"),
+		}
+		errResponse := struct {
+			Resources []struct {
+				ErrorMsg string `json:"errorMsg"`
+			} `json:"resources"`
+		}{}
+		if json.Unmarshal(resBody, &errResponse) == nil && len(errResponse.Resources) > 0 && errResponse.Resources[0].ErrorMsg != "" {
+			kpError.Message = errResponse.Resources[0].ErrorMsg
+		}
+		return kpError
+	}
+	if result != nil && len(resBody) != 0 {
+		return json.Unmarshal(resBody, result)
+	}
+	return nil
+}
+
+func isKmsNotFound(err error) bool {
+	if kpError, ok := err.(*kp.Error); ok {
+		return kpError.StatusCode == 404
+	}
+	return false
+}
+
+// kmsKeyStates maps the states of a key returned by the API to the names used by the provider.
+var kmsKeyStates = map[int]string{
+	0: "pre_activation",
+	1: "active",
+	2: "disabled",
+	3: "deactivated",
+	5: "destroyed",
+}
+
+// setKmsKeyState enables or disables a key when it is not in the requested state already. Only active
+// and disabled keys can change their state.
+func setKmsKeyState(ctx context.Context, kpAPI *kp.Client, keyID, state string) error {
+	key, err := kpAPI.GetKey(ctx, keyID)
+	if err != nil {
+		return err
+	}
+	switch {
+	case state == "disabled" && key.State == 1:
+		return kpAPI.DisableKey(ctx, keyID)
+	case state == "active" && key.State == 2:
+		return kpAPI.EnableKey(ctx, keyID)
+	case key.State != 1 && key.State != 2:
+		return fmt.Errorf("the key is %s and can not be set to %s", kmsKeyStates[key.State], state)
+	}
+	return nil
+}
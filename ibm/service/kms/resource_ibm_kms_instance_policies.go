@@ -0,0 +1,359 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	kp "github.com/IBM/keyprotect-go-client"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+var kmsInstancePolicyTypes = []string{"dual_auth_delete", "allowed_network", "allowed_ip", "key_create_import_access", "metrics"}
+
+func ResourceIBMKmsInstancePolicies() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsInstancePoliciesCreate,
+		ReadContext:   resourceIBMKmsInstancePoliciesRead,
+		UpdateContext: resourceIBMKmsInstancePoliciesUpdate,
+		DeleteContext: resourceIBMKmsInstancePoliciesDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"dual_auth_delete": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the dual authorization delete policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, keys of the instance require an authorization from two users to be deleted.",
+						},
+					},
+				},
+			},
+			"allowed_network": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the allowed network policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, the network restriction of the instance is enforced.",
+						},
+						"network": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      "public-and-private",
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{"public-and-private", "private-only"}),
+							Description:  "The type of the network the instance can be accessed from.",
+						},
+					},
+				},
+			},
+			"allowed_ip": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the allowed IP policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, only the allowed IP addresses can access the instance.",
+						},
+						"ip_addresses": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Set:         schema.HashString,
+							Description: "The IP addresses and subnets in CIDR notation that can access the instance.",
+						},
+					},
+				},
+			},
+			"key_create_import_access": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the key create and import access policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, the key create and import access restrictions are enforced.",
+						},
+						"create_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, root keys can be created in the instance.",
+						},
+						"create_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, standard keys can be created in the instance.",
+						},
+						"import_root_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, root keys can be imported in the instance.",
+						},
+						"import_standard_key": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     true,
+							Description: "If set to true, standard keys can be imported in the instance.",
+						},
+						"enforce_token": {
+							Type:        schema.TypeBool,
+							Optional:    true,
+							Default:     false,
+							Description: "If set to true, keys can only be imported with an import token.",
+						},
+					},
+				},
+			},
+			"metrics": {
+				Type:         schema.TypeList,
+				Optional:     true,
+				Computed:     true,
+				MaxItems:     1,
+				AtLeastOneOf: kmsInstancePolicyTypes,
+				Description:  "Data associated with the metrics policy of the instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"enabled": {
+							Type:        schema.TypeBool,
+							Required:    true,
+							Description: "If set to true, operational metrics of the instance are sent to the monitoring service.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMKmsInstancePoliciesCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, instanceCRN, err := getKmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = resourceHandleInstancePolicies(context, d, kpAPI, false)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error while creating instance policies: %s", err)
+	}
+	d.SetId(instanceCRN)
+	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	crnData := strings.Split(d.Id(), ":")
+	if len(crnData) < 3 {
+		return diag.Errorf("[ERROR] Incorrect ID %s: Id should be the CRN of the instance", d.Id())
+	}
+	instanceID := crnData[len(crnData)-3]
+
+	kpAPI, _, err := getKmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	policies, err := kpAPI.GetInstancePolicies(context)
+	if err != nil {
+		if isKmsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error while reading instance policies: %s", err)
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", kmsEndpointTypeFromClient(kpAPI))
+	for _, policy := range policies {
+		enabled := policy.PolicyData.Enabled != nil && *policy.PolicyData.Enabled
+		attributes := policy.PolicyData.Attributes
+		if attributes == nil {
+			attributes = &kp.Attributes{}
+		}
+		switch policy.PolicyType {
+		case kp.DualAuthDelete:
+			d.Set("dual_auth_delete", []interface{}{map[string]interface{}{"enabled": enabled}})
+		case kp.Metrics:
+			d.Set("metrics", []interface{}{map[string]interface{}{"enabled": enabled}})
+		case kp.AllowedNetwork:
+			network := map[string]interface{}{"enabled": enabled}
+			if attributes.AllowedNetwork != nil {
+				network["network"] = *attributes.AllowedNetwork
+			}
+			d.Set("allowed_network", []interface{}{network})
+		case kp.AllowedIP:
+			d.Set("allowed_ip", []interface{}{map[string]interface{}{
+				"enabled":      enabled,
+				"ip_addresses": flex.NewStringSet(schema.HashString, attributes.AllowedIP),
+			}})
+		case kp.KeyCreateImportAccess:
+			d.Set("key_create_import_access", []interface{}{map[string]interface{}{
+				"enabled":             enabled,
+				"create_root_key":     attributes.CreateRootKey != nil && *attributes.CreateRootKey,
+				"create_standard_key": attributes.CreateStandardKey != nil && *attributes.CreateStandardKey,
+				"import_root_key":     attributes.ImportRootKey != nil && *attributes.ImportRootKey,
+				"import_standard_key": attributes.ImportStandardKey != nil && *attributes.ImportStandardKey,
+				"enforce_token":       attributes.EnforceToken != nil && *attributes.EnforceToken,
+			}})
+		}
+	}
+
+	return nil
+}
+
+func resourceIBMKmsInstancePoliciesUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if d.HasChanges(kmsInstancePolicyTypes...) {
+		kpAPI, _, err := getKmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+
+		err = resourceHandleInstancePolicies(context, d, kpAPI, true)
+		if err != nil {
+			return diag.Errorf("[ERROR] Error while updating instance policies: %s", err)
+		}
+	}
+	return resourceIBMKmsInstancePoliciesRead(context, d, meta)
+}
+
+func resourceIBMKmsInstancePoliciesDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, _, err := getKmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The instance keeps its policies, destroying the resource disables the ones that are managed
+	policies := kp.MultiplePolicies{}
+	policyData := func(key string) map[string]interface{} {
+		if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			return v.([]interface{})[0].(map[string]interface{})
+		}
+		return nil
+	}
+	if data := policyData("dual_auth_delete"); data != nil && data["enabled"].(bool) {
+		policies.DualAuthDelete = &kp.BasicPolicyData{Enabled: false}
+	}
+	if data := policyData("metrics"); data != nil && data["enabled"].(bool) {
+		policies.Metrics = &kp.BasicPolicyData{Enabled: false}
+	}
+	if data := policyData("allowed_network"); data != nil && data["enabled"].(bool) {
+		policies.AllowedNetwork = &kp.AllowedNetworkPolicyData{
+			Enabled: false,
+			Network: data["network"].(string),
+		}
+	}
+	if data := policyData("allowed_ip"); data != nil && data["enabled"].(bool) {
+		policies.AllowedIP = &kp.AllowedIPPolicyData{Enabled: false}
+	}
+	if data := policyData("key_create_import_access"); data != nil && data["enabled"].(bool) {
+		policies.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{Enabled: false}
+	}
+
+	if policies != (kp.MultiplePolicies{}) {
+		err = kpAPI.SetInstancePolicies(context, policies)
+		if err != nil && !isKmsNotFound(err) {
+			return diag.Errorf("[ERROR] Error while disabling instance policies: %s", err)
+		}
+	}
+	d.SetId("")
+	return nil
+}
+
+// resourceHandleInstancePolicies sets the configured policies of the instance. On update only the
+// policies that changed are sent.
+func resourceHandleInstancePolicies(context context.Context, d *schema.ResourceData, kpAPI *kp.Client, onlyChanged bool) error {
+	policies := kp.MultiplePolicies{}
+	policyData := func(key string) map[string]interface{} {
+		if onlyChanged && !d.HasChange(key) {
+			return nil
+		}
+		if v, ok := d.GetOk(key); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+			return v.([]interface{})[0].(map[string]interface{})
+		}
+		return nil
+	}
+
+	if data := policyData("dual_auth_delete"); data != nil {
+		policies.DualAuthDelete = &kp.BasicPolicyData{
+			Enabled: data["enabled"].(bool),
+		}
+	}
+	if data := policyData("metrics"); data != nil {
+		policies.Metrics = &kp.BasicPolicyData{
+			Enabled: data["enabled"].(bool),
+		}
+	}
+	if data := policyData("allowed_network"); data != nil {
+		policies.AllowedNetwork = &kp.AllowedNetworkPolicyData{
+			Enabled: data["enabled"].(bool),
+			Network: data["network"].(string),
+		}
+	}
+	if data := policyData("allowed_ip"); data != nil {
+		policies.AllowedIP = &kp.AllowedIPPolicyData{
+			Enabled:     data["enabled"].(bool),
+			IPAddresses: flex.ExpandStringList(data["ip_addresses"].(*schema.Set).List()),
+		}
+	}
+	if data := policyData("key_create_import_access"); data != nil {
+		policies.KeyCreateImportAccess = &kp.KeyCreateImportAccessInstancePolicy{
+			Enabled:           data["enabled"].(bool),
+			CreateRootKey:     data["create_root_key"].(bool),
+			CreateStandardKey: data["create_standard_key"].(bool),
+			ImportRootKey:     data["import_root_key"].(bool),
+			ImportStandardKey: data["import_standard_key"].(bool),
+			EnforceToken:      data["enforce_token"].(bool),
+		}
+	}
+
+	if policies == (kp.MultiplePolicies{}) {
+		return nil
+	}
+	return kpAPI.SetInstancePolicies(context, policies)
+}
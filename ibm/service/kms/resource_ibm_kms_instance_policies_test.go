@@ -0,0 +1,109 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSInstancePolicies_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_kms_instance_policies.policies"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dual_auth_delete.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_network.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_network.0.network", "public-and-private"),
+					resource.TestCheckResourceAttr(resourceName, "key_create_import_access.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "key_create_import_access.0.import_standard_key", "false"),
+					resource.TestCheckResourceAttr(resourceName, "metrics.0.enabled", "false"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesConfig(instanceName, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "dual_auth_delete.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "metrics.0.enabled", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMKMSInstancePolicies_allowedIP(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_kms_instance_policies.policies"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsInstancePoliciesAllowedIPConfig(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_ip.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_ip.0.ip_addresses.#", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsInstancePoliciesConfig(instanceName string, dualAuthDelete, metrics bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_instance_policies" "policies" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		dual_auth_delete {
+			enabled = %t
+		}
+		allowed_network {
+			enabled = true
+			network = "public-and-private"
+		}
+		key_create_import_access {
+			enabled             = true
+			import_standard_key = false
+		}
+		metrics {
+			enabled = %t
+		}
+	}
+`, instanceName, dualAuthDelete, metrics)
+}
+
+func testAccCheckIBMKmsInstancePoliciesAllowedIPConfig(instanceName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_instance_policies" "policies" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		allowed_ip {
+			enabled      = true
+			ip_addresses = ["10.0.0.0/8", "192.0.2.0/24"]
+		}
+	}
+`, instanceName)
+}
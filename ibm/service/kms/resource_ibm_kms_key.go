@@ -124,6 +124,13 @@ func ResourceIBMKmskey() *schema.Resource {
 				Description: "The date the key material expires. The date format follows RFC 3339. You can set an expiration date on any key on its creation. A key moves into the Deactivated state within one hour past its expiration date, if one is assigned. If you create a key without specifying an expiration date, the key does not expire",
 				ForceNew:    true,
 			},
+			"state": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"active", "disabled"}),
+				Description:  "The state of the key. Set to disabled to suspend the key and to active to enable it again.",
+			},
 			"instance_crn": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -257,6 +264,13 @@ func resourceIBMKmsKeyCreate(d *schema.ResourceData, meta interface{}) error {
 			d.SetId(keyCRN)
 		}
 	}
+	if state, ok := d.GetOk("state"); ok {
+		crnData := strings.Split(keyCRN, ":")
+		err = setKmsKeyState(context.Background(), kpAPI, crnData[len(crnData)-1], state.(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while setting the state of the key to %s: %s", state.(string), err)
+		}
+	}
 	return resourceIBMKmsKeyUpdate(d, meta)
 }
 
@@ -331,6 +345,10 @@ func resourceIBMKmsKeyRead(d *schema.ResourceData, meta interface{}) error {
 	} else {
 		d.Set("expiration_date", "")
 	}
+	// Keys in any other state can not be enabled or disabled, resource_status still reports them
+	if key.State == 1 || key.State == 2 {
+		d.Set("state", kmsKeyStates[key.State])
+	}
 	d.Set(flex.ResourceName, key.Name)
 	d.Set(flex.ResourceCRN, key.CRN)
 	state := key.State
@@ -353,6 +371,18 @@ func resourceIBMKmsKeyUpdate(d *schema.ResourceData, meta interface{}) error {
 	if d.HasChange("force_delete") {
 		d.Set("force_delete", d.Get("force_delete").(bool))
 	}
+	if state, ok := d.GetOk("state"); ok && d.HasChange("state") && !d.IsNewResource() {
+		kpAPI, _, err := getKmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+		if err != nil {
+			return err
+		}
+		crnData := strings.Split(d.Id(), ":")
+		keyid := crnData[len(crnData)-1]
+		err = setKmsKeyState(context.Background(), kpAPI, keyid, state.(string))
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while setting the state of the key to %s: %s", state.(string), err)
+		}
+	}
 	return resourceIBMKmsKeyRead(d, meta)
 
 }
@@ -392,6 +422,19 @@ func resourceIBMKmsKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	f := kp.ForceOpt{
 		Force: force,
 	}
+	if !force {
+		registrations, err := kpAPI.ListRegistrations(context.Background(), keyid, "")
+		if err != nil {
+			return fmt.Errorf("[ERROR] Error while listing the registrations of the key: %s", err)
+		}
+		if len(registrations.Registrations) > 0 {
+			crns := make([]string, 0, len(registrations.Registrations))
+			for _, registration := range registrations.Registrations {
+				crns = append(crns, registration.ResourceCrn)
+			}
+			return fmt.Errorf("[ERROR] The key %s still protects the following resources: %s. Remove the registrations or set force_delete to true to delete the key", keyid, strings.Join(crns, ", "))
+		}
+	}
 
 	_, err1 := kpAPI.DeleteKey(context.Background(), keyid, kp.ReturnRepresentation, f)
 	if err1 != nil {
@@ -0,0 +1,155 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsKeyAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKeyActionCreate,
+		ReadContext:   resourceIBMKmsKeyActionRead,
+		DeleteContext: resourceIBMKmsKeyActionDelete,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID or alias of the key",
+			},
+			"action": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"rotate", "enable", "disable", "restore", "set_key_for_deletion", "unset_key_for_deletion"}),
+				Description:  "The action to run on the key",
+			},
+			"payload": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Sensitive:   true,
+				Description: "The new key material of an imported root key, used by the rotate action",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Arbitrary map of values that, when changed, runs the action again",
+			},
+			"state": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The state of the key",
+			},
+			"key_version": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the current version of the key",
+			},
+			"last_rotate_date": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the key was last rotated. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKeyActionCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, _, err := getKmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	keyID := d.Get("key_id").(string)
+	action := d.Get("action").(string)
+
+	switch action {
+	case "rotate":
+		err = kpAPI.Rotate(context, keyID, d.Get("payload").(string))
+	case "enable":
+		err = kpAPI.EnableKey(context, keyID)
+	case "disable":
+		err = kpAPI.DisableKey(context, keyID)
+	case "restore":
+		_, err = kpAPI.RestoreKey(context, keyID)
+	case "set_key_for_deletion":
+		err = kpAPI.InitiateDualAuthDelete(context, keyID)
+	case "unset_key_for_deletion":
+		err = kpAPI.CancelDualAuthDelete(context, keyID)
+	}
+	if err != nil {
+		return diag.Errorf("[ERROR] Error while running the %s action on key %s: %s", action, keyID, err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", kpAPI.Config.InstanceID, keyID, action))
+	return resourceIBMKmsKeyActionRead(context, d, meta)
+}
+
+func resourceIBMKmsKeyActionRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 3 {
+		return diag.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of instanceID/keyID/action", d.Id())
+	}
+	instanceID, keyID := parts[0], parts[1]
+
+	kpAPI, _, err := getKmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	key, err := kpAPI.GetKey(context, keyID)
+	if err != nil {
+		if isKmsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Get Key failed with error while reading key action: %s", err)
+	}
+
+	d.Set("state", kmsKeyStates[key.State])
+	if key.KeyVersion != nil {
+		d.Set("key_version", key.KeyVersion.ID)
+	}
+	if key.LastRotateDate != nil {
+		d.Set("last_rotate_date", key.LastRotateDate.Format(time.RFC3339))
+	}
+	return nil
+}
+
+func resourceIBMKmsKeyActionDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	//Actions can not be reverted
+	log.Println("Warning:  `terraform destroy` does not revert the action on the key but only clears the state file.")
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKeyAction_rotate(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_kms_key_action.action"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, "rotate", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_rotate_date"),
+					resource.TestCheckResourceAttrSet(resourceName, "key_version"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, "rotate", "2"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "triggers.rotation", "2"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccIBMKMSKeyAction_disable(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_kms_key_action.action"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, "disable", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "disabled"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, "enable", "1"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKeyActionConfig(instanceName, keyName, action, trigger string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "key" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+		lifecycle {
+			ignore_changes = [state]
+		}
+	}
+	resource "ibm_kms_key_action" "action" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		key_id      = ibm_kms_key.key.key_id
+		action      = "%s"
+		triggers = {
+			rotation = "%s"
+		}
+	}
+`, instanceName, keyName, action, trigger)
+}
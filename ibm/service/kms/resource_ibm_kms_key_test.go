@@ -318,3 +318,51 @@ func testAccCheckIBMKmsKeyPolicyDualAuth(instanceName, KeyName string, dual_auth
 	  }
 `, instanceName, KeyName, dual_auth_delete)
 }
+
+func TestAccIBMKMSResource_State(t *testing.T) {
+	instanceName := fmt.Sprintf("kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsResourceStateConfig(instanceName, keyName, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "state", "active"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsResourceStateConfig(instanceName, keyName, "disabled"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "state", "disabled"),
+				),
+			},
+			{
+				Config: testAccCheckIBMKmsResourceStateConfig(instanceName, keyName, "active"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("ibm_kms_key.test", "state", "active"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsResourceStateConfig(instanceName, keyName, state string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "test" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+		state        = "%s"
+	}
+`, instanceName, keyName, state)
+}
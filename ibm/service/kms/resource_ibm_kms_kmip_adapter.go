@@ -0,0 +1,216 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsKMIPAdapter() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKMIPAdapterCreate,
+		ReadContext:   resourceIBMKmsKMIPAdapterRead,
+		DeleteContext: resourceIBMKmsKMIPAdapterDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.InvokeValidator("ibm_kms_kmip_adapter", "name"),
+				Description:  "The name of the KMIP adapter. A name is generated when it is not set.",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The description of the KMIP adapter.",
+			},
+			"profile": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "native_1.0",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"native_1.0"}),
+				Description:  "The profile of the KMIP adapter.",
+			},
+			"profile_data": {
+				Type:        schema.TypeMap,
+				Required:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The data of the profile. The native_1.0 profile requires the crk_id of the root key that protects the keys of the adapter.",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the KMIP adapter.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the user that created the KMIP adapter.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the KMIP adapter was created. The date format follows RFC 3339.",
+			},
+			"updated_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the user that updated the KMIP adapter.",
+			},
+			"updated_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the KMIP adapter was updated. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func ResourceIBMKmsKMIPAdapterValidator() *validate.ResourceValidator {
+
+	validateSchema := make([]validate.ValidateSchema, 0)
+
+	validateSchema = append(validateSchema,
+		validate.ValidateSchema{
+			Identifier:                 "name",
+			ValidateFunctionIdentifier: validate.ValidateRegexpLen,
+			Type:                       validate.TypeString,
+			Optional:                   true,
+			Regexp:                     `^[a-z][a-z0-9-]*$`,
+			MinValueLength:             2,
+			MaxValueLength:             40})
+
+	ibmKMIPAdapterResourceValidator := validate.ResourceValidator{ResourceName: "ibm_kms_kmip_adapter", Schema: validateSchema}
+	return &ibmKMIPAdapterResourceValidator
+}
+
+func resourceIBMKmsKMIPAdapterCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, _, err := getKmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	profileData := make(map[string]string)
+	for k, v := range d.Get("profile_data").(map[string]interface{}) {
+		profileData[k] = v.(string)
+	}
+	adapter := kmipAdapter{
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Profile:     d.Get("profile").(string),
+		ProfileData: profileData,
+	}
+	request := kmipAdapters{
+		Metadata: &kmipMetadata{
+			CollectionType:  kmipAdapterCollectionType,
+			CollectionTotal: 1,
+		},
+		Resources: []kmipAdapter{adapter},
+	}
+	response := kmipAdapters{}
+	err = kmipRequest(context, kpAPI, meta, "POST", "kmip_adapters", request, &response)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error while creating KMIP adapter: %s", err)
+	}
+	if len(response.Resources) == 0 {
+		return diag.Errorf("[ERROR] Error while creating KMIP adapter: empty response")
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", kpAPI.Config.InstanceID, response.Resources[0].ID))
+	return resourceIBMKmsKMIPAdapterRead(context, d, meta)
+}
+
+func resourceIBMKmsKMIPAdapterRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 2 {
+		return diag.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of instanceID/adapterID", d.Id())
+	}
+	instanceID, adapterID := parts[0], parts[1]
+
+	kpAPI, _, err := getKmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response := kmipAdapters{}
+	err = kmipRequest(context, kpAPI, meta, "GET", "kmip_adapters/"+adapterID, nil, &response)
+	if err != nil {
+		if isKmsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error while reading KMIP adapter %s: %s", adapterID, err)
+	}
+	if len(response.Resources) == 0 {
+		d.SetId("")
+		return nil
+	}
+	adapter := response.Resources[0]
+
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", kmsEndpointTypeFromClient(kpAPI))
+	d.Set("adapter_id", adapter.ID)
+	d.Set("name", adapter.Name)
+	d.Set("description", adapter.Description)
+	d.Set("profile", adapter.Profile)
+	d.Set("profile_data", adapter.ProfileData)
+	d.Set("created_by", adapter.CreatedBy)
+	d.Set("created_at", adapter.CreatedAt)
+	d.Set("updated_by", adapter.UpdatedBy)
+	d.Set("updated_at", adapter.UpdatedAt)
+	return nil
+}
+
+func resourceIBMKmsKMIPAdapterDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 2 {
+		return diag.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of instanceID/adapterID", d.Id())
+	}
+	instanceID, adapterID := parts[0], parts[1]
+
+	kpAPI, _, err := getKmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = kmipRequest(context, kpAPI, meta, "DELETE", "kmip_adapters/"+adapterID, nil, nil)
+	if err != nil && !isKmsNotFound(err) {
+		return diag.Errorf("[ERROR] Error while deleting KMIP adapter %s: %s", adapterID, err)
+	}
+	d.SetId("")
+	return nil
+}
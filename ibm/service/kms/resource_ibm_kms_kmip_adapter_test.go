@@ -0,0 +1,69 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccIBMKMSKMIPAdapter_basic(t *testing.T) {
+	instanceName := fmt.Sprintf("tf_kms_%d", acctest.RandIntRange(10, 100))
+	keyName := fmt.Sprintf("key_%d", acctest.RandIntRange(10, 100))
+	adapterName := fmt.Sprintf("tf-adapter-%d", acctest.RandIntRange(10, 100))
+	resourceName := "ibm_kms_kmip_adapter.adapter"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMKmsKMIPAdapterConfig(instanceName, keyName, adapterName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", adapterName),
+					resource.TestCheckResourceAttr(resourceName, "profile", "native_1.0"),
+					resource.TestCheckResourceAttrPair(resourceName, "profile_data.crk_id", "ibm_kms_key.key", "key_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "adapter_id"),
+					resource.TestCheckResourceAttrSet(resourceName, "created_at"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIBMKmsKMIPAdapterConfig(instanceName, keyName, adapterName string) string {
+	return fmt.Sprintf(`
+	resource "ibm_resource_instance" "kms_instance" {
+		name              = "%s"
+		service           = "kms"
+		plan              = "tiered-pricing"
+		location          = "us-south"
+	}
+	resource "ibm_kms_key" "key" {
+		instance_id  = ibm_resource_instance.kms_instance.guid
+		key_name     = "%s"
+		standard_key = false
+		force_delete = true
+	}
+	resource "ibm_kms_kmip_adapter" "adapter" {
+		instance_id = ibm_resource_instance.kms_instance.guid
+		name        = "%s"
+		description = "adapter for vSAN encryption"
+		profile     = "native_1.0"
+		profile_data = {
+			crk_id = ibm_kms_key.key.key_id
+		}
+	}
+`, instanceName, keyName, adapterName)
+}
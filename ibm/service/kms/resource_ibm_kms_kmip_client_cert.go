@@ -0,0 +1,175 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package kms
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func ResourceIBMKmsKMIPClientCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMKmsKMIPClientCertificateCreate,
+		ReadContext:   resourceIBMKmsKMIPClientCertificateRead,
+		DeleteContext: resourceIBMKmsKMIPClientCertificateDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:             schema.TypeString,
+				Required:         true,
+				ForceNew:         true,
+				Description:      "Key protect or hpcs instance GUID",
+				DiffSuppressFunc: suppressKMSInstanceIDDiff,
+			},
+			"endpoint_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"public", "private"}),
+				Description:  "public or private",
+				ForceNew:     true,
+				Default:      "public",
+			},
+			"adapter_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the KMIP adapter the certificate is added to.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The PEM encoded contents of the client certificate.",
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return strings.TrimSpace(old) == strings.TrimSpace(new)
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the client certificate. A name is generated when it is not set.",
+			},
+			"cert_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the client certificate.",
+			},
+			"created_by": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier of the user that added the client certificate.",
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the client certificate was added. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIBMKmsKMIPClientCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	kpAPI, _, err := getKmsInstanceClient(meta, d.Get("instance_id").(string), d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	adapterID := d.Get("adapter_id").(string)
+
+	request := kmipClientCertificates{
+		Metadata: &kmipMetadata{
+			CollectionType:  kmipCertificateCollectionType,
+			CollectionTotal: 1,
+		},
+		Resources: []kmipClientCertificate{
+			{
+				Name:        d.Get("name").(string),
+				Certificate: d.Get("certificate").(string),
+			},
+		},
+	}
+	response := kmipClientCertificates{}
+	err = kmipRequest(context, kpAPI, meta, "POST", "kmip_adapters/"+adapterID+"/certificates", request, &response)
+	if err != nil {
+		return diag.Errorf("[ERROR] Error while adding client certificate to KMIP adapter %s: %s", adapterID, err)
+	}
+	if len(response.Resources) == 0 {
+		return diag.Errorf("[ERROR] Error while adding client certificate to KMIP adapter %s: empty response", adapterID)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", kpAPI.Config.InstanceID, adapterID, response.Resources[0].ID))
+	return resourceIBMKmsKMIPClientCertificateRead(context, d, meta)
+}
+
+func resourceIBMKmsKMIPClientCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 3 {
+		return diag.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of instanceID/adapterID/certID", d.Id())
+	}
+	instanceID, adapterID, certID := parts[0], parts[1], parts[2]
+
+	kpAPI, _, err := getKmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	response := kmipClientCertificates{}
+	err = kmipRequest(context, kpAPI, meta, "GET", "kmip_adapters/"+adapterID+"/certificates/"+certID, nil, &response)
+	if err != nil {
+		if isKmsNotFound(err) {
+			d.SetId("")
+			return nil
+		}
+		return diag.Errorf("[ERROR] Error while reading client certificate %s: %s", certID, err)
+	}
+	if len(response.Resources) == 0 {
+		d.SetId("")
+		return nil
+	}
+	cert := response.Resources[0]
+
+	d.Set("instance_id", instanceID)
+	d.Set("endpoint_type", kmsEndpointTypeFromClient(kpAPI))
+	d.Set("adapter_id", adapterID)
+	d.Set("cert_id", cert.ID)
+	d.Set("name", cert.Name)
+	d.Set("certificate", cert.Certificate)
+	d.Set("created_by", cert.CreatedBy)
+	d.Set("created_at", cert.CreatedAt)
+	return nil
+}
+
+func resourceIBMKmsKMIPClientCertificateDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) < 3 {
+		return diag.Errorf("[ERROR] Incorrect ID %s: Id should be a combination of instanceID/adapterID/certID", d.Id())
+	}
+	instanceID, adapterID, certID := parts[0], parts[1], parts[2]
+
+	kpAPI, _, err := getKmsInstanceClient(meta, instanceID, d.Get("endpoint_type").(string))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	err = kmipRequest(context, kpAPI, meta, "DELETE", "kmip_adapters/"+adapterID+"/certificates/"+certID, nil, nil)
+	if err != nil && !isKmsNotFound(err) {
+		return diag.Errorf("[ERROR] Error while deleting client certificate %s: %s", certID, err)
+	}
+	d.SetId("")
+	return nil
+}
@@ -5,33 +5,46 @@ package kubernetes
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"log"
 	"strings"
 	"time"
 
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
 	"github.com/IBM-Cloud/bluemix-go/bmxerror"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+const (
+	addOnUpgradePolicyPinned      = "pinned"
+	addOnUpgradePolicyLatestPatch = "latest_patch"
+	addOnUpgradePolicyLatestMinor = "latest_minor"
 )
 
 func ResourceIBMContainerAddOns() *schema.Resource {
 	return &schema.Resource{
-		Create:   resourceIBMContainerAddOnsCreate,
-		Read:     resourceIBMContainerAddOnsRead,
-		Update:   resourceIBMContainerAddOnsUpdate,
-		Delete:   resourceIBMContainerAddOnsDelete,
-		Exists:   resourceIBMContainerAddOnsExists,
-		Importer: &schema.ResourceImporter{},
+		CreateContext: resourceIBMContainerAddOnsCreateContext,
+		Read:          resourceIBMContainerAddOnsRead,
+		UpdateContext: resourceIBMContainerAddOnsUpdateContext,
+		Delete:        resourceIBMContainerAddOnsDelete,
+		Exists:        resourceIBMContainerAddOnsExists,
+		Importer:      &schema.ResourceImporter{},
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(20 * time.Minute),
 			Update: schema.DefaultTimeout(20 * time.Minute),
 		},
 
+		CustomizeDiff: resourceIBMContainerAddOnsUpgradePolicyDiff,
+
 		Schema: map[string]*schema.Schema{
 			"cluster": {
 				Type:        schema.TypeString,
@@ -63,6 +76,13 @@ func ResourceIBMContainerAddOns() *schema.Resource {
 							ForceNew:    false,
 							Description: "The addon version, omit the version if you wish to use the default version.",
 						},
+						"upgrade_policy": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Default:      addOnUpgradePolicyPinned,
+							ValidateFunc: validate.ValidateAllowedStringValues([]string{addOnUpgradePolicyPinned, addOnUpgradePolicyLatestPatch, addOnUpgradePolicyLatestMinor}),
+							Description:  "How the addon version is kept up to date: pinned, latest_patch or latest_minor. With latest_patch or latest_minor the version must be omitted and is upgraded to the newest allowed upgrade version.",
+						},
 						"allowed_upgrade_versions": {
 							Type:        schema.TypeList,
 							Computed:    true,
@@ -112,6 +132,12 @@ func ResourceIBMContainerAddOns() *schema.Resource {
 					},
 				},
 			},
+			"pending_upgrades": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The addon versions that the upgrade policies upgrade the installed addons to, keyed by addon name. Kept after the upgrade is applied.",
+			},
 		},
 	}
 }
@@ -255,6 +281,34 @@ func updateAddOnVersion(d *schema.ResourceData, meta interface{}, u map[string]i
 
 	return nil
 }
+func resourceIBMContainerAddOnsCreateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceIBMContainerAddOnsCreate(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return addOnsDeprecationWarnings(d)
+}
+func resourceIBMContainerAddOnsUpdateContext(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := resourceIBMContainerAddOnsUpdate(d, meta); err != nil {
+		return diag.FromErr(err)
+	}
+	return addOnsDeprecationWarnings(d)
+}
+
+// addOnsDeprecationWarnings warns about deprecated addon versions, only after an apply so that refreshes stay quiet
+func addOnsDeprecationWarnings(d *schema.ResourceData) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, a := range d.Get("addons").(*schema.Set).List() {
+		addOn := a.(map[string]interface{})
+		if addOn["deprecated"].(bool) {
+			diags = append(diags, diag.Diagnostic{
+				Severity: diag.Warning,
+				Summary:  fmt.Sprintf("Version %s of addon %s is deprecated", addOn["version"], addOn["name"]),
+				Detail:   fmt.Sprintf("Upgrade the addon to one of %v", addOn["allowed_upgrade_versions"]),
+			})
+		}
+	}
+	return diags
+}
 func resourceIBMContainerAddOnsRead(d *schema.ResourceData, meta interface{}) error {
 	csClient, err := meta.(conns.ClientSession).ContainerAPI()
 	if err != nil {
@@ -273,20 +327,38 @@ func resourceIBMContainerAddOnsRead(d *schema.ResourceData, meta interface{}) er
 		return err
 	}
 	d.Set("cluster", cluster)
-	addOns, err := flattenAddOns(result)
+	addOns, err := flattenAddOns(result, expandAddOnsUpgradePolicies(d.Get("addons").(*schema.Set).List()))
 	if err != nil {
 		fmt.Printf("Error Flattening Addons list %s", err)
 	}
 	d.Set("resource_group_id", targetEnv.ResourceGroup)
 	d.Set("addons", addOns)
+
+	// Keep the upgrades that were applied so that the state matches the plan, an upgrade
+	// that did not take effect is dropped and planned again
+	installed := map[string]string{}
+	for _, addOn := range result {
+		installed[addOn.Name] = addOn.Version
+	}
+	applied := map[string]string{}
+	for name, target := range d.Get("pending_upgrades").(map[string]interface{}) {
+		if installed[name] == target.(string) {
+			applied[name] = target.(string)
+		}
+	}
+	d.Set("pending_upgrades", applied)
 	return nil
 }
-func flattenAddOns(result []v1.AddOn) (resp *schema.Set, err error) {
+func flattenAddOns(result []v1.AddOn, upgradePolicies map[string]string) (resp *schema.Set, err error) {
 	addOns := []interface{}{}
 	for _, addOn := range result {
 		record := map[string]interface{}{}
 		record["name"] = addOn.Name
 		record["version"] = addOn.Version
+		record["upgrade_policy"] = addOnUpgradePolicyPinned
+		if policy, ok := upgradePolicies[addOn.Name]; ok {
+			record["upgrade_policy"] = policy
+		}
 		if len(addOn.AllowedUpgradeVersion) > 0 {
 			record["allowed_upgrade_versions"] = addOn.AllowedUpgradeVersion
 		}
@@ -435,6 +507,33 @@ func resourceIBMContainerAddOnsUpdate(d *schema.ResourceData, meta interface{})
 		}
 	}
 
+	if d.HasChange("pending_upgrades") {
+		o, n := d.GetChange("pending_upgrades")
+		applied := o.(map[string]interface{})
+		upgraded := false
+		for name, target := range n.(map[string]interface{}) {
+			// Upgrades kept in the state from an earlier apply are already installed
+			if applied[name] == target {
+				continue
+			}
+			upgraded = true
+			upgrade := map[string]interface{}{
+				"name":    name,
+				"version": target.(string),
+			}
+			err := updateAddOnVersion(d, meta, upgrade, cluster, targetEnv)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error upgrading addon %s to %s on %s : %s", name, target, cluster, err)
+			}
+		}
+		if upgraded {
+			_, err = waitForContainerAddOns(d, meta, cluster, schema.TimeoutUpdate)
+			if err != nil {
+				return fmt.Errorf("[ERROR] Error waiting for Addon to reach normal during update (%s) : %s", d.Id(), err)
+			}
+		}
+	}
+
 	return resourceIBMContainerAddOnsRead(d, meta)
 }
 
@@ -529,7 +628,201 @@ func resourceIBMContainerAddonsHash(v interface{}) int {
 	var buf bytes.Buffer
 	a := v.(map[string]interface{})
 	buf.WriteString(fmt.Sprintf("%s-", a["name"].(string)))
-	buf.WriteString(fmt.Sprintf("%s-", a["version"].(string)))
 
 	return conns.String(buf.String())
 }
+
+func resourceIBMContainerAddOnsUpgradePolicyDiff(ctx context.Context, diff *schema.ResourceDiff, meta interface{}) error {
+	if err := checkAddOnsUpgradePolicyConfig(diff); err != nil {
+		return err
+	}
+	// Upgrade policies only apply to addons that are already installed
+	if diff.Id() == "" {
+		return nil
+	}
+	oldList, newList := diff.GetChange("addons")
+	installed := map[string]map[string]interface{}{}
+	for _, a := range oldList.(*schema.Set).List() {
+		addOn := a.(map[string]interface{})
+		installed[addOn["name"].(string)] = addOn
+	}
+
+	clusterVersion := ""
+	var available []kubernetesserviceapiv1.AddonCommon
+	pending := map[string]string{}
+	for _, a := range newList.(*schema.Set).List() {
+		addOn := a.(map[string]interface{})
+		name := addOn["name"].(string)
+		policy := addOn["upgrade_policy"].(string)
+		current, ok := installed[name]
+		if !ok || policy == addOnUpgradePolicyPinned {
+			continue
+		}
+		currentVersion := current["version"].(string)
+		target := AddOnUpgradeTargetVersion(currentVersion, flex.ExpandStringList(current["allowed_upgrade_versions"].([]interface{})), policy)
+		if target == "" || target == currentVersion {
+			continue
+		}
+		if clusterVersion == "" {
+			var err error
+			clusterVersion, err = getAddOnsClusterVersion(diff, meta)
+			if err != nil {
+				return err
+			}
+			available, err = getAvailableAddOns(meta)
+			if err != nil {
+				return err
+			}
+		}
+		if err := CheckAddOnMinVersion(name, target, clusterVersion, available); err != nil {
+			return err
+		}
+		pending[name] = target
+	}
+
+	if len(pending) == 0 {
+		return nil
+	}
+	return diff.SetNew("pending_upgrades", pending)
+}
+
+// checkAddOnsUpgradePolicyConfig rejects an explicit version on addons that are upgraded by their upgrade policy
+func checkAddOnsUpgradePolicyConfig(diff *schema.ResourceDiff) error {
+	addOns := diff.GetRawConfig().GetAttr("addons")
+	if !addOns.IsKnown() || addOns.IsNull() {
+		return nil
+	}
+	for it := addOns.ElementIterator(); it.Next(); {
+		_, addOn := it.Element()
+		if !addOn.IsKnown() || addOn.IsNull() {
+			continue
+		}
+		policy, version, name := addOn.GetAttr("upgrade_policy"), addOn.GetAttr("version"), addOn.GetAttr("name")
+		if !policy.IsKnown() || policy.IsNull() || policy.AsString() == addOnUpgradePolicyPinned {
+			continue
+		}
+		if version.IsNull() {
+			continue
+		}
+		addOnName := "unknown"
+		if name.IsKnown() && !name.IsNull() {
+			addOnName = name.AsString()
+		}
+		return fmt.Errorf("[ERROR] Addon %s sets version together with upgrade_policy %s, omit the version or use upgrade_policy %s", addOnName, policy.AsString(), addOnUpgradePolicyPinned)
+	}
+	return nil
+}
+
+// AddOnUpgradeTargetVersion picks the newest allowed upgrade version permitted by the upgrade policy
+func AddOnUpgradeTargetVersion(current string, allowed []string, policy string) string {
+	currentVersion, err := version.NewVersion(current)
+	if err != nil {
+		return ""
+	}
+	currentSegments := currentVersion.Segments()
+	var target *version.Version
+	for _, a := range allowed {
+		v, err := version.NewVersion(a)
+		if err != nil || !v.GreaterThan(currentVersion) {
+			continue
+		}
+		segments := v.Segments()
+		if segments[0] != currentSegments[0] {
+			continue
+		}
+		if policy == addOnUpgradePolicyLatestPatch && segments[1] != currentSegments[1] {
+			continue
+		}
+		if target == nil || v.GreaterThan(target) {
+			target = v
+		}
+	}
+	if target == nil {
+		return ""
+	}
+	return target.Original()
+}
+
+// CheckAddOnMinVersion blocks upgrades on clusters older than the minimum kubernetes or OpenShift version of the target addon version
+func CheckAddOnMinVersion(name, target, clusterVersion string, available []kubernetesserviceapiv1.AddonCommon) error {
+	var addOn *kubernetesserviceapiv1.AddonCommon
+	for i := range available {
+		if available[i].Name != nil && *available[i].Name == name && available[i].Version != nil && *available[i].Version == target {
+			addOn = &available[i]
+			break
+		}
+	}
+	if addOn == nil {
+		return nil
+	}
+	minVersion := ""
+	if strings.Contains(strings.ToLower(clusterVersion), "openshift") {
+		if addOn.MinOCPVersion != nil {
+			minVersion = *addOn.MinOCPVersion
+		}
+	} else if addOn.MinKubeVersion != nil {
+		minVersion = *addOn.MinKubeVersion
+	}
+	if minVersion == "" {
+		return nil
+	}
+	clusterV, err := version.NewVersion(strings.Split(clusterVersion, "_")[0])
+	if err != nil {
+		return nil
+	}
+	minV, err := version.NewVersion(strings.Split(minVersion, "_")[0])
+	if err != nil {
+		return nil
+	}
+	if clusterV.LessThan(minV) {
+		return fmt.Errorf("[ERROR] Addon %s cannot be upgraded to %s: the cluster version %s is older than the minimum version %s", name, target, clusterVersion, minVersion)
+	}
+	return nil
+}
+
+// getAvailableAddOns lists every version of every addon together with its minimum cluster versions
+func getAvailableAddOns(meta interface{}) ([]kubernetesserviceapiv1.AddonCommon, error) {
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return nil, err
+	}
+	available, response, err := satClient.GetAddons(&kubernetesserviceapiv1.GetAddonsOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error listing the available addon versions: %s\n%s", err, response)
+	}
+	return available, nil
+}
+
+func getAddOnsClusterVersion(diff *schema.ResourceDiff, meta interface{}) (string, error) {
+	csClient, err := meta.(conns.ClientSession).ContainerAPI()
+	if err != nil {
+		return "", err
+	}
+	userDetails, err := meta.(conns.ClientSession).BluemixUserDetails()
+	if err != nil {
+		return "", err
+	}
+	targetEnv := v1.ClusterTargetHeader{
+		AccountID: userDetails.UserAccount,
+	}
+	if v, ok := diff.GetOk("resource_group_id"); ok {
+		targetEnv.ResourceGroup = v.(string)
+	}
+	cluster := diff.Id()
+	cls, err := csClient.Clusters().Find(cluster, targetEnv)
+	if err != nil {
+		return "", fmt.Errorf("[ERROR] Error retrieving cluster %s: %s", cluster, err)
+	}
+	return cls.MasterKubeVersion, nil
+}
+
+func expandAddOnsUpgradePolicies(addOnSet []interface{}) map[string]string {
+	policies := map[string]string{}
+	for _, a := range addOnSet {
+		addOn := a.(map[string]interface{})
+		if policy, ok := addOn["upgrade_policy"].(string); ok && policy != "" {
+			policies[addOn["name"].(string)] = policy
+		}
+	}
+	return policies
+}
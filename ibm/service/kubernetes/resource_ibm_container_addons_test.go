@@ -10,12 +10,15 @@ import (
 
 	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/kubernetes"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
 	v1 "github.com/IBM-Cloud/bluemix-go/api/container/containerv1"
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM/go-sdk-core/v5/core"
 )
 
 func TestAccIBMContainerAddOns_Basic(t *testing.T) {
@@ -49,6 +52,30 @@ func TestAccIBMContainerAddOns_Basic(t *testing.T) {
 	})
 }
 
+func TestAccIBMContainerAddOns_UpgradePolicy(t *testing.T) {
+	name := fmt.Sprintf("tf-cluster-addon-%d", acctest.RandIntRange(10, 100))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMContainerAddOnsDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMContainerAddOnsUpgradePolicy(name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_container_addons.addons", "addons.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs(
+						"ibm_container_addons.addons", "addons.*", map[string]string{
+							"name":           "vpc-block-csi-driver",
+							"upgrade_policy": "latest_patch",
+						}),
+				),
+			},
+		},
+	})
+}
+
 func testAccCheckIBMContainerAddOnsDestroy(s *terraform.State) error {
 	for _, rs := range s.RootModule().Resources {
 		if rs.Type != "ibm_container_addons" {
@@ -140,3 +167,88 @@ func testAccCheckIBMContainerAddOnsUpdate(name string) string {
 		}
 }`, name)
 }
+
+func testAccCheckIBMContainerAddOnsUpgradePolicy(name string) string {
+	return fmt.Sprintf(`
+	provider "ibm"{
+		region = "eu-de"
+	}
+	resource "ibm_is_vpc" "vpc" {
+		name = "%[1]s"
+	}
+	resource "ibm_is_subnet" "subnet" {
+		name                     = "%[1]s"
+		vpc                      = ibm_is_vpc.vpc.id
+		zone                     = "eu-de-1"
+		total_ipv4_address_count = 256
+	}
+	resource "ibm_container_vpc_cluster" "cluster" {
+		name              = "%[1]s"
+		vpc_id            = ibm_is_vpc.vpc.id
+		flavor            = "cx2.2x4"
+		worker_count      = 1
+		wait_till         = "OneWorkerNodeReady"
+		zones {
+			subnet_id = ibm_is_subnet.subnet.id
+			name      = "eu-de-1"
+		}
+	}
+	resource "ibm_container_addons" "addons" {
+		cluster = ibm_container_vpc_cluster.cluster.id
+		addons {
+			name           = "vpc-block-csi-driver"
+			upgrade_policy = "latest_patch"
+		}
+}`, name)
+}
+
+func TestAddOnUpgradeTargetVersion(t *testing.T) {
+	allowed := []string{"1.8.3", "1.9.0", "1.9.2", "2.0.0", "bad"}
+	cases := []struct {
+		current, policy, want string
+	}{
+		{"1.8.1", "latest_patch", "1.8.3"},
+		{"1.8.1", "latest_minor", "1.9.2"},
+		{"1.9.2", "latest_patch", ""},
+		{"1.9.2", "latest_minor", ""},
+		{"invalid", "latest_minor", ""},
+	}
+	for _, c := range cases {
+		if got := kubernetes.AddOnUpgradeTargetVersion(c.current, allowed, c.policy); got != c.want {
+			t.Errorf("AddOnUpgradeTargetVersion(%q, %q) = %q, want %q", c.current, c.policy, got, c.want)
+		}
+	}
+}
+
+func TestCheckAddOnMinVersion(t *testing.T) {
+	available := []kubernetesserviceapiv1.AddonCommon{
+		{
+			Name:           core.StringPtr("istio"),
+			Version:        core.StringPtr("1.8"),
+			MinKubeVersion: core.StringPtr("1.20.0"),
+		},
+		{
+			Name:           core.StringPtr("istio"),
+			Version:        core.StringPtr("1.9"),
+			MinKubeVersion: core.StringPtr("1.22.0"),
+			MinOCPVersion:  core.StringPtr("4.8.0"),
+		},
+	}
+	cases := []struct {
+		target, clusterVersion string
+		wantErr                bool
+	}{
+		{"1.8", "1.21.4", false},
+		{"1.9", "1.21.4", true},
+		{"1.9", "1.22.1", false},
+		{"1.9", "4.7.30_openshift", true},
+		{"1.9", "4.8.12_openshift", false},
+		{"1.10", "1.21.4", false},
+	}
+	for _, c := range cases {
+		err := kubernetes.CheckAddOnMinVersion("istio", c.target, c.clusterVersion, available)
+		if (err != nil) != c.wantErr {
+			t.Errorf("CheckAddOnMinVersion(%q, %q) error = %v, want error %t", c.target, c.clusterVersion, err, c.wantErr)
+		}
+	}
+}
@@ -52,6 +52,14 @@ func DataSourceIBMPIInstance() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			Attr_InstanceSharedProcessorPool: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			Attr_InstanceSharedProcessorPoolID: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
 			"addresses": {
 				Type:       schema.TypeList,
 				Computed:   true,
@@ -224,6 +232,8 @@ func dataSourceIBMPIInstancesRead(ctx context.Context, d *schema.ResourceData, m
 	d.Set("storage_pool_affinity", powervmdata.StoragePoolAffinity)
 	d.Set("license_repository_capacity", powervmdata.LicenseRepositoryCapacity)
 	d.Set("networks", flattenPvmInstanceNetworks(powervmdata.Networks))
+	d.Set(Attr_InstanceSharedProcessorPool, powervmdata.SharedProcessorPool)
+	d.Set(Attr_InstanceSharedProcessorPoolID, powervmdata.SharedProcessorPoolID)
 	if *powervmdata.PlacementGroup != "none" {
 		d.Set(PIPlacementGroupID, powervmdata.PlacementGroup)
 	}
@@ -0,0 +1,174 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPISharedProcessorPool() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPISharedProcessorPoolRead,
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SharedProcessorPoolID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID or name of the shared processor pool",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_SharedProcessorPoolName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the shared processor pool",
+			},
+			Attr_SharedProcessorPoolReservedCores: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The amount of reserved cores for the shared processor pool",
+			},
+			Attr_SharedProcessorPoolAllocatedCores: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Shared processor pool allocated cores",
+			},
+			Attr_SharedProcessorPoolAvailableCores: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Shared processor pool available cores",
+			},
+			Attr_SharedProcessorPoolHostID: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The host ID where the shared processor pool resides",
+			},
+			Attr_SharedProcessorPoolStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the shared processor pool",
+			},
+			Attr_SharedProcessorPoolStatusDetail: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status details of the shared processor pool",
+			},
+			Attr_SharedProcessorPoolPlacementGroups: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "SPP placement groups the shared processor pool is in",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_SPPPlacementGroupID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						Attr_SPPPlacementGroupName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						Attr_SPPPlacementGroupPolicy: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			Attr_SharedProcessorPoolInstances: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of server instances deployed in the shared processor pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_SharedProcessorPoolInstanceAvailabilityZone: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Availability zone for the server instances",
+						},
+						Attr_SharedProcessorPoolInstanceCpus: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The amount of cpus for the server instance",
+						},
+						Attr_SharedProcessorPoolInstanceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The server instance ID",
+						},
+						Attr_SharedProcessorPoolInstanceMemory: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The amount of memory for the server instance",
+						},
+						Attr_SharedProcessorPoolInstanceName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The server instance name",
+						},
+						Attr_SharedProcessorPoolInstanceStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the instance",
+						},
+						Attr_SharedProcessorPoolInstanceUncapped: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Identifies if uncapped or not",
+						},
+						Attr_SharedProcessorPoolInstanceVcpus: {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The amount of vcpus for the server instance",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMPISharedProcessorPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	sppID := d.Get(Arg_SharedProcessorPoolID).(string)
+
+	client := st.NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	response, err := client.Get(sppID)
+	if err != nil {
+		log.Printf("[DEBUG] get shared processor pool failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	spp := response.SharedProcessorPool
+	d.SetId(*spp.ID)
+	d.Set(Attr_SharedProcessorPoolName, spp.Name)
+	d.Set(Attr_SharedProcessorPoolReservedCores, spp.ReservedCores)
+	d.Set(Attr_SharedProcessorPoolAllocatedCores, spp.AllocatedCores)
+	d.Set(Attr_SharedProcessorPoolAvailableCores, spp.AvailableCores)
+	d.Set(Attr_SharedProcessorPoolHostID, spp.HostID)
+	d.Set(Attr_SharedProcessorPoolStatus, spp.Status)
+	d.Set(Attr_SharedProcessorPoolStatusDetail, spp.StatusDetail)
+	d.Set(Attr_SharedProcessorPoolPlacementGroups, flattenSharedProcessorPoolPlacementGroups(spp.SharedProcessorPoolPlacementGroups))
+	d.Set(Attr_SharedProcessorPoolInstances, flattenSharedProcessorPoolInstances(response.Servers))
+
+	return nil
+}
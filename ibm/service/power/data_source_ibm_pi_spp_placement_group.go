@@ -0,0 +1,78 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPISPPPlacementGroup() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPISPPPlacementGroupRead,
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SPPPlacementGroupID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The ID of the SPP placement group",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_SPPPlacementGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the SPP placement group",
+			},
+			Attr_SPPPlacementGroupPolicy: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The policy of the SPP placement group",
+			},
+			Attr_SPPPlacementGroupMembers: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "Member shared processor pool IDs that are the SPP placement group members",
+			},
+		},
+	}
+}
+
+func dataSourceIBMPISPPPlacementGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	pgID := d.Get(Arg_SPPPlacementGroupID).(string)
+
+	client := st.NewIBMPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+	response, err := client.Get(pgID)
+	if err != nil {
+		log.Printf("[DEBUG] get SPP placement group failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(*response.ID)
+	d.Set(Attr_SPPPlacementGroupName, response.Name)
+	d.Set(Attr_SPPPlacementGroupPolicy, response.Policy)
+	d.Set(Attr_SPPPlacementGroupMembers, response.MemberSharedProcessorPools)
+
+	return nil
+}
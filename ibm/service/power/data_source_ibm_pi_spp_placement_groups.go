@@ -0,0 +1,96 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"log"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/hashicorp/go-uuid"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+func DataSourceIBMPISPPPlacementGroups() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIBMPISPPPlacementGroupsRead,
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_SPPPlacementGroups: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The list of all the SPP placement groups",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_SPPPlacementGroupID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the SPP placement group",
+						},
+						Attr_SPPPlacementGroupName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the SPP placement group",
+						},
+						Attr_SPPPlacementGroupPolicy: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The policy of the SPP placement group",
+						},
+						Attr_SPPPlacementGroupMembers: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Member shared processor pool IDs that are the SPP placement group members",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceIBMPISPPPlacementGroupsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+
+	client := st.NewIBMPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+	groups, err := client.GetAll()
+	if err != nil {
+		log.Printf("[ERROR] get all SPP placement groups failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	result := make([]map[string]interface{}, 0, len(groups.SppPlacementGroups))
+	for _, pg := range groups.SppPlacementGroups {
+		key := map[string]interface{}{
+			Attr_SPPPlacementGroupID:      pg.ID,
+			Attr_SPPPlacementGroupName:    pg.Name,
+			Attr_SPPPlacementGroupPolicy:  pg.Policy,
+			Attr_SPPPlacementGroupMembers: pg.MemberSharedProcessorPools,
+		}
+		result = append(result, key)
+	}
+
+	var genID, _ = uuid.GenerateUUID()
+	d.SetId(genID)
+	d.Set(Attr_SPPPlacementGroups, result)
+
+	return nil
+}
@@ -62,6 +62,56 @@ func DataSourceIBMPIVolume() *schema.Resource {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
+			Attr_VolumeAuxiliary: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "true if volume is auxiliary otherwise false",
+			},
+			Attr_VolumeAuxiliaryVolumeName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates auxiliary volume name",
+			},
+			Attr_VolumeConsistencyGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Consistency Group Name if volume is a part of volume group",
+			},
+			Attr_GroupID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume Group ID",
+			},
+			Attr_VolumeMasterVolumeName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates master volume name",
+			},
+			Attr_VolumeMirroringState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Mirroring state for replication enabled volume",
+			},
+			Attr_VolumePrimaryRole: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates whether master/aux volume is playing the primary role",
+			},
+			Attr_VolumeReplicationEnabled: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Indicates if the volume should be replication enabled or not",
+			},
+			Attr_VolumeReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication status of a volume",
+			},
+			Attr_VolumeReplicationType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication type(metro,global)",
+			},
 		},
 	}
 }
@@ -87,6 +137,16 @@ func dataSourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta
 	d.Set("disk_type", volumedata.DiskType)
 	d.Set("volume_pool", volumedata.VolumePool)
 	d.Set("wwn", volumedata.Wwn)
+	d.Set(Attr_VolumeAuxiliary, volumedata.Auxiliary)
+	d.Set(Attr_VolumeAuxiliaryVolumeName, volumedata.AuxVolumeName)
+	d.Set(Attr_VolumeConsistencyGroupName, volumedata.ConsistencyGroupName)
+	d.Set(Attr_GroupID, volumedata.GroupID)
+	d.Set(Attr_VolumeMasterVolumeName, volumedata.MasterVolumeName)
+	d.Set(Attr_VolumeMirroringState, volumedata.MirroringState)
+	d.Set(Attr_VolumePrimaryRole, volumedata.PrimaryRole)
+	d.Set(Attr_VolumeReplicationEnabled, volumedata.ReplicationEnabled)
+	d.Set(Attr_VolumeReplicationStatus, volumedata.ReplicationStatus)
+	d.Set(Attr_VolumeReplicationType, volumedata.ReplicationType)
 
 	return nil
 }
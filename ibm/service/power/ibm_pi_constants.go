@@ -52,17 +52,132 @@ const (
 	PISAPInstanceProfileID        = "pi_sap_profile_id"
 	PISAPInstanceDeploymentType   = "pi_sap_deployment_type"
 	PIInstanceStoragePoolAffinity = "pi_storage_pool_affinity"
+	PIInstanceSharedProcessorPool = "pi_shared_processor_pool"
+
+	Attr_InstanceSharedProcessorPool   = "shared_processor_pool"
+	Attr_InstanceSharedProcessorPoolID = "shared_processor_pool_id"
 
 	// Placement Group
 	PIPlacementGroupID      = "placement_group_id"
 	PIPlacementGroupMembers = "members"
 
+	// Shared Processor Pool
+	Arg_SharedProcessorPoolHostGroup        = "pi_shared_processor_pool_host_group"
+	Arg_SharedProcessorPoolID               = "pi_shared_processor_pool_id"
+	Arg_SharedProcessorPoolName             = "pi_shared_processor_pool_name"
+	Arg_SharedProcessorPoolPlacementGroupID = "pi_shared_processor_pool_placement_group_id"
+	Arg_SharedProcessorPoolReservedCores    = "pi_shared_processor_pool_reserved_cores"
+
+	Attr_SharedProcessorPoolAllocatedCores           = "allocated_cores"
+	Attr_SharedProcessorPoolAvailableCores           = "available_cores"
+	Attr_SharedProcessorPoolHostID                   = "host_id"
+	Attr_SharedProcessorPoolID                       = "shared_processor_pool_id"
+	Attr_SharedProcessorPoolInstances                = "instances"
+	Attr_SharedProcessorPoolInstanceAvailabilityZone = "availability_zone"
+	Attr_SharedProcessorPoolInstanceCpus             = "cpus"
+	Attr_SharedProcessorPoolInstanceID               = "id"
+	Attr_SharedProcessorPoolInstanceMemory           = "memory"
+	Attr_SharedProcessorPoolInstanceName             = "name"
+	Attr_SharedProcessorPoolInstanceStatus           = "status"
+	Attr_SharedProcessorPoolInstanceUncapped         = "uncapped"
+	Attr_SharedProcessorPoolInstanceVcpus            = "vcpus"
+	Attr_SharedProcessorPoolName                     = "name"
+	Attr_SharedProcessorPoolPlacementGroups          = "spp_placement_groups"
+	Attr_SharedProcessorPoolReservedCores            = "reserved_cores"
+	Attr_SharedProcessorPools                        = "shared_processor_pools"
+	Attr_SharedProcessorPoolStatus                   = "status"
+	Attr_SharedProcessorPoolStatusDetail             = "status_detail"
+
+	// SPP Placement Group
+	Arg_SPPPlacementGroupID     = "pi_spp_placement_group_id"
+	Arg_SPPPlacementGroupName   = "pi_spp_placement_group_name"
+	Arg_SPPPlacementGroupPolicy = "pi_spp_placement_group_policy"
+
+	Attr_SPPPlacementGroupID      = "spp_placement_group_id"
+	Attr_SPPPlacementGroupMembers = "members"
+	Attr_SPPPlacementGroupName    = "name"
+	Attr_SPPPlacementGroupPolicy  = "policy"
+	Attr_SPPPlacementGroups       = "spp_placement_groups"
+
 	// Volume
 	PIAffinityPolicy        = "pi_affinity_policy"
 	PIAffinityVolume        = "pi_affinity_volume"
 	PIAffinityInstance      = "pi_affinity_instance"
 	PIAntiAffinityInstances = "pi_anti_affinity_instances"
 	PIAntiAffinityVolumes   = "pi_anti_affinity_volumes"
+	Arg_ReplicationEnabled  = "pi_replication_enabled"
+
+	Attr_VolumeAuxiliary            = "auxiliary"
+	Attr_VolumeAuxiliaryVolumeName  = "auxiliary_volume_name"
+	Attr_VolumeConsistencyGroupName = "consistency_group_name"
+	Attr_GroupID                    = "group_id"
+	Attr_VolumeMasterVolumeName     = "master_volume_name"
+	Attr_VolumeMirroringState       = "mirroring_state"
+	Attr_VolumePrimaryRole          = "primary_role"
+	Attr_VolumeReplicationEnabled   = "replication_enabled"
+	Attr_VolumeReplicationStatus    = "replication_status"
+	Attr_VolumeReplicationType      = "replication_type"
+
+	// Volume Group
+	Arg_VolumeGroupAction               = "pi_volume_group_action"
+	Arg_VolumeGroupConsistencyGroupName = "pi_consistency_group_name"
+	Arg_VolumeGroupID                   = "pi_volume_group_id"
+	Arg_VolumeGroupName                 = "pi_volume_group_name"
+	Arg_VolumeGroupVolumeIDs            = "pi_volume_ids"
+
+	Attr_VolumeGroupActionAccess            = "access"
+	Attr_VolumeGroupActionReset             = "reset"
+	Attr_VolumeGroupActionSource            = "source"
+	Attr_VolumeGroupActionStart             = "start"
+	Attr_VolumeGroupActionStatus            = "status"
+	Attr_VolumeGroupActionStop              = "stop"
+	Attr_VolumeGroupConsistencyGroupName    = "consistency_group_name"
+	Attr_VolumeGroupID                      = "volume_group_id"
+	Attr_VolumeGroupReplicationStatus       = "replication_status"
+	Attr_VolumeGroupStatus                  = "volume_group_status"
+	Attr_VolumeGroupStatusDescriptionErrors = "status_description_errors"
+	Attr_VolumeGroupStatusErrorKey          = "key"
+	Attr_VolumeGroupStatusErrorMessage      = "message"
+	Attr_VolumeGroupStatusErrorVolumeIDs    = "volume_ids"
+
+	// Volume Onboarding
+	Arg_OnboardingAuxiliaryVolumeName = "pi_auxiliary_volume_name"
+	Arg_OnboardingAuxiliaryVolumes    = "pi_auxiliary_volumes"
+	Arg_OnboardingDescription         = "pi_description"
+	Arg_OnboardingDisplayName         = "pi_display_name"
+	Arg_OnboardingSourceCRN           = "pi_source_crn"
+	Arg_OnboardingVolumes             = "pi_onboarding_volumes"
+
+	Attr_OnboardingCreateTime     = "create_time"
+	Attr_OnboardingFailureMessage = "failure_message"
+	Attr_OnboardingFailures       = "results_volume_onboarding_failures"
+	Attr_OnboardingFailureVolumes = "volumes"
+	Attr_OnboardingID             = "onboarding_id"
+	Attr_OnboardingInputVolumes   = "input_volumes"
+	Attr_OnboardingProgress       = "progress"
+	Attr_OnboardingStatus         = "status"
+	Attr_OnboardedVolumes         = "results_onboarded_volumes"
+
+	// Snapshot Restore
+	Arg_SnapshotRestoreForce          = "pi_force"
+	Arg_SnapshotRestoreInstanceName   = "pi_instance_name"
+	Arg_SnapshotRestoreRollbackOnFail = "pi_rollback_on_failure"
+	Arg_SnapshotRestoreSnapshotID     = "pi_snapshot_id"
+
+	Attr_SnapshotRestorePercentComplete = "percent_complete"
+	Attr_SnapshotRestoreStatus          = "status"
+
+	// Volume Clone
+	Arg_VolumeCloneName      = "pi_volume_clone_name"
+	Arg_VolumeCloneVolumeIDs = "pi_volume_ids"
+
+	Attr_VolumeCloneClonedVolumes   = "cloned_volumes"
+	Attr_VolumeCloneClonedVolumeID  = "clone_volume_id"
+	Attr_VolumeCloneFailureReason   = "failure_reason"
+	Attr_VolumeClonePercentComplete = "percent_complete"
+	Attr_VolumeCloneSourceVolumeID  = "source_volume_id"
+	Attr_VolumeCloneStatus          = "status"
+	Attr_VolumeCloneTaskID          = "task_id"
 
 	// VPN
 	PIVPNConnectionId                         = "connection_id"
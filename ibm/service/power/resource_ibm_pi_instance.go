@@ -193,6 +193,18 @@ func ResourceIBMPIInstance() *schema.Resource {
 				Optional:    true,
 				Description: "Placement group ID",
 			},
+			PIInstanceSharedProcessorPool: {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{PISAPInstanceProfileID},
+				Description:   "Shared Processor Pool the instance is deployed on",
+			},
+			Attr_InstanceSharedProcessorPoolID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Shared Processor Pool ID the instance is deployed on",
+			},
 			"health_status": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -435,6 +447,10 @@ func resourceIBMPIInstanceRead(ctx context.Context, d *schema.ResourceData, meta
 	if *powervmdata.PlacementGroup != "none" {
 		d.Set(helpers.PIPlacementGroupID, powervmdata.PlacementGroup)
 	}
+	if powervmdata.SharedProcessorPool != "" {
+		d.Set(PIInstanceSharedProcessorPool, powervmdata.SharedProcessorPool)
+		d.Set(Attr_InstanceSharedProcessorPoolID, powervmdata.SharedProcessorPoolID)
+	}
 
 	networksMap := []map[string]interface{}{}
 	if powervmdata.Networks != nil {
@@ -1258,6 +1274,10 @@ func createPVMInstance(d *schema.ResourceData, client *st.IBMPIInstanceClient, i
 		body.PlacementGroup = pg.(string)
 	}
 
+	if spp, ok := d.GetOk(PIInstanceSharedProcessorPool); ok {
+		body.SharedProcessorPool = spp.(string)
+	}
+
 	if lrc, ok := d.GetOk(helpers.PIInstanceLicenseRepositoryCapacity); ok {
 		// check if using vtl image
 		// check if vtl image is stock image
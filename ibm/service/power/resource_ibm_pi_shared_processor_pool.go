@@ -0,0 +1,435 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_shared_processor_pools"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func ResourceIBMPISharedProcessorPool() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPISharedProcessorPoolCreate,
+		ReadContext:   resourceIBMPISharedProcessorPoolRead,
+		UpdateContext: resourceIBMPISharedProcessorPoolUpdate,
+		DeleteContext: resourceIBMPISharedProcessorPoolDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Update: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(60 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SharedProcessorPoolName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Name of the shared processor pool",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SharedProcessorPoolHostGroup: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Host group of the shared processor pool",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SharedProcessorPoolReservedCores: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "The amount of reserved cores for the shared processor pool",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			// Optional Arguments
+			Arg_SharedProcessorPoolPlacementGroupID: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Placement group the shared processor pool is created in",
+			},
+
+			// Attributes
+			Attr_SharedProcessorPoolID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Shared processor pool ID",
+			},
+			Attr_SharedProcessorPoolAllocatedCores: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Shared processor pool allocated cores",
+			},
+			Attr_SharedProcessorPoolAvailableCores: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "Shared processor pool available cores",
+			},
+			Attr_SharedProcessorPoolHostID: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The host ID where the shared processor pool resides",
+			},
+			Attr_SharedProcessorPoolStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the shared processor pool",
+			},
+			Attr_SharedProcessorPoolStatusDetail: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status details of the shared processor pool",
+			},
+			Attr_SharedProcessorPoolPlacementGroups: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "SPP placement groups the shared processor pool is in",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_SPPPlacementGroupID: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						Attr_SPPPlacementGroupName: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						Attr_SPPPlacementGroupPolicy: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			Attr_SharedProcessorPoolInstances: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of server instances deployed in the shared processor pool",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_SharedProcessorPoolInstanceAvailabilityZone: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Availability zone for the server instances",
+						},
+						Attr_SharedProcessorPoolInstanceCpus: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The amount of cpus for the server instance",
+						},
+						Attr_SharedProcessorPoolInstanceID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The server instance ID",
+						},
+						Attr_SharedProcessorPoolInstanceMemory: {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The amount of memory for the server instance",
+						},
+						Attr_SharedProcessorPoolInstanceName: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The server instance name",
+						},
+						Attr_SharedProcessorPoolInstanceStatus: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Status of the instance",
+						},
+						Attr_SharedProcessorPoolInstanceUncapped: {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Identifies if uncapped or not",
+						},
+						Attr_SharedProcessorPoolInstanceVcpus: {
+							Type:        schema.TypeFloat,
+							Computed:    true,
+							Description: "The amount of vcpus for the server instance",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceIBMPISharedProcessorPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	name := d.Get(Arg_SharedProcessorPoolName).(string)
+	hostGroup := d.Get(Arg_SharedProcessorPoolHostGroup).(string)
+	reservedCores := int64(d.Get(Arg_SharedProcessorPoolReservedCores).(int))
+
+	body := &models.SharedProcessorPoolCreate{
+		Name:          &name,
+		HostGroup:     &hostGroup,
+		ReservedCores: &reservedCores,
+	}
+	if pg, ok := d.GetOk(Arg_SharedProcessorPoolPlacementGroupID); ok {
+		body.PlacementGroupID = pg.(string)
+	}
+
+	client := st.NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	spp, err := client.Create(body)
+	if err != nil {
+		log.Printf("[DEBUG] create shared processor pool failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *spp.ID))
+
+	_, err = waitForIBMPISharedProcessorPoolAvailable(ctx, client, *spp.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPISharedProcessorPoolRead(ctx, d, meta)
+}
+
+func resourceIBMPISharedProcessorPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, sppID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	response, err := client.Get(sppID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_shared_processor_pools.PcloudSharedprocessorpoolsGetNotFound:
+			log.Printf("[DEBUG] shared processor pool does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] get shared processor pool failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	spp := response.SharedProcessorPool
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Attr_SharedProcessorPoolID, *spp.ID)
+	d.Set(Arg_SharedProcessorPoolName, spp.Name)
+	d.Set(Arg_SharedProcessorPoolHostGroup, spp.HostGroup)
+	d.Set(Arg_SharedProcessorPoolReservedCores, spp.ReservedCores)
+	d.Set(Attr_SharedProcessorPoolAllocatedCores, spp.AllocatedCores)
+	d.Set(Attr_SharedProcessorPoolAvailableCores, spp.AvailableCores)
+	d.Set(Attr_SharedProcessorPoolHostID, spp.HostID)
+	d.Set(Attr_SharedProcessorPoolStatus, spp.Status)
+	d.Set(Attr_SharedProcessorPoolStatusDetail, spp.StatusDetail)
+
+	placementGroups := flattenSharedProcessorPoolPlacementGroups(spp.SharedProcessorPoolPlacementGroups)
+	d.Set(Attr_SharedProcessorPoolPlacementGroups, placementGroups)
+	if len(spp.SharedProcessorPoolPlacementGroups) > 0 {
+		d.Set(Arg_SharedProcessorPoolPlacementGroupID, spp.SharedProcessorPoolPlacementGroups[0].ID)
+	} else {
+		d.Set(Arg_SharedProcessorPoolPlacementGroupID, "")
+	}
+	d.Set(Attr_SharedProcessorPoolInstances, flattenSharedProcessorPoolInstances(response.Servers))
+
+	return nil
+}
+
+func resourceIBMPISharedProcessorPoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, sppID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+
+	if d.HasChanges(Arg_SharedProcessorPoolName, Arg_SharedProcessorPoolReservedCores) {
+		body := &models.SharedProcessorPoolUpdate{}
+		if d.HasChange(Arg_SharedProcessorPoolName) {
+			body.Name = d.Get(Arg_SharedProcessorPoolName).(string)
+		}
+		if d.HasChange(Arg_SharedProcessorPoolReservedCores) {
+			body.ReservedCores = int64(d.Get(Arg_SharedProcessorPoolReservedCores).(int))
+		}
+		_, err = client.Update(sppID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = waitForIBMPISharedProcessorPoolAvailable(ctx, client, sppID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange(Arg_SharedProcessorPoolPlacementGroupID) {
+		pgClient := st.NewIBMPISPPPlacementGroupClient(ctx, sess, cloudInstanceID)
+
+		oldRaw, newRaw := d.GetChange(Arg_SharedProcessorPoolPlacementGroupID)
+		old := oldRaw.(string)
+		new := newRaw.(string)
+
+		if len(strings.TrimSpace(old)) > 0 {
+			// remove the pool from the old spp placement group
+			_, err := pgClient.DeleteMember(old, sppID)
+			if err != nil {
+				// ignore delete member error where the pool is already not in the spp placement group
+				if !strings.Contains(err.Error(), "is not part of spp placement group") {
+					return diag.FromErr(err)
+				}
+			}
+		}
+
+		if len(strings.TrimSpace(new)) > 0 {
+			// add the pool to the new spp placement group
+			_, err := pgClient.AddMember(new, sppID)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	return resourceIBMPISharedProcessorPoolRead(ctx, d, meta)
+}
+
+func resourceIBMPISharedProcessorPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, sppID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPISharedProcessorPoolClient(ctx, sess, cloudInstanceID)
+	err = client.Delete(sppID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_shared_processor_pools.PcloudSharedprocessorpoolsDeleteNotFound:
+			log.Printf("[DEBUG] shared processor pool does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] delete shared processor pool failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	_, err = waitForIBMPISharedProcessorPoolDeleted(ctx, client, sppID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func waitForIBMPISharedProcessorPoolAvailable(ctx context.Context, client *st.IBMPISharedProcessorPoolClient, sppID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"configuring"},
+		Target:  []string{"active"},
+		Refresh: func() (interface{}, string, error) {
+			response, err := client.Get(sppID)
+			if err != nil {
+				log.Printf("[DEBUG] get shared processor pool failed %v", err)
+				return nil, "", err
+			}
+			status := strings.ToLower(response.SharedProcessorPool.Status)
+			switch status {
+			case "active":
+				return response, "active", nil
+			case "failed":
+				return response, status, fmt.Errorf("[ERROR] shared processor pool %s failed: %s", sppID, response.SharedProcessorPool.StatusDetail)
+			}
+			return response, "configuring", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func waitForIBMPISharedProcessorPoolDeleted(ctx context.Context, client *st.IBMPISharedProcessorPoolClient, sppID string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"deleting"},
+		Target:  []string{"deleted"},
+		Refresh: func() (interface{}, string, error) {
+			response, err := client.Get(sppID)
+			if err != nil {
+				log.Printf("[DEBUG] shared processor pool does not exist %v", err)
+				return response, "deleted", nil
+			}
+			return response, "deleting", nil
+		},
+		Timeout:    timeout,
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func flattenSharedProcessorPoolPlacementGroups(placementGroups []*models.SharedProcessorPoolPlacementGroup) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(placementGroups))
+	for _, pg := range placementGroups {
+		result = append(result, map[string]interface{}{
+			Attr_SPPPlacementGroupID:     pg.ID,
+			Attr_SPPPlacementGroupName:   pg.Name,
+			Attr_SPPPlacementGroupPolicy: pg.Policy,
+		})
+	}
+	return result
+}
+
+func flattenSharedProcessorPoolInstances(servers []*models.SharedProcessorPoolServer) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(servers))
+	for _, server := range servers {
+		result = append(result, map[string]interface{}{
+			Attr_SharedProcessorPoolInstanceAvailabilityZone: server.AvailabilityZone,
+			Attr_SharedProcessorPoolInstanceCpus:             server.Cpus,
+			Attr_SharedProcessorPoolInstanceID:               server.ID,
+			Attr_SharedProcessorPoolInstanceMemory:           server.Memory,
+			Attr_SharedProcessorPoolInstanceName:             server.Name,
+			Attr_SharedProcessorPoolInstanceStatus:           server.Status,
+			Attr_SharedProcessorPoolInstanceUncapped:         server.Uncapped,
+			Attr_SharedProcessorPoolInstanceVcpus:            server.Vcpus,
+		})
+	}
+	return result
+}
@@ -0,0 +1,240 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_snapshots"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+const (
+	snapshotRestoreFailActionRetry    = "retry"
+	snapshotRestoreFailActionRollback = "rollback"
+
+	// The action of the restore job, e.g. restore or vmRestore
+	snapshotRestoreJobAction = "restore"
+)
+
+func ResourceIBMPISnapshotRestore() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPISnapshotRestoreCreate,
+		ReadContext:   resourceIBMPISnapshotRestoreRead,
+		DeleteContext: resourceIBMPISnapshotRestoreDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(60 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SnapshotRestoreInstanceName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Instance name / id of the pvm to restore",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_SnapshotRestoreSnapshotID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "ID of the PVM instance snapshot to restore from",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Optional Arguments
+			Arg_SnapshotRestoreForce: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Restore the snapshot even if the PVM instance is not shut off",
+			},
+			Arg_SnapshotRestoreRollbackOnFail: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Roll back the PVM instance to its previous state if the restore fails, otherwise the restore is retried",
+			},
+
+			// Attributes
+			Attr_SnapshotRestorePercentComplete: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Snapshot percent complete",
+			},
+			Attr_SnapshotRestoreStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Status of the snapshot",
+			},
+		},
+	}
+}
+
+func resourceIBMPISnapshotRestoreCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	instanceID := d.Get(Arg_SnapshotRestoreInstanceName).(string)
+	snapshotID := d.Get(Arg_SnapshotRestoreSnapshotID).(string)
+	force := d.Get(Arg_SnapshotRestoreForce).(bool)
+
+	restoreFailAction := snapshotRestoreFailActionRetry
+	if d.Get(Arg_SnapshotRestoreRollbackOnFail).(bool) {
+		restoreFailAction = snapshotRestoreFailActionRollback
+	}
+
+	body := &models.SnapshotRestore{
+		Force: &force,
+	}
+
+	client := st.NewIBMPIInstanceClient(ctx, sess, cloudInstanceID)
+	requested := time.Now()
+	snapshot, err := client.RestoreSnapShotVM(instanceID, snapshotID, restoreFailAction, body)
+	if err != nil {
+		log.Printf("[DEBUG] restore snapshot failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s/%s", cloudInstanceID, instanceID, snapshotID))
+
+	pvmInstanceID := instanceID
+	if snapshot.PvmInstanceID != nil {
+		pvmInstanceID = *snapshot.PvmInstanceID
+	}
+	jobClient := st.NewIBMPIJobClient(ctx, sess, cloudInstanceID)
+	jobID, err := waitForIBMPISnapshotRestoreJob(ctx, jobClient, pvmInstanceID, snapshotID, requested, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	_, err = waitForIBMPIJobCompleted(ctx, jobClient, jobID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPISnapshotRestoreRead(ctx, d, meta)
+}
+
+func resourceIBMPISnapshotRestoreRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	parts, err := flex.IdParts(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if len(parts) != 3 {
+		return diag.Errorf("[ERROR] incorrect ID %s: ID should be a combination of cloudInstanceID/instanceID/snapshotID", d.Id())
+	}
+	cloudInstanceID := parts[0]
+	snapshotID := parts[2]
+
+	client := st.NewIBMPISnapshotClient(ctx, sess, cloudInstanceID)
+	snapshot, err := client.Get(snapshotID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_snapshots.PcloudCloudinstancesSnapshotsGetNotFound:
+			log.Printf("[DEBUG] snapshot does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] get snapshot failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.Set(Attr_SnapshotRestorePercentComplete, snapshot.PercentComplete)
+	d.Set(Attr_SnapshotRestoreStatus, snapshot.Status)
+
+	return nil
+}
+
+// A restore cannot be undone; removing the resource only drops it from state
+func resourceIBMPISnapshotRestoreDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+// The restore response carries no job reference, the restore job is looked
+// up by the snapshot or PVM instance it operates on
+func waitForIBMPISnapshotRestoreJob(ctx context.Context, client *st.IBMPIJobClient, pvmInstanceID, snapshotID string, requested time.Time, timeout time.Duration) (string, error) {
+	log.Printf("Waiting for the restore job of PIInstance Snapshot (%s)", snapshotID)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{"retry"},
+		Target:  []string{"found"},
+		Refresh: func() (interface{}, string, error) {
+			jobs, err := client.GetAll()
+			if err != nil {
+				log.Printf("[DEBUG] get all jobs failed %v", err)
+				return nil, "", err
+			}
+			var restoreJob *models.Job
+			for _, job := range jobs.Jobs {
+				if job == nil || job.ID == nil || job.Operation == nil || job.Operation.ID == nil || job.Operation.Action == nil {
+					continue
+				}
+				if *job.Operation.ID != snapshotID && *job.Operation.ID != pvmInstanceID {
+					continue
+				}
+				// Captures and other jobs of the PVM instance can start at the same time
+				if !strings.Contains(strings.ToLower(*job.Operation.Action), snapshotRestoreJobAction) {
+					continue
+				}
+				// Allow for clock skew, older jobs belong to earlier operations
+				created := time.Time(job.CreateTimestamp)
+				if created.Before(requested.Add(-1 * time.Minute)) {
+					continue
+				}
+				if restoreJob == nil || created.After(time.Time(restoreJob.CreateTimestamp)) {
+					restoreJob = job
+				}
+			}
+			if restoreJob == nil {
+				return jobs, "retry", nil
+			}
+			return *restoreJob.ID, "found", nil
+		},
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	jobID, err := stateConf.WaitForStateContext(ctx)
+	if err != nil {
+		return "", err
+	}
+	return jobID.(string), nil
+}
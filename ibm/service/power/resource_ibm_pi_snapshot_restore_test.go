@@ -0,0 +1,43 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIBMPISnapshotRestorebasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPISnapshotRestoreConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(
+						"ibm_pi_snapshot_restore.power_snapshot_restore", "status", "available"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_snapshot_restore.power_snapshot_restore", "percent_complete", "100"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPISnapshotRestoreConfig() string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_snapshot_restore" "power_snapshot_restore" {
+		pi_cloud_instance_id   = "%s"
+		pi_instance_name       = "%s"
+		pi_snapshot_id         = "%s"
+		pi_force               = true
+		pi_rollback_on_failure = true
+	}
+	`, acc.Pi_cloud_instance_id, acc.Pi_instance_name, acc.Pi_snapshot_id)
+}
@@ -0,0 +1,104 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func TestAccIBMPISPPPlacementGroupBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-spp-placement-group-%d", acctest.RandIntRange(10, 100))
+	policy := "affinity"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPISPPPlacementGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPISPPPlacementGroupConfig(name, policy),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPISPPPlacementGroupExists("ibm_pi_spp_placement_group.power_spp_placement_group"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_spp_placement_group.power_spp_placement_group", "pi_spp_placement_group_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_spp_placement_group.power_spp_placement_group", "pi_spp_placement_group_policy", policy),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPISPPPlacementGroupDestroy(s *terraform.State) error {
+	sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_pi_spp_placement_group" {
+			continue
+		}
+		parts, _ := flex.IdParts(rs.Primary.ID)
+		cloudInstanceID := parts[0]
+		client := st.NewIBMPISPPPlacementGroupClient(context.Background(), sess, cloudInstanceID)
+		_, err = client.Get(parts[1])
+		if err == nil {
+			return fmt.Errorf("PI SPP placement group still exists: %s", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckIBMPISPPPlacementGroupExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+		parts, err := flex.IdParts(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		cloudInstanceID := parts[0]
+		client := st.NewIBMPISPPPlacementGroupClient(context.Background(), sess, cloudInstanceID)
+
+		_, err = client.Get(parts[1])
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPISPPPlacementGroupConfig(name string, policy string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_spp_placement_group" "power_spp_placement_group" {
+		pi_spp_placement_group_name   = "%s"
+		pi_spp_placement_group_policy = "%s"
+		pi_cloud_instance_id          = "%s"
+	}
+	`, name, policy, acc.Pi_cloud_instance_id)
+}
@@ -109,6 +109,12 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Description:      "List of pvmInstances to base volume anti-affinity policy against; required if requesting anti-affinity and pi_anti_affinity_volumes is not provided",
 				ConflictsWith:    []string{PIAntiAffinityVolumes},
 			},
+			Arg_ReplicationEnabled: {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Indicates if the volume should be replication enabled or not",
+			},
 
 			// Computed Attributes
 			"volume_id": {
@@ -132,6 +138,51 @@ func ResourceIBMPIVolume() *schema.Resource {
 				Computed:    true,
 				Description: "WWN Of the volume",
 			},
+			Attr_VolumeAuxiliary: {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "true if volume is auxiliary otherwise false",
+			},
+			Attr_VolumeAuxiliaryVolumeName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates auxiliary volume name",
+			},
+			Attr_VolumeConsistencyGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Consistency Group Name if volume is a part of volume group",
+			},
+			Attr_GroupID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume Group ID",
+			},
+			Attr_VolumeMasterVolumeName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates master volume name",
+			},
+			Attr_VolumeMirroringState: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Mirroring state for replication enabled volume",
+			},
+			Attr_VolumePrimaryRole: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Indicates whether master/aux volume is playing the primary role",
+			},
+			Attr_VolumeReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication status of a volume",
+			},
+			Attr_VolumeReplicationType: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Replication type(metro,global)",
+			},
 		},
 	}
 }
@@ -178,6 +229,10 @@ func resourceIBMPIVolumeCreate(ctx context.Context, d *schema.ResourceData, meta
 		volumePool := v.(string)
 		body.VolumePool = volumePool
 	}
+	if v, ok := d.GetOkExists(Arg_ReplicationEnabled); ok {
+		replicationEnabled := v.(bool)
+		body.ReplicationEnabled = &replicationEnabled
+	}
 	if ap, ok := d.GetOk(PIAffinityPolicy); ok {
 		policy := ap.(string)
 		body.AffinityPolicy = &policy
@@ -253,6 +308,16 @@ func resourceIBMPIVolumeRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("delete_on_termination", vol.DeleteOnTermination)
 	}
 	d.Set("wwn", vol.Wwn)
+	d.Set(Arg_ReplicationEnabled, vol.ReplicationEnabled)
+	d.Set(Attr_VolumeAuxiliary, vol.Auxiliary)
+	d.Set(Attr_VolumeAuxiliaryVolumeName, vol.AuxVolumeName)
+	d.Set(Attr_VolumeConsistencyGroupName, vol.ConsistencyGroupName)
+	d.Set(Attr_GroupID, vol.GroupID)
+	d.Set(Attr_VolumeMasterVolumeName, vol.MasterVolumeName)
+	d.Set(Attr_VolumeMirroringState, vol.MirroringState)
+	d.Set(Attr_VolumePrimaryRole, vol.PrimaryRole)
+	d.Set(Attr_VolumeReplicationStatus, vol.ReplicationStatus)
+	d.Set(Attr_VolumeReplicationType, vol.ReplicationType)
 	d.Set(helpers.PICloudInstanceId, cloudInstanceID)
 
 	return nil
@@ -277,18 +342,35 @@ func resourceIBMPIVolumeUpdate(ctx context.Context, d *schema.ResourceData, meta
 		shareable = v.(bool)
 	}
 
-	body := &models.UpdateVolume{
-		Name:      &name,
-		Shareable: &shareable,
-		Size:      size,
-	}
-	volrequest, err := client.UpdateVolume(volumeID, body)
-	if err != nil {
-		return diag.FromErr(err)
+	if d.HasChanges(helpers.PIVolumeName, helpers.PIVolumeSize, helpers.PIVolumeShareable) {
+		body := &models.UpdateVolume{
+			Name:      &name,
+			Shareable: &shareable,
+			Size:      size,
+		}
+		volrequest, err := client.UpdateVolume(volumeID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForIBMPIVolumeAvailable(ctx, client, *volrequest.VolumeID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
-	_, err = isWaitForIBMPIVolumeAvailable(ctx, client, *volrequest.VolumeID, d.Timeout(schema.TimeoutUpdate))
-	if err != nil {
-		return diag.FromErr(err)
+
+	if d.HasChange(Arg_ReplicationEnabled) {
+		replicationEnabled := d.Get(Arg_ReplicationEnabled).(bool)
+		volActionBody := models.VolumeAction{
+			ReplicationEnabled: &replicationEnabled,
+		}
+		err = client.VolumeAction(volumeID, &volActionBody)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForIBMPIVolumeAvailable(ctx, client, volumeID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
 	}
 
 	return resourceIBMPIVolumeRead(ctx, d, meta)
@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volumes"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+const (
+	volumeCloneStatusCompleted = "completed"
+	volumeCloneStatusFailed    = "failed"
+	volumeCloneStatusRunning   = "running"
+	volumeCloneStatusUnknown   = "unknown"
+)
+
+func ResourceIBMPIVolumeClone() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeCloneCreate,
+		ReadContext:   resourceIBMPIVolumeCloneRead,
+		DeleteContext: resourceIBMPIVolumeCloneDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeCloneName: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "The base name of the newly cloned volume(s)",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeCloneVolumeIDs: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "List of volumes to be cloned",
+			},
+
+			// Attributes
+			Attr_VolumeCloneClonedVolumes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The List of cloned volumes",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_VolumeCloneClonedVolumeID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the newly cloned volume",
+						},
+						Attr_VolumeCloneSourceVolumeID: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The ID of the source volume",
+						},
+					},
+				},
+			},
+			Attr_VolumeCloneFailureReason: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The reason the clone volumes task has failed",
+			},
+			Attr_VolumeClonePercentComplete: {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The completion percentage of the volume clone task",
+			},
+			Attr_VolumeCloneStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the volume clone task",
+			},
+			Attr_VolumeCloneTaskID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the volume clone task",
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeCloneCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	name := d.Get(Arg_VolumeCloneName).(string)
+	body := &models.VolumesCloneAsyncRequest{
+		Name:      &name,
+		VolumeIDs: flex.ExpandStringList(d.Get(Arg_VolumeCloneVolumeIDs).(*schema.Set).List()),
+	}
+
+	client := st.NewIBMPICloneVolumeClient(ctx, sess, cloudInstanceID)
+	cloneTask, err := client.Create(body)
+	if err != nil {
+		log.Printf("[DEBUG] create volume clone failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *cloneTask.CloneTaskID))
+
+	_, err = isWaitForIBMPIVolumeCloneCompletion(ctx, client, *cloneTask.CloneTaskID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIVolumeCloneRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeCloneRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, taskID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPICloneVolumeClient(ctx, sess, cloudInstanceID)
+	cloneTask, err := client.Get(taskID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_volumes.PcloudV2VolumesClonetasksGetNotFound:
+			log.Printf("[DEBUG] volume clone task does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Attr_VolumeCloneTaskID, taskID)
+	d.Set(Attr_VolumeCloneStatus, cloneTask.Status)
+	d.Set(Attr_VolumeCloneFailureReason, cloneTask.FailedReason)
+	d.Set(Attr_VolumeClonePercentComplete, cloneTask.PercentComplete)
+	d.Set(Attr_VolumeCloneClonedVolumes, flattenClonedVolumes(cloneTask.ClonedVolumes))
+
+	return nil
+}
+
+// Cloned volumes are independent volumes; removing the resource only drops it from state
+func resourceIBMPIVolumeCloneDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func isWaitForIBMPIVolumeCloneCompletion(ctx context.Context, client *st.IBMPICloneVolumeClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume clone (%s) to be completed.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending: []string{volumeCloneStatusRunning, volumeCloneStatusUnknown},
+		Target:  []string{volumeCloneStatusCompleted},
+		Refresh: func() (interface{}, string, error) {
+			cloneTask, err := client.Get(id)
+			if err != nil {
+				log.Printf("[DEBUG] get volume clone task failed %v", err)
+				return nil, "", err
+			}
+			if cloneTask == nil || cloneTask.Status == nil {
+				log.Printf("[DEBUG] get volume clone task failed with empty response")
+				return nil, "", fmt.Errorf("failed to get volume clone task status for task id %s", id)
+			}
+			if *cloneTask.Status == volumeCloneStatusFailed {
+				log.Printf("[DEBUG] volume clone task failed with reason: %v", cloneTask.FailedReason)
+				return cloneTask, volumeCloneStatusFailed, fmt.Errorf("volume clone task %s failed with reason: %v", id, cloneTask.FailedReason)
+			}
+			return cloneTask, *cloneTask.Status, nil
+		},
+		Delay:      10 * time.Second,
+		MinTimeout: 10 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func flattenClonedVolumes(list []*models.ClonedVolume) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+	for _, data := range list {
+		l := map[string]interface{}{
+			Attr_VolumeCloneClonedVolumeID: data.ClonedVolumeID,
+			Attr_VolumeCloneSourceVolumeID: data.SourceVolumeID,
+		}
+		result = append(result, l)
+	}
+	return result
+}
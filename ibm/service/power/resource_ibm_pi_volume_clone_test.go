@@ -0,0 +1,88 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func TestAccIBMPIVolumeClonebasic(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-clone-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeCloneConfig(name),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeCloneExists("ibm_pi_volume_clone.power_volume_clone"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_clone.power_volume_clone", "status", "completed"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_clone.power_volume_clone", "percent_complete", "100"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume_clone.power_volume_clone", "cloned_volumes.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeCloneExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+
+		cloudInstanceID, taskID, err := splitID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := st.NewIBMPICloneVolumeClient(context.Background(), sess, cloudInstanceID)
+
+		_, err = client.Get(taskID)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPIVolumeCloneConfig(name string) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_volume" "power_volume" {
+		pi_volume_size       = 2
+		pi_volume_name       = "%[2]s"
+		pi_volume_type       = "tier3"
+		pi_cloud_instance_id = "%[1]s"
+	}
+
+	resource "ibm_pi_volume_clone" "power_volume_clone" {
+		pi_cloud_instance_id = "%[1]s"
+		pi_volume_clone_name = "%[2]s-clone"
+		pi_volume_ids        = [ibm_pi_volume.power_volume.volume_id]
+	}
+	`, acc.Pi_cloud_instance_id, name)
+}
@@ -0,0 +1,361 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volume_groups"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+)
+
+func ResourceIBMPIVolumeGroup() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeGroupCreate,
+		ReadContext:   resourceIBMPIVolumeGroupRead,
+		UpdateContext: resourceIBMPIVolumeGroupUpdate,
+		DeleteContext: resourceIBMPIVolumeGroupDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(10 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeGroupVolumeIDs: {
+				Type:        schema.TypeSet,
+				Required:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+				Description: "List of volume IDs to add in the volume group",
+			},
+
+			// Optional Arguments
+			Arg_VolumeGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{Arg_VolumeGroupName, Arg_VolumeGroupConsistencyGroupName},
+				Description:  "Volume Group Name to create",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeGroupConsistencyGroupName: {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				ExactlyOneOf: []string{Arg_VolumeGroupName, Arg_VolumeGroupConsistencyGroupName},
+				Description:  "The name of consistency group at storage controller level",
+				ValidateFunc: validation.NoZeroValues,
+			},
+
+			// Attributes
+			Attr_VolumeGroupID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume Group ID",
+			},
+			Attr_VolumeGroupStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume Group Status",
+			},
+			Attr_VolumeGroupReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume Group Replication Status",
+			},
+			Attr_VolumeGroupStatusDescriptionErrors: {
+				Type:        schema.TypeSet,
+				Computed:    true,
+				Description: "The status details of the volume group",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_VolumeGroupStatusErrorKey: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The volume group error key",
+						},
+						Attr_VolumeGroupStatusErrorMessage: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The failure message providing more details about the error key",
+						},
+						Attr_VolumeGroupStatusErrorVolumeIDs: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "List of volume IDs, which failed to be added/removed to/from the volume group, with the given error",
+						},
+					},
+				},
+			},
+			Attr_VolumeGroupConsistencyGroupName: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Consistency Group Name if volume is a part of volume group",
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	body := &models.VolumeGroupCreate{
+		VolumeIDs: flex.ExpandStringList(d.Get(Arg_VolumeGroupVolumeIDs).(*schema.Set).List()),
+	}
+	if v, ok := d.GetOk(Arg_VolumeGroupName); ok {
+		body.Name = v.(string)
+	}
+	if v, ok := d.GetOk(Arg_VolumeGroupConsistencyGroupName); ok {
+		body.ConsistencyGroupName = v.(string)
+	}
+
+	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := client.CreateVolumeGroup(body)
+	if err != nil {
+		log.Printf("[DEBUG] create volume group failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, *vg.ID))
+
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, *vg.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIVolumeGroupRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := client.GetDetails(vgID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_volume_groups.PcloudVolumegroupsGetDetailsNotFound:
+			log.Printf("[DEBUG] volume group does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] get volume group failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Attr_VolumeGroupID, vg.ID)
+	// Both names are computed, the service generates the one that is not given
+	d.Set(Arg_VolumeGroupName, vg.Name)
+	d.Set(Arg_VolumeGroupConsistencyGroupName, vg.ConsistencyGroupName)
+	d.Set(Arg_VolumeGroupVolumeIDs, vg.VolumeIDs)
+	d.Set(Attr_VolumeGroupStatus, vg.Status)
+	d.Set(Attr_VolumeGroupReplicationStatus, vg.ReplicationStatus)
+	d.Set(Attr_VolumeGroupConsistencyGroupName, vg.ConsistencyGroupName)
+	if vg.StatusDescription != nil {
+		d.Set(Attr_VolumeGroupStatusDescriptionErrors, flattenVolumeGroupStatusDescription(vg.StatusDescription.Errors))
+	}
+
+	return nil
+}
+
+func resourceIBMPIVolumeGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	if d.HasChange(Arg_VolumeGroupVolumeIDs) {
+		oldRaw, newRaw := d.GetChange(Arg_VolumeGroupVolumeIDs)
+		oldSet := oldRaw.(*schema.Set)
+		newSet := newRaw.(*schema.Set)
+
+		body := &models.VolumeGroupUpdate{
+			AddVolumes:    flex.ExpandStringList(newSet.Difference(oldSet).List()),
+			RemoveVolumes: flex.ExpandStringList(oldSet.Difference(newSet).List()),
+		}
+		err = client.UpdateVolumeGroup(vgID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIBMPIVolumeGroupRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+
+	// A volume group can only be deleted once all of its member volumes are removed
+	volids := flex.ExpandStringList(d.Get(Arg_VolumeGroupVolumeIDs).(*schema.Set).List())
+	if len(volids) > 0 {
+		body := &models.VolumeGroupUpdate{
+			RemoveVolumes: volids,
+		}
+		err = client.UpdateVolumeGroup(vgID, body)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutDelete))
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	err = client.DeleteVolumeGroup(vgID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_volume_groups.PcloudVolumegroupsDeleteNotFound:
+			log.Printf("[DEBUG] volume group does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] delete volume group failed %v", err)
+		return diag.FromErr(err)
+	}
+	_, err = isWaitForIBMPIVolumeGroupDeleted(ctx, client, vgID, d.Timeout(schema.TimeoutDelete))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId("")
+	return nil
+}
+
+func isWaitForIBMPIVolumeGroupAvailable(ctx context.Context, client *st.IBMPIVolumeGroupClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Group (%s) to be available.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"retry", "pending", "updating"},
+		Target:     []string{"available"},
+		Refresh:    isIBMPIVolumeGroupRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupRefreshFunc(client *st.IBMPIVolumeGroupClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vg, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch strings.ToLower(vg.Status) {
+		case "available":
+			return vg, "available", nil
+		case "error":
+			return vg, vg.Status, fmt.Errorf("[ERROR] volume group (%s) is in error state", id)
+		}
+
+		return vg, "updating", nil
+	}
+}
+
+func isWaitForIBMPIVolumeGroupDeleted(ctx context.Context, client *st.IBMPIVolumeGroupClient, id string, timeout time.Duration) (interface{}, error) {
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"deleting", "updating"},
+		Target:     []string{"deleted"},
+		Refresh:    isIBMPIVolumeGroupDeleteRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeGroupDeleteRefreshFunc(client *st.IBMPIVolumeGroupClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		vg, err := client.Get(id)
+		if err != nil {
+			uErr := errors.Unwrap(err)
+			switch uErr.(type) {
+			case *p_cloud_volume_groups.PcloudVolumegroupsGetNotFound:
+				return vg, "deleted", nil
+			}
+			return nil, "", err
+		}
+		if vg == nil {
+			return vg, "deleted", nil
+		}
+		return vg, "deleting", nil
+	}
+}
+
+func flattenVolumeGroupStatusDescription(list []*models.StatusDescriptionError) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+	for _, data := range list {
+		l := map[string]interface{}{
+			Attr_VolumeGroupStatusErrorKey:       data.Key,
+			Attr_VolumeGroupStatusErrorMessage:   data.Message,
+			Attr_VolumeGroupStatusErrorVolumeIDs: data.VolIDs,
+		}
+		result = append(result, l)
+	}
+	return result
+}
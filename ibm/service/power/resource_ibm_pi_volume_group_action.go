@@ -0,0 +1,225 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+)
+
+func ResourceIBMPIVolumeGroupAction() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeGroupActionCreate,
+		ReadContext:   resourceIBMPIVolumeGroupActionRead,
+		DeleteContext: resourceIBMPIVolumeGroupActionDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeGroupID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Volume Group ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_VolumeGroupAction: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				MinItems:    1,
+				Description: "Performs an action (start/stop/reset) on a volume group (one at a time)",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_VolumeGroupActionStart: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Description: "Performs start action on a volume group",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_VolumeGroupActionSource: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{"master", "aux"}),
+										Description:  "Indicates the source of the action `master` or `aux`",
+									},
+								},
+							},
+						},
+						Attr_VolumeGroupActionStop: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Description: "Performs stop action on a volume group",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_VolumeGroupActionAccess: {
+										Type:        schema.TypeBool,
+										Required:    true,
+										ForceNew:    true,
+										Description: "Indicates the access mode of aux volumes",
+									},
+								},
+							},
+						},
+						Attr_VolumeGroupActionReset: {
+							Type:        schema.TypeList,
+							Optional:    true,
+							ForceNew:    true,
+							MaxItems:    1,
+							Description: "Performs reset action on the volume group to update its value",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Attr_VolumeGroupActionStatus: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										ValidateFunc: validate.ValidateAllowedStringValues([]string{"available"}),
+										Description:  "New status to be set for a volume group",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// Attributes
+			Attr_VolumeGroupStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume Group Status",
+			},
+			Attr_VolumeGroupReplicationStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Volume Group Replication Status",
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeGroupActionCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	vgID := d.Get(Arg_VolumeGroupID).(string)
+
+	body, err := expandVolumeGroupAction(d.Get(Arg_VolumeGroupAction).([]interface{}))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	_, err = client.VolumeGroupAction(vgID, body)
+	if err != nil {
+		log.Printf("[DEBUG] volume group action failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, vgID))
+
+	_, err = isWaitForIBMPIVolumeGroupAvailable(ctx, client, vgID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIVolumeGroupActionRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeGroupActionRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, vgID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPIVolumeGroupClient(ctx, sess, cloudInstanceID)
+	vg, err := client.Get(vgID)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set(Attr_VolumeGroupStatus, vg.Status)
+	d.Set(Attr_VolumeGroupReplicationStatus, vg.ReplicationStatus)
+
+	return nil
+}
+
+// Volume group actions cannot be undone; removing the resource only drops it from state
+func resourceIBMPIVolumeGroupActionDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func expandVolumeGroupAction(data []interface{}) (*models.VolumeGroupAction, error) {
+	if len(data) == 0 || data[0] == nil {
+		return nil, fmt.Errorf("[ERROR] one of start, stop or reset must be specified in %s", Arg_VolumeGroupAction)
+	}
+	action := data[0].(map[string]interface{})
+	body := &models.VolumeGroupAction{}
+	count := 0
+
+	if v, ok := action[Attr_VolumeGroupActionStart].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		source := v[0].(map[string]interface{})[Attr_VolumeGroupActionSource].(string)
+		body.Start = &models.VolumeGroupActionStart{
+			Source: &source,
+		}
+		count++
+	}
+	if v, ok := action[Attr_VolumeGroupActionStop].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		access := v[0].(map[string]interface{})[Attr_VolumeGroupActionAccess].(bool)
+		body.Stop = &models.VolumeGroupActionStop{
+			Access: &access,
+		}
+		count++
+	}
+	if v, ok := action[Attr_VolumeGroupActionReset].([]interface{}); ok && len(v) > 0 && v[0] != nil {
+		status := v[0].(map[string]interface{})[Attr_VolumeGroupActionStatus].(string)
+		body.Reset = &models.VolumeGroupActionReset{
+			Status: &status,
+		}
+		count++
+	}
+
+	if count != 1 {
+		return nil, fmt.Errorf("[ERROR] exactly one of start, stop or reset must be specified in %s", Arg_VolumeGroupAction)
+	}
+	return body, nil
+}
@@ -0,0 +1,300 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	"github.com/IBM-Cloud/power-go-client/errors"
+	"github.com/IBM-Cloud/power-go-client/power/client/p_cloud_volume_onboarding"
+	"github.com/IBM-Cloud/power-go-client/power/models"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func ResourceIBMPIVolumeOnboarding() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIBMPIVolumeOnboardingCreate,
+		ReadContext:   resourceIBMPIVolumeOnboardingRead,
+		DeleteContext: resourceIBMPIVolumeOnboardingDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(15 * time.Minute),
+			Delete: schema.DefaultTimeout(5 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+
+			// Required Arguments
+			Arg_CloudInstanceID: {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "PI cloud instance ID",
+				ValidateFunc: validation.NoZeroValues,
+			},
+			Arg_OnboardingVolumes: {
+				Type:        schema.TypeList,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "List of auxiliary volumes to onboard, grouped by source site",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Arg_OnboardingSourceCRN: {
+							Type:         schema.TypeString,
+							Required:     true,
+							ForceNew:     true,
+							Description:  "CRN of the source service broker instance from where the auxiliary volumes need to be onboarded",
+							ValidateFunc: validation.NoZeroValues,
+						},
+						Arg_OnboardingAuxiliaryVolumes: {
+							Type:        schema.TypeList,
+							Required:    true,
+							ForceNew:    true,
+							MinItems:    1,
+							Description: "List auxiliary volumes",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									Arg_OnboardingAuxiliaryVolumeName: {
+										Type:         schema.TypeString,
+										Required:     true,
+										ForceNew:     true,
+										Description:  "Auxiliary volume name at storage host level",
+										ValidateFunc: validation.NoZeroValues,
+									},
+									Arg_OnboardingDisplayName: {
+										Type:        schema.TypeString,
+										Optional:    true,
+										ForceNew:    true,
+										Description: "Display name of auxVolumeName once onboarded, auxVolumeName will be set to display name if not provided",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+
+			// Optional Arguments
+			Arg_OnboardingDescription: {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "Description of the volume onboarding operation",
+			},
+
+			// Attributes
+			Attr_OnboardingID: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The volume onboarding ID",
+			},
+			Attr_OnboardingCreateTime: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The create time of volume onboarding operation",
+			},
+			Attr_OnboardingInputVolumes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of volumes requested to be onboarded",
+			},
+			Attr_OnboardingProgress: {
+				Type:        schema.TypeFloat,
+				Computed:    true,
+				Description: "The progress of volume onboarding operation",
+			},
+			Attr_OnboardedVolumes: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "List of volumes which are onboarded successfully",
+			},
+			Attr_OnboardingFailures: {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The volume onboarding failure details",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						Attr_OnboardingFailureMessage: {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The failure reason for the volumes which have failed to be onboarded",
+						},
+						Attr_OnboardingFailureVolumes: {
+							Type:        schema.TypeList,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "List of volumes which have failed to be onboarded",
+						},
+					},
+				},
+			},
+			Attr_OnboardingStatus: {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of volume onboarding operation",
+			},
+		},
+	}
+}
+
+func resourceIBMPIVolumeOnboardingCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID := d.Get(Arg_CloudInstanceID).(string)
+	body := &models.VolumeOnboardingCreate{
+		Volumes: expandOnboardingVolumes(d.Get(Arg_OnboardingVolumes).([]interface{})),
+	}
+	if v, ok := d.GetOk(Arg_OnboardingDescription); ok {
+		body.Description = v.(string)
+	}
+
+	client := st.NewIBMPIVolumeOnboardingClient(ctx, sess, cloudInstanceID)
+	onboarding, err := client.CreateVolumeOnboarding(body)
+	if err != nil {
+		log.Printf("[DEBUG] create volume onboarding failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.SetId(fmt.Sprintf("%s/%s", cloudInstanceID, onboarding.ID))
+
+	_, err = isWaitForIBMPIVolumeOnboardingSucceeded(ctx, client, onboarding.ID, d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceIBMPIVolumeOnboardingRead(ctx, d, meta)
+}
+
+func resourceIBMPIVolumeOnboardingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	sess, err := meta.(conns.ClientSession).IBMPISession()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	cloudInstanceID, onboardingID, err := splitID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	client := st.NewIBMPIVolumeOnboardingClient(ctx, sess, cloudInstanceID)
+	onboarding, err := client.Get(onboardingID)
+	if err != nil {
+		uErr := errors.Unwrap(err)
+		switch uErr.(type) {
+		case *p_cloud_volume_onboarding.PcloudVolumeOnboardingGetNotFound:
+			log.Printf("[DEBUG] volume onboarding does not exist %v", err)
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] get volume onboarding failed %v", err)
+		return diag.FromErr(err)
+	}
+
+	d.Set(Arg_CloudInstanceID, cloudInstanceID)
+	d.Set(Arg_OnboardingDescription, onboarding.Description)
+	d.Set(Attr_OnboardingID, onboarding.ID)
+	d.Set(Attr_OnboardingCreateTime, onboarding.CreationTimestamp.String())
+	d.Set(Attr_OnboardingInputVolumes, onboarding.InputVolumes)
+	d.Set(Attr_OnboardingProgress, onboarding.Progress)
+	d.Set(Attr_OnboardingStatus, onboarding.Status)
+	if onboarding.Results != nil {
+		d.Set(Attr_OnboardedVolumes, onboarding.Results.OnboardedVolumes)
+		d.Set(Attr_OnboardingFailures, flattenVolumeOnboardingFailures(onboarding.Results.VolumeOnboardingFailures))
+	}
+
+	return nil
+}
+
+// Onboarded volumes are managed through ibm_pi_volume; removing the resource only drops it from state
+func resourceIBMPIVolumeOnboardingDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
+
+func isWaitForIBMPIVolumeOnboardingSucceeded(ctx context.Context, client *st.IBMPIVolumeOnboardingClient, id string, timeout time.Duration) (interface{}, error) {
+	log.Printf("Waiting for Volume Onboarding (%s) to be completed.", id)
+
+	stateConf := &resource.StateChangeConf{
+		Pending:    []string{"in-progress"},
+		Target:     []string{"success"},
+		Refresh:    isIBMPIVolumeOnboardingRefreshFunc(client, id),
+		Delay:      10 * time.Second,
+		MinTimeout: 30 * time.Second,
+		Timeout:    timeout,
+	}
+
+	return stateConf.WaitForStateContext(ctx)
+}
+
+func isIBMPIVolumeOnboardingRefreshFunc(client *st.IBMPIVolumeOnboardingClient, id string) resource.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		onboarding, err := client.Get(id)
+		if err != nil {
+			return nil, "", err
+		}
+
+		switch strings.ToLower(onboarding.Status) {
+		case "success":
+			return onboarding, "success", nil
+		case "failed", "partial-success":
+			return onboarding, onboarding.Status, fmt.Errorf("[ERROR] volume onboarding (%s) finished with status %s", id, onboarding.Status)
+		}
+
+		return onboarding, "in-progress", nil
+	}
+}
+
+func expandOnboardingVolumes(data []interface{}) []*models.AuxiliaryVolumesForOnboarding {
+	volumes := make([]*models.AuxiliaryVolumesForOnboarding, 0, len(data))
+	for _, v := range data {
+		vol := v.(map[string]interface{})
+		sourceCRN := vol[Arg_OnboardingSourceCRN].(string)
+
+		auxVolumes := make([]*models.AuxiliaryVolumeForOnboarding, 0)
+		for _, a := range vol[Arg_OnboardingAuxiliaryVolumes].([]interface{}) {
+			aux := a.(map[string]interface{})
+			auxVolumeName := aux[Arg_OnboardingAuxiliaryVolumeName].(string)
+			auxVolumes = append(auxVolumes, &models.AuxiliaryVolumeForOnboarding{
+				AuxVolumeName: &auxVolumeName,
+				Name:          aux[Arg_OnboardingDisplayName].(string),
+			})
+		}
+
+		volumes = append(volumes, &models.AuxiliaryVolumesForOnboarding{
+			SourceCRN:        &sourceCRN,
+			AuxiliaryVolumes: auxVolumes,
+		})
+	}
+	return volumes
+}
+
+func flattenVolumeOnboardingFailures(list []*models.VolumeOnboardingFailure) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(list))
+	for _, data := range list {
+		l := map[string]interface{}{
+			Attr_OnboardingFailureMessage: data.FailureMessage,
+			Attr_OnboardingFailureVolumes: data.Volumes,
+		}
+		result = append(result, l)
+	}
+	return result
+}
@@ -0,0 +1,82 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package power_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	st "github.com/IBM-Cloud/power-go-client/clients/instance"
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+)
+
+func TestAccIBMPIVolumeOnboardingbasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeOnboardingConfig(),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeOnboardingExists("ibm_pi_volume_onboarding.power_volume_onboarding"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume_onboarding.power_volume_onboarding", "onboarding_id"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume_onboarding.power_volume_onboarding", "status"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeOnboardingExists(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return errors.New("No Record ID is set")
+		}
+
+		sess, err := acc.TestAccProvider.Meta().(conns.ClientSession).IBMPISession()
+		if err != nil {
+			return err
+		}
+
+		cloudInstanceID, onboardingID, err := splitID(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		client := st.NewIBMPIVolumeOnboardingClient(context.Background(), sess, cloudInstanceID)
+
+		_, err = client.Get(onboardingID)
+		if err != nil {
+			return err
+		}
+		return nil
+	}
+}
+
+func testAccCheckIBMPIVolumeOnboardingConfig() string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_volume_onboarding" "power_volume_onboarding" {
+		pi_cloud_instance_id = "%s"
+		pi_description       = "tf-pi-volume-onboarding"
+		pi_onboarding_volumes {
+			pi_source_crn = "%s"
+			pi_auxiliary_volumes {
+				pi_auxiliary_volume_name = "%s"
+			}
+		}
+	}
+	`, acc.Pi_cloud_instance_id, acc.Pi_replication_source_crn, acc.Pi_auxiliary_volume_name)
+}
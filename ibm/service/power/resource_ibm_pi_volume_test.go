@@ -160,3 +160,48 @@ func testAccCheckIBMPIVolumePoolConfig(name string) string {
 	  }
 	`, name, acc.Pi_cloud_instance_id)
 }
+
+func TestAccIBMPIVolumeGRS(t *testing.T) {
+	name := fmt.Sprintf("tf-pi-volume-%d", acctest.RandIntRange(10, 100))
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIBMPIVolumeDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckIBMPIVolumeGRSConfig(name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeExists("ibm_pi_volume.power_volume"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume.power_volume", "pi_volume_name", name),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume.power_volume", "pi_replication_enabled", "true"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume.power_volume", "auxiliary_volume_name"),
+					resource.TestCheckResourceAttrSet(
+						"ibm_pi_volume.power_volume", "primary_role"),
+				),
+			},
+			{
+				Config: testAccCheckIBMPIVolumeGRSConfig(name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckIBMPIVolumeExists("ibm_pi_volume.power_volume"),
+					resource.TestCheckResourceAttr(
+						"ibm_pi_volume.power_volume", "pi_replication_enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIBMPIVolumeGRSConfig(name string, replicationEnabled bool) string {
+	return fmt.Sprintf(`
+	resource "ibm_pi_volume" "power_volume"{
+		pi_volume_size         = 20
+		pi_volume_name         = "%[1]s"
+		pi_volume_type         = "tier1"
+		pi_replication_enabled = %[3]t
+		pi_cloud_instance_id   = "%[2]s"
+	  }
+	`, name, acc.Pi_cloud_instance_id, replicationEnabled)
+}
@@ -196,13 +196,9 @@ func dataSourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}
 		d.Set("ingress_secret", *instance.Ingress.SecretName)
 	}
 
-	getSatHostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
-		Controller: &location,
-	}
-
-	hostList, response, err := satClient.GetSatelliteHosts(getSatHostOptions)
+	hostList, err := getSatelliteLocationHosts(satClient, location)
 	if err != nil {
-		return fmt.Errorf("[ERROR] Error retrieving location hosts %s : %s\n%s", location, err, response)
+		return err
 	}
 	if hostList != nil {
 		d.Set("hosts", flex.FlattenSatelliteHosts(hostList))
@@ -217,3 +213,16 @@ func dataSourceIBMSatelliteLocationRead(d *schema.ResourceData, meta interface{}
 
 	return nil
 }
+
+// getSatelliteLocationHosts lists the hosts attached to a Satellite location
+func getSatelliteLocationHosts(satClient *kubernetesserviceapiv1.KubernetesServiceApiV1, location string) ([]kubernetesserviceapiv1.MultishiftQueueNode, error) {
+	getSatHostOptions := &kubernetesserviceapiv1.GetSatelliteHostsOptions{
+		Controller: &location,
+	}
+
+	hostList, response, err := satClient.GetSatelliteHosts(getSatHostOptions)
+	if err != nil {
+		return nil, fmt.Errorf("[ERROR] Error retrieving location hosts %s : %s\n%s", location, err, response)
+	}
+	return hostList, nil
+}
@@ -0,0 +1,518 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite
+
+import (
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+)
+
+const (
+	satelliteHostAssignmentControlPlane = "control_plane"
+	satelliteHostAssignmentWorkerPool   = "worker_pool"
+	satelliteHostAssignmentUnassigned   = "unassigned"
+
+	// Hosts assigned to the location control plane report this cluster name
+	satelliteControlPlaneClusterName = "infrastructure"
+
+	// Hosts that are not labeled with a zone yet are reported under this zone
+	satelliteHostZoneUnassigned = "unassigned"
+
+	// Labels that Satellite sets automatically when a host is attached
+	satelliteHostLabelCPU    = "cpu"
+	satelliteHostLabelMemory = "memory"
+)
+
+// Satellite flavors encode vCPU and memory in GB, for example upi.4x16
+var satelliteFlavorSizeRegexp = regexp.MustCompile(`(\d+)x(\d+)`)
+
+func DataSourceIBMSatelliteLocationCapacity() *schema.Resource {
+	return &schema.Resource{
+		Read: dataSourceIBMSatelliteLocationCapacityRead,
+
+		Schema: map[string]*schema.Schema{
+			"location": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name or ID of the Satellite location",
+			},
+			"worker_pool_flavor": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The flavor of a planned worker pool, for example upi.4x16. When set, each zone reports whether it can absorb the worker pool",
+			},
+			"worker_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "The number of workers per zone of the planned worker pool",
+			},
+			"host_labels": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         flex.ResourceIBMVPCHash,
+				Description: "Labels that the hosts of the planned worker pool must match, in the format key:value",
+			},
+			"host_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of hosts that are attached to the Satellite location",
+			},
+			"available_host_count": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of hosts that are not assigned to the control plane or a cluster",
+			},
+			"insufficient_zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The zones that cannot absorb the planned worker pool",
+			},
+			"zones": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Host capacity aggregated by zone",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the zone",
+						},
+						"host_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of hosts in the zone",
+						},
+						"available_host_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of unassigned hosts in the zone",
+						},
+						"control_plane_host_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of hosts in the zone that are assigned to the location control plane",
+						},
+						"worker_host_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of hosts in the zone that are assigned to cluster worker pools",
+						},
+						"cpu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total vCPU of the hosts in the zone",
+						},
+						"memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total memory in GB of the hosts in the zone",
+						},
+						"available_cpu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total vCPU of the unassigned hosts in the zone",
+						},
+						"available_memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The total memory in GB of the unassigned hosts in the zone",
+						},
+						"eligible_host_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of unassigned hosts in the zone that match the planned worker pool flavor and host labels",
+						},
+						"can_absorb_worker_pool": {
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Whether the zone has enough eligible hosts for the planned worker pool",
+						},
+					},
+				},
+			},
+			"host_groups": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Hosts aggregated by zone, labels and size",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the zone",
+						},
+						"cpu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The vCPU of each host in the group",
+						},
+						"memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The memory in GB of each host in the group",
+						},
+						"host_labels": {
+							Type:        schema.TypeMap,
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "The labels shared by the hosts in the group",
+						},
+						"host_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of hosts in the group",
+						},
+						"available_host_count": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The number of unassigned hosts in the group",
+						},
+					},
+				},
+			},
+			"hosts": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The hosts of the location and what they are assigned to",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the host",
+						},
+						"host_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the host",
+						},
+						"zone": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The name of the zone",
+						},
+						"cpu": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The vCPU of the host",
+						},
+						"memory": {
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The memory in GB of the host",
+						},
+						"state": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "State of the host",
+						},
+						"assignment": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "What the host is assigned to: control_plane, worker_pool or unassigned",
+						},
+						"cluster_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The cluster that the host is assigned to",
+						},
+						"worker_pool_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The worker pool that the host is assigned to",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// SatelliteHostCapacity is the sizing and assignment of a single host
+type SatelliteHostCapacity struct {
+	ID             string
+	Name           string
+	Zone           string
+	State          string
+	CPU            int
+	Memory         int
+	Labels         map[string]string
+	Assignment     string
+	ClusterName    string
+	WorkerPoolName string
+}
+
+func dataSourceIBMSatelliteLocationCapacityRead(d *schema.ResourceData, meta interface{}) error {
+	location := d.Get("location").(string)
+
+	satClient, err := meta.(conns.ClientSession).SatelliteClientSession()
+	if err != nil {
+		return err
+	}
+
+	getSatLocOptions := &kubernetesserviceapiv1.GetSatelliteLocationOptions{
+		Controller: &location,
+	}
+	instance, response, err := satClient.GetSatelliteLocation(getSatLocOptions)
+	if err != nil || instance == nil {
+		return fmt.Errorf("[ERROR] Error retrieving IBM cloud satellite location %s : %s\n%s", location, err, response)
+	}
+
+	// The location argument may be a name, the control plane cluster is keyed by ID
+	locationID := location
+	if instance.ID != nil {
+		locationID = *instance.ID
+	}
+
+	hostList, err := getSatelliteLocationHosts(satClient, location)
+	if err != nil {
+		return err
+	}
+
+	flavor := d.Get("worker_pool_flavor").(string)
+	flavorCPU, flavorMemory := 0, 0
+	if flavor != "" {
+		flavorCPU, flavorMemory, err = ParseSatelliteFlavorSize(flavor)
+		if err != nil {
+			return err
+		}
+	}
+	workerCount := d.Get("worker_count").(int)
+	requiredLabels := map[string]string{}
+	if v, ok := d.GetOk("host_labels"); ok {
+		requiredLabels = flex.FlattenHostLabels(v.(*schema.Set).List())
+	}
+
+	hosts := make([]SatelliteHostCapacity, 0, len(hostList))
+	for _, host := range hostList {
+		hosts = append(hosts, ExpandSatelliteHostCapacity(host, locationID))
+	}
+	sort.Slice(hosts, func(i, j int) bool {
+		if hosts[i].Zone != hosts[j].Zone {
+			return hosts[i].Zone < hosts[j].Zone
+		}
+		return hosts[i].Name < hosts[j].Name
+	})
+
+	// Zones without any host are the ones least able to absorb a worker pool
+	zoneNames := []string{}
+	zones := map[string]map[string]interface{}{}
+	for _, name := range instance.WorkerZones {
+		if _, ok := zones[name]; !ok {
+			zones[name] = newSatelliteZoneCapacity(name)
+			zoneNames = append(zoneNames, name)
+		}
+	}
+	groupKeys := []string{}
+	groups := map[string]map[string]interface{}{}
+	hostsList := make([]map[string]interface{}, 0, len(hosts))
+	available := 0
+
+	for _, host := range hosts {
+		isAvailable := host.Assignment == satelliteHostAssignmentUnassigned
+		if isAvailable {
+			available++
+		}
+
+		zone, ok := zones[host.Zone]
+		if !ok {
+			zone = newSatelliteZoneCapacity(host.Zone)
+			zones[host.Zone] = zone
+			zoneNames = append(zoneNames, host.Zone)
+		}
+		zone["host_count"] = zone["host_count"].(int) + 1
+		zone["cpu"] = zone["cpu"].(int) + host.CPU
+		zone["memory"] = zone["memory"].(int) + host.Memory
+		switch host.Assignment {
+		case satelliteHostAssignmentControlPlane:
+			zone["control_plane_host_count"] = zone["control_plane_host_count"].(int) + 1
+		case satelliteHostAssignmentWorkerPool:
+			zone["worker_host_count"] = zone["worker_host_count"].(int) + 1
+		default:
+			zone["available_host_count"] = zone["available_host_count"].(int) + 1
+			zone["available_cpu"] = zone["available_cpu"].(int) + host.CPU
+			zone["available_memory"] = zone["available_memory"].(int) + host.Memory
+			if host.CPU >= flavorCPU && host.Memory >= flavorMemory && SatelliteHostLabelsMatch(host.Labels, requiredLabels) {
+				zone["eligible_host_count"] = zone["eligible_host_count"].(int) + 1
+			}
+		}
+
+		groupKey := fmt.Sprintf("%s|%d|%d|%s", host.Zone, host.CPU, host.Memory, satelliteHostLabelsKey(host.Labels))
+		group, ok := groups[groupKey]
+		if !ok {
+			group = map[string]interface{}{
+				"zone":                 host.Zone,
+				"cpu":                  host.CPU,
+				"memory":               host.Memory,
+				"host_labels":          host.Labels,
+				"host_count":           0,
+				"available_host_count": 0,
+			}
+			groups[groupKey] = group
+			groupKeys = append(groupKeys, groupKey)
+		}
+		group["host_count"] = group["host_count"].(int) + 1
+		if isAvailable {
+			group["available_host_count"] = group["available_host_count"].(int) + 1
+		}
+
+		hostsList = append(hostsList, map[string]interface{}{
+			"host_id":          host.ID,
+			"host_name":        host.Name,
+			"zone":             host.Zone,
+			"cpu":              host.CPU,
+			"memory":           host.Memory,
+			"state":            host.State,
+			"assignment":       host.Assignment,
+			"cluster_name":     host.ClusterName,
+			"worker_pool_name": host.WorkerPoolName,
+		})
+	}
+
+	zonesList := make([]map[string]interface{}, 0, len(zoneNames))
+	insufficientZones := []string{}
+	for _, name := range zoneNames {
+		zone := zones[name]
+		// Without a planned worker pool there is nothing to absorb, and hosts
+		// without a zone can not be used by a zoned worker pool
+		if flavor != "" && name != satelliteHostZoneUnassigned {
+			canAbsorb := zone["eligible_host_count"].(int) >= workerCount
+			zone["can_absorb_worker_pool"] = canAbsorb
+			if !canAbsorb {
+				insufficientZones = append(insufficientZones, name)
+			}
+		}
+		zonesList = append(zonesList, zone)
+	}
+	groupsList := make([]map[string]interface{}, 0, len(groupKeys))
+	for _, key := range groupKeys {
+		groupsList = append(groupsList, groups[key])
+	}
+
+	d.SetId(location)
+	d.Set("location", location)
+	d.Set("host_count", len(hosts))
+	d.Set("available_host_count", available)
+	d.Set("insufficient_zones", insufficientZones)
+	d.Set("zones", zonesList)
+	d.Set("host_groups", groupsList)
+	d.Set("hosts", hostsList)
+
+	return nil
+}
+
+func newSatelliteZoneCapacity(name string) map[string]interface{} {
+	return map[string]interface{}{
+		"zone":                     name,
+		"host_count":               0,
+		"available_host_count":     0,
+		"control_plane_host_count": 0,
+		"worker_host_count":        0,
+		"cpu":                      0,
+		"memory":                   0,
+		"available_cpu":            0,
+		"available_memory":         0,
+		"eligible_host_count":      0,
+		"can_absorb_worker_pool":   false,
+	}
+}
+
+func ExpandSatelliteHostCapacity(host kubernetesserviceapiv1.MultishiftQueueNode, locationID string) SatelliteHostCapacity {
+	h := SatelliteHostCapacity{
+		Labels:     map[string]string{},
+		Assignment: satelliteHostAssignmentUnassigned,
+	}
+	if host.ID != nil {
+		h.ID = *host.ID
+	}
+	if host.Name != nil {
+		h.Name = *host.Name
+	}
+	if host.State != nil {
+		h.State = *host.State
+	}
+	for k, v := range host.Labels {
+		h.Labels[k] = v
+	}
+	h.CPU, _ = strconv.Atoi(host.Labels[satelliteHostLabelCPU])
+	if memory, err := strconv.ParseFloat(host.Labels[satelliteHostLabelMemory], 64); err == nil {
+		// The memory label is reported in KB
+		h.Memory = int(math.Round(memory / (1024 * 1024)))
+	}
+	h.Zone = host.Labels["zone"]
+
+	if a := host.Assignment; a != nil {
+		if a.Zone != nil && *a.Zone != "" {
+			h.Zone = *a.Zone
+		}
+		if a.ClusterName != nil {
+			h.ClusterName = *a.ClusterName
+		}
+		if a.WorkerPoolName != nil {
+			h.WorkerPoolName = *a.WorkerPoolName
+		}
+		clusterID := ""
+		if a.ClusterID != nil {
+			clusterID = *a.ClusterID
+		}
+		switch {
+		case h.ClusterName == satelliteControlPlaneClusterName || (clusterID != "" && clusterID == locationID):
+			h.Assignment = satelliteHostAssignmentControlPlane
+		case clusterID != "" || h.ClusterName != "":
+			h.Assignment = satelliteHostAssignmentWorkerPool
+		}
+	}
+	if h.Zone == "" {
+		h.Zone = satelliteHostZoneUnassigned
+	}
+
+	return h
+}
+
+func ParseSatelliteFlavorSize(flavor string) (int, int, error) {
+	match := satelliteFlavorSizeRegexp.FindStringSubmatch(flavor)
+	if match == nil {
+		return 0, 0, fmt.Errorf("[ERROR] Unable to determine the vCPU and memory of flavor %s, expected a flavor such as upi.4x16", flavor)
+	}
+	cpu, _ := strconv.Atoi(match[1])
+	memory, _ := strconv.Atoi(match[2])
+	return cpu, memory, nil
+}
+
+func SatelliteHostLabelsMatch(labels, required map[string]string) bool {
+	for k, v := range required {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+func satelliteHostLabelsKey(labels map[string]string) string {
+	keys := make([]string, 0, len(labels))
+	for k := range labels {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	pairs := make([]string, 0, len(keys))
+	for _, k := range keys {
+		pairs = append(pairs, k+":"+labels[k])
+	}
+	return strings.Join(pairs, ",")
+}
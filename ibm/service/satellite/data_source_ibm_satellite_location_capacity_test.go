@@ -0,0 +1,152 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package satellite_test
+
+import (
+	"fmt"
+	"testing"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/satellite"
+
+	"github.com/IBM-Cloud/container-services-go-sdk/kubernetesserviceapiv1"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+)
+
+func TestAccSatelliteLocationCapacityDataSourceBasic(t *testing.T) {
+	name := fmt.Sprintf("tf-satellitelocation-%d", acctest.RandIntRange(10, 100))
+	managed_from := "wdc04"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCheckSatelliteLocationCapacityDataSource(name, managed_from),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_location_capacity.capacity", "host_count"),
+					resource.TestCheckResourceAttrSet("data.ibm_satellite_location_capacity.capacity", "available_host_count"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSatelliteLocationCapacityDataSource(name, managed_from string) string {
+	return fmt.Sprintf(`
+	resource "ibm_satellite_location" "location" {
+		location      = "%s"
+		managed_from  = "%s"
+		description   = "satellite service"
+		zones         = ["us-east-1", "us-east-2", "us-east-3"]
+	}
+
+	data "ibm_satellite_location_capacity" "capacity" {
+		location           = ibm_satellite_location.location.id
+		worker_pool_flavor = "upi.4x16"
+		worker_count       = 1
+	}`, name, managed_from)
+}
+
+func TestParseSatelliteFlavorSize(t *testing.T) {
+	for _, c := range []struct {
+		flavor      string
+		cpu, memory int
+	}{
+		{"upi.4x16", 4, 16},
+		{"mx2.16x128.2000gb", 16, 128},
+	} {
+		cpu, memory, err := satellite.ParseSatelliteFlavorSize(c.flavor)
+		if err != nil {
+			t.Errorf("ParseSatelliteFlavorSize(%q) returned an error: %s", c.flavor, err)
+			continue
+		}
+		if cpu != c.cpu || memory != c.memory {
+			t.Errorf("ParseSatelliteFlavorSize(%q) = %dx%d, want %dx%d", c.flavor, cpu, memory, c.cpu, c.memory)
+		}
+	}
+
+	if _, _, err := satellite.ParseSatelliteFlavorSize("upi"); err == nil {
+		t.Errorf("expected an error for a flavor without a size")
+	}
+}
+
+func TestExpandSatelliteHostCapacity(t *testing.T) {
+	location := "c1234567890"
+
+	unassigned := satellite.ExpandSatelliteHostCapacity(kubernetesserviceapiv1.MultishiftQueueNode{
+		ID:     core.StringPtr("host-1"),
+		Name:   core.StringPtr("host-1-name"),
+		State:  core.StringPtr("unassigned"),
+		Labels: map[string]string{"cpu": "4", "memory": "16266012", "zone": "zone-1", "env": "prod"},
+	}, location)
+	if unassigned.ID != "host-1" || unassigned.Name != "host-1-name" || unassigned.Zone != "zone-1" {
+		t.Errorf("got host %s (%s) in zone %s", unassigned.ID, unassigned.Name, unassigned.Zone)
+	}
+	if unassigned.CPU != 4 || unassigned.Memory != 16 {
+		t.Errorf("got size %dx%d, want 4x16", unassigned.CPU, unassigned.Memory)
+	}
+	if unassigned.Labels["env"] != "prod" || unassigned.Assignment != "unassigned" {
+		t.Errorf("got env label %q and assignment %q", unassigned.Labels["env"], unassigned.Assignment)
+	}
+
+	controlPlane := satellite.ExpandSatelliteHostCapacity(kubernetesserviceapiv1.MultishiftQueueNode{
+		Labels: map[string]string{"zone": "zone-1"},
+		Assignment: &kubernetesserviceapiv1.Assignment{
+			ClusterID:   core.StringPtr(location),
+			ClusterName: core.StringPtr("infrastructure"),
+			Zone:        core.StringPtr("zone-2"),
+		},
+	}, location)
+	if controlPlane.Zone != "zone-2" || controlPlane.Assignment != "control_plane" {
+		t.Errorf("got assignment %q in zone %q, want control_plane in zone-2", controlPlane.Assignment, controlPlane.Zone)
+	}
+
+	worker := satellite.ExpandSatelliteHostCapacity(kubernetesserviceapiv1.MultishiftQueueNode{
+		Assignment: &kubernetesserviceapiv1.Assignment{
+			ClusterID:      core.StringPtr("cluster-1"),
+			ClusterName:    core.StringPtr("my-cluster"),
+			WorkerPoolName: core.StringPtr("default"),
+		},
+	}, location)
+	if worker.Assignment != "worker_pool" || worker.ClusterName != "my-cluster" || worker.WorkerPoolName != "default" {
+		t.Errorf("got assignment %q to %s/%s", worker.Assignment, worker.ClusterName, worker.WorkerPoolName)
+	}
+	if worker.CPU != 0 {
+		t.Errorf("expected a host without a cpu label to have no cpu, got %d", worker.CPU)
+	}
+	if worker.Zone != "unassigned" {
+		t.Errorf("expected a host without a zone to be unassigned, got %q", worker.Zone)
+	}
+
+	byName := satellite.ExpandSatelliteHostCapacity(kubernetesserviceapiv1.MultishiftQueueNode{
+		Labels: map[string]string{"zone": "zone-1"},
+		Assignment: &kubernetesserviceapiv1.Assignment{
+			ClusterID: core.StringPtr("my-location"),
+		},
+	}, location)
+	if byName.Assignment != "worker_pool" {
+		t.Errorf("expected a cluster matching the location name only to be a worker pool, got %q", byName.Assignment)
+	}
+}
+
+func TestSatelliteHostLabelsMatch(t *testing.T) {
+	labels := map[string]string{"cpu": "4", "env": "prod"}
+
+	for _, c := range []struct {
+		want  map[string]string
+		match bool
+	}{
+		{nil, true},
+		{map[string]string{"env": "prod"}, true},
+		{map[string]string{"env": "dev"}, false},
+		{map[string]string{"os": "RHEL8"}, false},
+	} {
+		if got := satellite.SatelliteHostLabelsMatch(labels, c.want); got != c.match {
+			t.Errorf("SatelliteHostLabelsMatch(%v) = %t, want %t", c.want, got, c.match)
+		}
+	}
+}
@@ -12,9 +12,9 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
-	"github.com/IBM-Cloud/secrets-manager-mt-go-sdk/secretsmanagerv2"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
 func DataSourceIbmSmSecretGroups() *schema.Resource {
@@ -118,11 +118,11 @@ func dataSourceIbmSmSecretGroupsSecretGroupToMap(model *secretsmanagerv2.SecretG
 	if model.Description != nil {
 		modelMap["description"] = *model.Description
 	}
-	if model.CreationDate != nil {
-		modelMap["creation_date"] = model.CreationDate.String()
+	if model.CreatedAt != nil {
+		modelMap["creation_date"] = model.CreatedAt.String()
 	}
-	if model.LastUpdateDate != nil {
-		modelMap["last_update_date"] = model.LastUpdateDate.String()
+	if model.UpdatedAt != nil {
+		modelMap["last_update_date"] = model.UpdatedAt.String()
 	}
 	return modelMap, nil
 }
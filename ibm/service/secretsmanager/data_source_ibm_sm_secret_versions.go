@@ -0,0 +1,157 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func DataSourceIbmSmSecretVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadContext: dataSourceIbmSmSecretVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the secret.",
+			},
+			"versions": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "A collection of secret versions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "A v4 UUID identifier.",
+						},
+						"secret_type": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The type of the secret.",
+						},
+						"created_by": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The unique identifier for the entity that created the secret version.",
+						},
+						"creation_date": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date a resource was created. The date format follows RFC 3339.",
+						},
+						"expiration_date": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The date a secret version is expired. The date format follows RFC 3339.",
+						},
+						"payload_available": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the payload of the secret version is still available.",
+						},
+						"downloaded": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the secret payload of the version has been read.",
+						},
+						"auto_rotated": &schema.Schema{
+							Type:        schema.TypeBool,
+							Computed:    true,
+							Description: "Indicates whether the version was created by an automatic rotation.",
+						},
+					},
+				},
+			},
+			"total_count": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The total number of resources in a collection.",
+			},
+		},
+	}
+}
+
+func dataSourceIbmSmSecretVersionsRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretID := d.Get("secret_id").(string)
+
+	listSecretVersionsOptions := &secretsmanagerv2.ListSecretVersionsOptions{}
+
+	listSecretVersionsOptions.SetSecretID(secretID)
+
+	secretVersionMetadataCollection, response, err := secretsManagerClient.ListSecretVersionsWithContext(context, listSecretVersionsOptions)
+	if err != nil {
+		log.Printf("[DEBUG] ListSecretVersionsWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("ListSecretVersionsWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId(secretID)
+
+	versions := []map[string]interface{}{}
+	for _, modelItem := range secretVersionMetadataCollection.Versions {
+		modelMap, err := dataSourceIbmSmSecretVersionsSecretVersionMetadataToMap(modelItem)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		versions = append(versions, modelMap)
+	}
+	if err = d.Set("versions", versions); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting versions %s", err))
+	}
+
+	if err = d.Set("total_count", flex.IntValue(secretVersionMetadataCollection.TotalCount)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting total_count: %s", err))
+	}
+
+	return nil
+}
+
+func dataSourceIbmSmSecretVersionsSecretVersionMetadataToMap(modelIntf secretsmanagerv2.SecretVersionMetadataIntf) (map[string]interface{}, error) {
+	model, err := SmSecretVersionMetadata(modelIntf)
+	if err != nil {
+		return nil, err
+	}
+	modelMap := make(map[string]interface{})
+	if model.ID != nil {
+		modelMap["id"] = *model.ID
+	}
+	if model.SecretType != nil {
+		modelMap["secret_type"] = *model.SecretType
+	}
+	if model.CreatedBy != nil {
+		modelMap["created_by"] = *model.CreatedBy
+	}
+	if model.CreatedAt != nil {
+		modelMap["creation_date"] = model.CreatedAt.String()
+	}
+	if model.ExpirationDate != nil {
+		modelMap["expiration_date"] = model.ExpirationDate.String()
+	}
+	if model.PayloadAvailable != nil {
+		modelMap["payload_available"] = *model.PayloadAvailable
+	}
+	if model.Downloaded != nil {
+		modelMap["downloaded"] = *model.Downloaded
+	}
+	if model.AutoRotated != nil {
+		modelMap["auto_rotated"] = *model.AutoRotated
+	}
+	return modelMap, nil
+}
@@ -0,0 +1,94 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmSecretVersionsDataSourceBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretVersionsDataSourceConfigBasic(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.ibm_sm_secret_versions.sm_secret_versions", "id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "total_count", "1"),
+					resource.TestCheckResourceAttrSet("data.ibm_sm_secret_versions.sm_secret_versions", "versions.0.id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "versions.0.payload_available", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretVersionsDataSourceConfigBasic() string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			name = "my-versioned-secret"
+			payload = "secret-payload"
+		}
+
+		data "ibm_sm_secret_versions" "sm_secret_versions" {
+			secret_id = ibm_sm_arbitrary_secret.sm_arbitrary_secret.id
+		}
+	`)
+}
+
+func TestSmSecretVersionMetadata(t *testing.T) {
+	createdAt := strfmt.DateTime(time.Date(2023, 1, 2, 3, 4, 5, 0, time.UTC))
+	versions := []secretsmanagerv2.SecretVersionMetadataIntf{
+		&secretsmanagerv2.ArbitrarySecretVersionMetadata{
+			ID:         core.StringPtr("version-1"),
+			SecretType: core.StringPtr("arbitrary"),
+			CreatedAt:  &createdAt,
+		},
+		&secretsmanagerv2.UsernamePasswordSecretVersionMetadata{
+			ID:          core.StringPtr("version-2"),
+			SecretType:  core.StringPtr("username_password"),
+			AutoRotated: core.BoolPtr(true),
+		},
+		&secretsmanagerv2.PublicCertificateVersionMetadata{
+			ID:               core.StringPtr("version-3"),
+			SecretType:       core.StringPtr("public_cert"),
+			PayloadAvailable: core.BoolPtr(false),
+		},
+	}
+
+	version, err := secretsmanager.SmSecretVersionMetadata(versions[0])
+	if err != nil {
+		t.Fatalf("SmSecretVersionMetadata returned an error: %s", err)
+	}
+	if *version.ID != "version-1" || *version.SecretType != "arbitrary" || version.CreatedAt.String() != createdAt.String() {
+		t.Errorf("got version %s of type %s created at %s", *version.ID, *version.SecretType, version.CreatedAt)
+	}
+
+	version, err = secretsmanager.SmSecretVersionMetadata(versions[1])
+	if err != nil {
+		t.Fatalf("SmSecretVersionMetadata returned an error: %s", err)
+	}
+	if *version.ID != "version-2" || !*version.AutoRotated {
+		t.Errorf("got version %s, auto rotated %t, want version-2 auto rotated", *version.ID, *version.AutoRotated)
+	}
+
+	version, err = secretsmanager.SmSecretVersionMetadata(versions[2])
+	if err != nil {
+		t.Fatalf("SmSecretVersionMetadata returned an error: %s", err)
+	}
+	if *version.SecretType != "public_cert" || *version.PayloadAvailable {
+		t.Errorf("got type %s, payload available %t, want public_cert without payload", *version.SecretType, *version.PayloadAvailable)
+	}
+}
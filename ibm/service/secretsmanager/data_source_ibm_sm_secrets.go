@@ -12,8 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
-	"github.com/IBM-Cloud/secrets-manager-mt-go-sdk/secretsmanagerv2"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
 func DataSourceIbmSmSecrets() *schema.Resource {
@@ -117,11 +117,6 @@ func DataSourceIbmSmSecrets() *schema.Resource {
 							Computed:    true,
 							Description: "The date a resource was recently modified. The date format follows RFC 3339.",
 						},
-						"version_id": &schema.Schema{
-							Type:        schema.TypeString,
-							Computed:    true,
-							Description: "A v4 UUID identifier.",
-						},
 						"versions_total": &schema.Schema{
 							Type:        schema.TypeInt,
 							Computed:    true,
@@ -260,7 +255,7 @@ func dataSourceIbmSmSecretsID(d *schema.ResourceData) string {
 	return time.Now().UTC().String()
 }
 
-func dataSourceIbmSmSecretsSecretMetadataPaginatedCollectionFirstToMap(model *secretsmanagerv2.SecretMetadataPaginatedCollectionFirst) (map[string]interface{}, error) {
+func dataSourceIbmSmSecretsSecretMetadataPaginatedCollectionFirstToMap(model *secretsmanagerv2.PaginatedCollectionFirst) (map[string]interface{}, error) {
 	modelMap := make(map[string]interface{})
 	if model.Href != nil {
 		modelMap["href"] = *model.Href
@@ -268,7 +263,7 @@ func dataSourceIbmSmSecretsSecretMetadataPaginatedCollectionFirstToMap(model *se
 	return modelMap, nil
 }
 
-func dataSourceIbmSmSecretsSecretMetadataPaginatedCollectionPreviousToMap(model *secretsmanagerv2.SecretMetadataPaginatedCollectionPrevious) (map[string]interface{}, error) {
+func dataSourceIbmSmSecretsSecretMetadataPaginatedCollectionPreviousToMap(model *secretsmanagerv2.PaginatedCollectionPrevious) (map[string]interface{}, error) {
 	modelMap := make(map[string]interface{})
 	if model.Href != nil {
 		modelMap["href"] = *model.Href
@@ -276,7 +271,7 @@ func dataSourceIbmSmSecretsSecretMetadataPaginatedCollectionPreviousToMap(model
 	return modelMap, nil
 }
 
-func dataSourceIbmSmSecretsSecretMetadataPaginatedCollectionLastToMap(model *secretsmanagerv2.SecretMetadataPaginatedCollectionLast) (map[string]interface{}, error) {
+func dataSourceIbmSmSecretsSecretMetadataPaginatedCollectionLastToMap(model *secretsmanagerv2.PaginatedCollectionLast) (map[string]interface{}, error) {
 	modelMap := make(map[string]interface{})
 	if model.Href != nil {
 		modelMap["href"] = *model.Href
@@ -295,8 +290,8 @@ func dataSourceIbmSmSecretsSecretMetadataToMap(model secretsmanagerv2.SecretMeta
 		if model.ID != nil {
 			modelMap["id"] = *model.ID
 		}
-		if model.Type != nil {
-			modelMap["type"] = *model.Type
+		if model.SecretType != nil {
+			modelMap["type"] = *model.SecretType
 		}
 		if model.Name != nil {
 			modelMap["name"] = *model.Name
@@ -313,14 +308,11 @@ func dataSourceIbmSmSecretsSecretMetadataToMap(model secretsmanagerv2.SecretMeta
 		if model.CreatedBy != nil {
 			modelMap["created_by"] = *model.CreatedBy
 		}
-		if model.CreationDate != nil {
-			modelMap["creation_date"] = model.CreationDate.String()
-		}
-		if model.LastUpdateDate != nil {
-			modelMap["last_update_date"] = model.LastUpdateDate.String()
+		if model.CreatedAt != nil {
+			modelMap["creation_date"] = model.CreatedAt.String()
 		}
-		if model.VersionID != nil {
-			modelMap["version_id"] = *model.VersionID
+		if model.UpdatedAt != nil {
+			modelMap["last_update_date"] = model.UpdatedAt.String()
 		}
 		if model.VersionsTotal != nil {
 			modelMap["versions_total"] = *model.VersionsTotal
@@ -340,8 +332,8 @@ func dataSourceIbmSmSecretsSecretMetadataToMap(model secretsmanagerv2.SecretMeta
 		if model.SerialNumber != nil {
 			modelMap["serial_number"] = *model.SerialNumber
 		}
-		if model.Algorithm != nil {
-			modelMap["algorithm"] = *model.Algorithm
+		if model.SigningAlgorithm != nil {
+			modelMap["algorithm"] = *model.SigningAlgorithm
 		}
 		if model.KeyAlgorithm != nil {
 			modelMap["key_algorithm"] = *model.KeyAlgorithm
@@ -383,13 +375,21 @@ func dataSourceIbmSmSecretsCertificateValidityToMap(model *secretsmanagerv2.Cert
 	return modelMap, nil
 }
 
-func dataSourceIbmSmSecretsPublicCertificateRotationPolicyToMap(model *secretsmanagerv2.PublicCertificateRotationPolicy) (map[string]interface{}, error) {
+func dataSourceIbmSmSecretsPublicCertificateRotationPolicyToMap(model secretsmanagerv2.RotationPolicyIntf) (map[string]interface{}, error) {
+	var autoRotate, rotateKeys *bool
+	if rotation, ok := model.(*secretsmanagerv2.RotationPolicy); ok {
+		autoRotate, rotateKeys = rotation.AutoRotate, rotation.RotateKeys
+	} else if rotation, ok := model.(*secretsmanagerv2.PublicCertificateRotationPolicy); ok {
+		autoRotate, rotateKeys = rotation.AutoRotate, rotation.RotateKeys
+	} else {
+		return nil, fmt.Errorf("Unrecognized secretsmanagerv2.RotationPolicyIntf subtype encountered")
+	}
 	modelMap := make(map[string]interface{})
-	if model.AutoRotate != nil {
-		modelMap["auto_rotate"] = *model.AutoRotate
+	if autoRotate != nil {
+		modelMap["auto_rotate"] = *autoRotate
 	}
-	if model.RotateKeys != nil {
-		modelMap["rotate_keys"] = *model.RotateKeys
+	if rotateKeys != nil {
+		modelMap["rotate_keys"] = *rotateKeys
 	}
 	return modelMap, nil
 }
@@ -399,8 +399,8 @@ func dataSourceIbmSmSecretsPublicCertificateMetadataToMap(model *secretsmanagerv
 	if model.ID != nil {
 		modelMap["id"] = *model.ID
 	}
-	if model.Type != nil {
-		modelMap["type"] = *model.Type
+	if model.SecretType != nil {
+		modelMap["type"] = *model.SecretType
 	}
 	if model.Name != nil {
 		modelMap["name"] = *model.Name
@@ -417,14 +417,11 @@ func dataSourceIbmSmSecretsPublicCertificateMetadataToMap(model *secretsmanagerv
 	if model.CreatedBy != nil {
 		modelMap["created_by"] = *model.CreatedBy
 	}
-	if model.CreationDate != nil {
-		modelMap["creation_date"] = model.CreationDate.String()
+	if model.CreatedAt != nil {
+		modelMap["creation_date"] = model.CreatedAt.String()
 	}
-	if model.LastUpdateDate != nil {
-		modelMap["last_update_date"] = model.LastUpdateDate.String()
-	}
-	if model.VersionID != nil {
-		modelMap["version_id"] = *model.VersionID
+	if model.UpdatedAt != nil {
+		modelMap["last_update_date"] = model.UpdatedAt.String()
 	}
 	if model.VersionsTotal != nil {
 		modelMap["versions_total"] = *model.VersionsTotal
@@ -438,8 +435,8 @@ func dataSourceIbmSmSecretsPublicCertificateMetadataToMap(model *secretsmanagerv
 	if model.SerialNumber != nil {
 		modelMap["serial_number"] = *model.SerialNumber
 	}
-	if model.Algorithm != nil {
-		modelMap["algorithm"] = *model.Algorithm
+	if model.SigningAlgorithm != nil {
+		modelMap["algorithm"] = *model.SigningAlgorithm
 	}
 	if model.KeyAlgorithm != nil {
 		modelMap["key_algorithm"] = *model.KeyAlgorithm
@@ -472,8 +469,8 @@ func dataSourceIbmSmSecretsImportedCertificateMetadataToMap(model *secretsmanage
 	if model.ID != nil {
 		modelMap["id"] = *model.ID
 	}
-	if model.Type != nil {
-		modelMap["type"] = *model.Type
+	if model.SecretType != nil {
+		modelMap["type"] = *model.SecretType
 	}
 	if model.Name != nil {
 		modelMap["name"] = *model.Name
@@ -490,14 +487,11 @@ func dataSourceIbmSmSecretsImportedCertificateMetadataToMap(model *secretsmanage
 	if model.CreatedBy != nil {
 		modelMap["created_by"] = *model.CreatedBy
 	}
-	if model.CreationDate != nil {
-		modelMap["creation_date"] = model.CreationDate.String()
-	}
-	if model.LastUpdateDate != nil {
-		modelMap["last_update_date"] = model.LastUpdateDate.String()
+	if model.CreatedAt != nil {
+		modelMap["creation_date"] = model.CreatedAt.String()
 	}
-	if model.VersionID != nil {
-		modelMap["version_id"] = *model.VersionID
+	if model.UpdatedAt != nil {
+		modelMap["last_update_date"] = model.UpdatedAt.String()
 	}
 	if model.VersionsTotal != nil {
 		modelMap["versions_total"] = *model.VersionsTotal
@@ -517,8 +511,8 @@ func dataSourceIbmSmSecretsImportedCertificateMetadataToMap(model *secretsmanage
 	if model.SerialNumber != nil {
 		modelMap["serial_number"] = *model.SerialNumber
 	}
-	if model.Algorithm != nil {
-		modelMap["algorithm"] = *model.Algorithm
+	if model.SigningAlgorithm != nil {
+		modelMap["algorithm"] = *model.SigningAlgorithm
 	}
 	if model.KeyAlgorithm != nil {
 		modelMap["key_algorithm"] = *model.KeyAlgorithm
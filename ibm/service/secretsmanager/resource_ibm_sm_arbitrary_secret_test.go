@@ -0,0 +1,182 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/service/secretsmanager"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmArbitrarySecretBasic(t *testing.T) {
+	var secretID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmArbitrarySecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmArbitrarySecretConfig("my-secret", "secret-payload"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmArbitrarySecretExists("ibm_sm_arbitrary_secret.sm_arbitrary_secret", &secretID),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "name", "my-secret"),
+				),
+			},
+			resource.TestStep{
+				// Metadata and payload changes are applied in place, so the secret ID is preserved
+				Config: testAccCheckIbmSmArbitrarySecretConfig("my-secret-updated", "secret-payload-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "id", &secretID),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "name", "my-secret-updated"),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "payload", "secret-payload-updated"),
+					resource.TestCheckResourceAttr("ibm_sm_arbitrary_secret.sm_arbitrary_secret", "versions_total", "2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_sm_arbitrary_secret.sm_arbitrary_secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmArbitrarySecretConfig(name string, payload string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_arbitrary_secret" "sm_arbitrary_secret" {
+			name = "%s"
+			description = "Extended description for this secret."
+			labels = [ "my-label" ]
+			payload = "%s"
+		}
+	`, name, payload)
+}
+
+func testAccCheckIbmSmArbitrarySecretExists(n string, secretID *string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+		getSecretOptions.SetID(rs.Primary.ID)
+
+		secretIntf, _, err := secretsManagerClient.GetSecret(getSecretOptions)
+		if err != nil {
+			return err
+		}
+
+		secret := secretIntf.(*secretsmanagerv2.ArbitrarySecret)
+		*secretID = *secret.ID
+		return nil
+	}
+}
+
+func testAccCheckIbmSmArbitrarySecretDestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_arbitrary_secret" {
+			continue
+		}
+
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+		getSecretOptions.SetID(rs.Primary.ID)
+
+		// Try to find the key
+		_, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+
+		if err == nil {
+			return fmt.Errorf("sm_arbitrary_secret still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for sm_arbitrary_secret (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func TestExpandSmSecretMetadataPatch(t *testing.T) {
+	state := &terraform.InstanceState{
+		ID: "secret-id",
+		Attributes: map[string]string{
+			"name":            "my-secret",
+			"payload":         "secret-payload",
+			"labels.#":        "1",
+			"labels.0":        "my-label",
+			"expiration_date": "2030-01-01T00:00:00Z",
+		},
+	}
+	resourceData := func(raw map[string]interface{}) *schema.ResourceData {
+		sm := schema.InternalMap(secretsmanager.ResourceIbmSmArbitrarySecret().Schema)
+		diff, err := sm.Diff(context.Background(), state, terraform.NewResourceConfigRaw(raw), nil, nil, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		d, err := sm.Data(state, diff)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+
+	d := resourceData(map[string]interface{}{
+		"instance_id": "instance-id",
+		"name":        "my-secret",
+		"payload":     "secret-payload",
+	})
+	patch, err := secretsmanager.ExpandSmSecretMetadataPatch(d)
+	if err != nil {
+		t.Fatalf("ExpandSmSecretMetadataPatch returned an error: %s", err)
+	}
+	if labels, ok := patch["labels"].([]string); !ok || len(labels) != 0 {
+		t.Errorf("labels = %#v, want the labels to be cleared", patch["labels"])
+	}
+	if value, ok := patch["expiration_date"]; !ok || value != nil {
+		t.Errorf("expiration_date = %v, want the expiration date to be cleared", value)
+	}
+	if _, ok := patch["name"]; ok {
+		t.Errorf("unchanged name is in the patch")
+	}
+
+	d = resourceData(map[string]interface{}{
+		"instance_id":     "instance-id",
+		"name":            "my-new-secret",
+		"payload":         "secret-payload",
+		"labels":          []interface{}{"my-label"},
+		"expiration_date": "2031-01-01T00:00:00Z",
+	})
+	patch, err = secretsmanager.ExpandSmSecretMetadataPatch(d)
+	if err != nil {
+		t.Fatalf("ExpandSmSecretMetadataPatch returned an error: %s", err)
+	}
+	if patch["name"] != "my-new-secret" || patch["expiration_date"] == nil {
+		t.Errorf("name = %v, expiration_date = %v, want the changed name and expiration date", patch["name"], patch["expiration_date"])
+	}
+	if _, ok := patch["labels"]; ok {
+		t.Errorf("unchanged labels are in the patch")
+	}
+}
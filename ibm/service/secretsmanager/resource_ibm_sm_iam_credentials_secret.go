@@ -0,0 +1,289 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmIAMCredentialsSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmIAMCredentialsSecretCreate,
+		ReadContext:   resourceIbmSmIAMCredentialsSecretRead,
+		UpdateContext: resourceIbmSmIAMCredentialsSecretUpdate,
+		DeleteContext: resourceIbmSmSecretDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-readable name to assign to your secret.To protect your privacy, do not use personal data, such as your name or location, as an name for your secret.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An extended description of your secret.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret group.",
+			},
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "A v4 UUID identifier, or `default` secret group.",
+			},
+			"labels": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Labels that you can use to filter for secrets in your instance.Up to 30 labels can be created.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The time-to-live (TTL) or lease duration to assign to generated credentials, in seconds or with a unit such as `1h` or `30d`.",
+			},
+			"access_groups": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Access Groups that you can use for an `iam_credentials` secret.Up to 10 Access Groups can be used for each secret.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"service_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The service ID under which the API key is created. If omitted, a service ID is created for the secret.",
+			},
+			"reuse_api_key": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Determines whether to use the same service ID and API key for future read operations on an`iam_credentials` secret.",
+			},
+			"api_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The API key that is generated for this secret.",
+			},
+			"api_key_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the API key that is generated for this secret.",
+			},
+			"rotation": smRotationPolicySchema(),
+			"next_rotation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation.The service automatically creates a new version of the secret on its next rotation date. This field exists only for secrets that have an existing rotation policy.",
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Secret type.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for the entity that created the secret.",
+			},
+			"creation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was recently modified. The date format follows RFC 3339.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the current version of the secret.",
+			},
+			"versions_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions the secret has.",
+			},
+			"locks_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of locks the secret has.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmIAMCredentialsSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretPrototypeModel := &secretsmanagerv2.IAMCredentialsSecretPrototype{}
+	secretPrototypeModel.SecretType = core.StringPtr(IAMCredentialsSecretType)
+	secretPrototypeModel.Name = core.StringPtr(d.Get("name").(string))
+	if v, ok := d.GetOk("description"); ok {
+		secretPrototypeModel.Description = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("secret_group_id"); ok {
+		secretPrototypeModel.SecretGroupID = core.StringPtr(v.(string))
+	}
+	secretPrototypeModel.Labels = expandSmSecretLabels(d)
+	secretPrototypeModel.Rotation, err = expandSmRotationPolicy(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretPrototypeModel.TTL = core.StringPtr(d.Get("ttl").(string))
+	if v, ok := d.GetOk("access_groups"); ok {
+		secretPrototypeModel.AccessGroups = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("service_id"); ok {
+		secretPrototypeModel.ServiceID = core.StringPtr(v.(string))
+	}
+	secretPrototypeModel.ReuseApiKey = core.BoolPtr(d.Get("reuse_api_key").(bool))
+
+	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
+	createSecretOptions.SetSecretPrototype(secretPrototypeModel)
+
+	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+	}
+
+	secret, ok := secretIntf.(*secretsmanagerv2.IAMCredentialsSecret)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.SecretIntf subtype encountered"))
+	}
+	d.SetId(*secret.ID)
+
+	return resourceIbmSmIAMCredentialsSecretRead(context, d, meta)
+}
+
+func resourceIbmSmIAMCredentialsSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+	getSecretOptions.SetID(d.Id())
+
+	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+	}
+
+	secret, ok := secretIntf.(*secretsmanagerv2.IAMCredentialsSecret)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.SecretIntf subtype encountered"))
+	}
+
+	if err = d.Set("name", secret.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("description", secret.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
+	}
+	if err = d.Set("secret_group_id", secret.SecretGroupID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_group_id: %s", err))
+	}
+	if secret.Labels != nil {
+		if err = d.Set("labels", secret.Labels); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting labels: %s", err))
+		}
+	}
+	if err = d.Set("ttl", secret.TTL); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ttl: %s", err))
+	}
+	if secret.AccessGroups != nil {
+		if err = d.Set("access_groups", secret.AccessGroups); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting access_groups: %s", err))
+		}
+	}
+	if err = d.Set("service_id", secret.ServiceID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting service_id: %s", err))
+	}
+	if err = d.Set("reuse_api_key", secret.ReuseApiKey); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting reuse_api_key: %s", err))
+	}
+	if err = d.Set("api_key", secret.ApiKey); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting api_key: %s", err))
+	}
+	if err = d.Set("api_key_id", secret.ApiKeyID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting api_key_id: %s", err))
+	}
+	if err = d.Set("rotation", flattenSmRotationPolicy(secret.Rotation)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rotation: %s", err))
+	}
+	if err = d.Set("next_rotation_date", flex.DateTimeToString(secret.NextRotationDate)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting next_rotation_date: %s", err))
+	}
+	if err = d.Set("type", secret.SecretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
+	}
+	if err = d.Set("created_by", secret.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("creation_date", flex.DateTimeToString(secret.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting creation_date: %s", err))
+	}
+	if err = d.Set("last_update_date", flex.DateTimeToString(secret.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting last_update_date: %s", err))
+	}
+	versionID, err := getSmSecretCurrentVersionID(context, d.Id(), secretsManagerClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("version_id", versionID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
+	}
+	if err = d.Set("versions_total", flex.IntValue(secret.VersionsTotal)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting versions_total: %s", err))
+	}
+	if err = d.Set("locks_total", flex.IntValue(secret.LocksTotal)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting locks_total: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmIAMCredentialsSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The API key is generated by the service, so only the metadata, TTL and rotation policy can change in place
+	if d.HasChanges("name", "description", "labels", "ttl", "rotation") {
+		if err = updateSmSecretMetadata(context, d, secretsManagerClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSmIAMCredentialsSecretRead(context, d, meta)
+}
@@ -0,0 +1,121 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmIAMCredentialsSecretBasic(t *testing.T) {
+	var secretID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmIAMCredentialsSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmIAMCredentialsSecretConfig("my-secret", "1h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmIAMCredentialsSecretExists("ibm_sm_iam_credentials_secret.sm_iam_credentials_secret", &secretID),
+					resource.TestCheckResourceAttr("ibm_sm_iam_credentials_secret.sm_iam_credentials_secret", "name", "my-secret"),
+				),
+			},
+			resource.TestStep{
+				// Metadata and TTL changes are applied in place, so the secret ID is preserved
+				Config: testAccCheckIbmSmIAMCredentialsSecretConfig("my-secret-updated", "2h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("ibm_sm_iam_credentials_secret.sm_iam_credentials_secret", "id", &secretID),
+					resource.TestCheckResourceAttr("ibm_sm_iam_credentials_secret.sm_iam_credentials_secret", "name", "my-secret-updated"),
+					resource.TestCheckResourceAttr("ibm_sm_iam_credentials_secret.sm_iam_credentials_secret", "ttl", "2h"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_sm_iam_credentials_secret.sm_iam_credentials_secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmIAMCredentialsSecretConfig(name string, ttl string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_iam_access_group" "sm_access_group" {
+			name = "sm-iam-credentials-access-group"
+		}
+
+		resource "ibm_sm_iam_credentials_secret" "sm_iam_credentials_secret" {
+			name = "%s"
+			description = "Extended description for this secret."
+			labels = [ "my-label" ]
+			ttl = "%s"
+			access_groups = [ ibm_iam_access_group.sm_access_group.id ]
+		}
+	`, name, ttl)
+}
+
+func testAccCheckIbmSmIAMCredentialsSecretExists(n string, secretID *string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+		getSecretOptions.SetID(rs.Primary.ID)
+
+		secretIntf, _, err := secretsManagerClient.GetSecret(getSecretOptions)
+		if err != nil {
+			return err
+		}
+
+		secret := secretIntf.(*secretsmanagerv2.IAMCredentialsSecret)
+		*secretID = *secret.ID
+		return nil
+	}
+}
+
+func testAccCheckIbmSmIAMCredentialsSecretDestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_iam_credentials_secret" {
+			continue
+		}
+
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+		getSecretOptions.SetID(rs.Primary.ID)
+
+		// Try to find the key
+		_, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+
+		if err == nil {
+			return fmt.Errorf("sm_iam_credentials_secret still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for sm_iam_credentials_secret (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmKvSecret() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmKvSecretCreate,
+		ReadContext:   resourceIbmSmKvSecretRead,
+		UpdateContext: resourceIbmSmKvSecretUpdate,
+		DeleteContext: resourceIbmSmSecretDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-readable name to assign to your secret.To protect your privacy, do not use personal data, such as your name or location, as an name for your secret.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An extended description of your secret.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret group.",
+			},
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "A v4 UUID identifier, or `default` secret group.",
+			},
+			"labels": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Labels that you can use to filter for secrets in your instance.Up to 30 labels can be created.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"data": &schema.Schema{
+				Type:        schema.TypeMap,
+				Required:    true,
+				Sensitive:   true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Description: "The key-value pairs of the secret. Changing the data creates a new version of the secret.",
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Secret type.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for the entity that created the secret.",
+			},
+			"creation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was recently modified. The date format follows RFC 3339.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the current version of the secret.",
+			},
+			"versions_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions the secret has.",
+			},
+			"locks_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of locks the secret has.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmKvSecretCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretPrototypeModel := &secretsmanagerv2.KVSecretPrototype{}
+	secretPrototypeModel.SecretType = core.StringPtr(KvSecretType)
+	secretPrototypeModel.Name = core.StringPtr(d.Get("name").(string))
+	if v, ok := d.GetOk("description"); ok {
+		secretPrototypeModel.Description = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("secret_group_id"); ok {
+		secretPrototypeModel.SecretGroupID = core.StringPtr(v.(string))
+	}
+	secretPrototypeModel.Labels = expandSmSecretLabels(d)
+	secretPrototypeModel.Data = d.Get("data").(map[string]interface{})
+
+	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
+	createSecretOptions.SetSecretPrototype(secretPrototypeModel)
+
+	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+	}
+
+	secret, ok := secretIntf.(*secretsmanagerv2.KVSecret)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.SecretIntf subtype encountered"))
+	}
+	d.SetId(*secret.ID)
+
+	return resourceIbmSmKvSecretRead(context, d, meta)
+}
+
+func resourceIbmSmKvSecretRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+	getSecretOptions.SetID(d.Id())
+
+	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+	}
+
+	secret, ok := secretIntf.(*secretsmanagerv2.KVSecret)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.SecretIntf subtype encountered"))
+	}
+
+	if err = d.Set("name", secret.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("description", secret.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
+	}
+	if err = d.Set("secret_group_id", secret.SecretGroupID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_group_id: %s", err))
+	}
+	if secret.Labels != nil {
+		if err = d.Set("labels", secret.Labels); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting labels: %s", err))
+		}
+	}
+	if err = d.Set("data", flattenSmKvSecretData(secret.Data)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting data: %s", err))
+	}
+	if err = d.Set("type", secret.SecretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
+	}
+	if err = d.Set("created_by", secret.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("creation_date", flex.DateTimeToString(secret.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting creation_date: %s", err))
+	}
+	if err = d.Set("last_update_date", flex.DateTimeToString(secret.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting last_update_date: %s", err))
+	}
+	versionID, err := getSmSecretCurrentVersionID(context, d.Id(), secretsManagerClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("version_id", versionID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
+	}
+	if err = d.Set("versions_total", flex.IntValue(secret.VersionsTotal)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting versions_total: %s", err))
+	}
+	if err = d.Set("locks_total", flex.IntValue(secret.LocksTotal)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting locks_total: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmKvSecretUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges(smSecretMetadataFields...) {
+		if err = updateSmSecretMetadata(context, d, secretsManagerClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	if d.HasChange("data") {
+		versionModel := &secretsmanagerv2.KVSecretVersionPrototype{
+			Data: d.Get("data").(map[string]interface{}),
+		}
+		if err = createSmSecretVersion(context, d, secretsManagerClient, versionModel); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSmKvSecretRead(context, d, meta)
+}
+
+// flattenSmKvSecretData renders nested values as JSON so the data fits a map of strings
+func flattenSmKvSecretData(data map[string]interface{}) map[string]interface{} {
+	flattened := make(map[string]interface{}, len(data))
+	for k, v := range data {
+		if str, ok := v.(string); ok {
+			flattened[k] = str
+			continue
+		}
+		b, err := json.Marshal(v)
+		if err != nil {
+			flattened[k] = fmt.Sprintf("%v", v)
+			continue
+		}
+		flattened[k] = string(b)
+	}
+	return flattened
+}
@@ -0,0 +1,119 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmKvSecretBasic(t *testing.T) {
+	var secretID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmKvSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmKvSecretConfig("my-secret", "value-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmKvSecretExists("ibm_sm_kv_secret.sm_kv_secret", &secretID),
+					resource.TestCheckResourceAttr("ibm_sm_kv_secret.sm_kv_secret", "name", "my-secret"),
+				),
+			},
+			resource.TestStep{
+				// Metadata and data changes are applied in place, so the secret ID is preserved
+				Config: testAccCheckIbmSmKvSecretConfig("my-secret-updated", "value-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("ibm_sm_kv_secret.sm_kv_secret", "id", &secretID),
+					resource.TestCheckResourceAttr("ibm_sm_kv_secret.sm_kv_secret", "name", "my-secret-updated"),
+					resource.TestCheckResourceAttr("ibm_sm_kv_secret.sm_kv_secret", "data.key", "value-2"),
+					resource.TestCheckResourceAttr("ibm_sm_kv_secret.sm_kv_secret", "versions_total", "2"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_sm_kv_secret.sm_kv_secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmKvSecretConfig(name string, value string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_kv_secret" "sm_kv_secret" {
+			name = "%s"
+			description = "Extended description for this secret."
+			labels = [ "my-label" ]
+			data = {
+				key = "%s"
+			}
+		}
+	`, name, value)
+}
+
+func testAccCheckIbmSmKvSecretExists(n string, secretID *string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+		getSecretOptions.SetID(rs.Primary.ID)
+
+		secretIntf, _, err := secretsManagerClient.GetSecret(getSecretOptions)
+		if err != nil {
+			return err
+		}
+
+		secret := secretIntf.(*secretsmanagerv2.KVSecret)
+		*secretID = *secret.ID
+		return nil
+	}
+}
+
+func testAccCheckIbmSmKvSecretDestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_kv_secret" {
+			continue
+		}
+
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+		getSecretOptions.SetID(rs.Primary.ID)
+
+		// Try to find the key
+		_, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+
+		if err == nil {
+			return fmt.Errorf("sm_kv_secret still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for sm_kv_secret (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
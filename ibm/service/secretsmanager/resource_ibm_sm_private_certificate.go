@@ -0,0 +1,380 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmPrivateCertificate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmPrivateCertificateCreate,
+		ReadContext:   resourceIbmSmPrivateCertificateRead,
+		UpdateContext: resourceIbmSmPrivateCertificateUpdate,
+		DeleteContext: resourceIbmSmSecretDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A human-readable name to assign to your secret.To protect your privacy, do not use personal data, such as your name or location, as an name for your secret.",
+			},
+			"description": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "An extended description of your secret.To protect your privacy, do not use personal data, such as your name or location, as a description for your secret group.",
+			},
+			"secret_group_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "A v4 UUID identifier, or `default` secret group.",
+			},
+			"labels": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Labels that you can use to filter for secrets in your instance.Up to 30 labels can be created.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"certificate_template": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the certificate template.",
+			},
+			"common_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Common Name (AKA CN) represents the server name protected by the SSL certificate.",
+			},
+			"alt_names": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "With the Subject Alternative Name field, you can specify additional host names to be protected by a single SSL certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ip_sans": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The IP Subject Alternative Names (SANs) to define for the CA certificate, in a comma-delimited list.",
+			},
+			"uri_sans": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The URI Subject Alternative Names (SANs) to define for the CA certificate, in a comma-delimited list.",
+			},
+			"other_sans": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The custom Object Identifier (OID) or UTF8-string Subject Alternative Names (SANs) to define for the CA certificate.The alternative names must match the values that are specified in the `allowed_other_sans` field in the associated certificate template. The format is the same as OpenSSL: `<oid>:<type>:<value>` where the current valid type is `UTF8`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"csr": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The certificate signing request. If you don't include this parameter, the CSR that is used to generate the certificate is created internally.",
+			},
+			"format": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "pem",
+				Description: "The format of the returned data.",
+			},
+			"private_key_format": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "der",
+				Description: "The format of the generated private key.",
+			},
+			"exclude_cn_from_sans": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Controls whether the common name is excluded from Subject Alternative Names (SANs).If the common name set to `true`, it is not included in DNS or Email SANs if they apply. This field can be useful if the common name is a human-readable identifier, instead of a hostname or an email address.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The time-to-live (TTL) to assign to a private certificate.The value can be supplied as a string representation of a duration in hours, for example '12h'. The value can't exceed the `max_ttl` that is defined in the associated certificate template.",
+			},
+			"certificate": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM-encoded contents of your certificate.",
+			},
+			"private_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The PEM-encoded private key to associate with the certificate.",
+			},
+			"issuing_ca": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The PEM-encoded certificate of the certificate authority that signed and issued this certificate.",
+			},
+			"ca_chain": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The chain of Certificate Authority certificates that are associated with the certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"serial_number": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique serial number that was assigned to a certificate by the issuing certificate authority.",
+			},
+			"expiration_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a secret is expired. The date format follows RFC 3339.",
+			},
+			"rotation": smRotationPolicySchema(),
+			"next_rotation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date that the secret is scheduled for automatic rotation.The service automatically creates a new version of the secret on its next rotation date. This field exists only for secrets that have an existing rotation policy.",
+			},
+			"type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Secret type.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for the entity that created the secret.",
+			},
+			"creation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was recently modified. The date format follows RFC 3339.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the current version of the secret.",
+			},
+			"versions_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of versions the secret has.",
+			},
+			"locks_total": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of locks the secret has.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmPrivateCertificateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretPrototypeModel := &secretsmanagerv2.PrivateCertificatePrototype{}
+	secretPrototypeModel.SecretType = core.StringPtr(PrivateCertSecretType)
+	secretPrototypeModel.Name = core.StringPtr(d.Get("name").(string))
+	if v, ok := d.GetOk("description"); ok {
+		secretPrototypeModel.Description = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("secret_group_id"); ok {
+		secretPrototypeModel.SecretGroupID = core.StringPtr(v.(string))
+	}
+	secretPrototypeModel.Labels = expandSmSecretLabels(d)
+	secretPrototypeModel.Rotation, err = expandSmRotationPolicy(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	secretPrototypeModel.CertificateTemplate = core.StringPtr(d.Get("certificate_template").(string))
+	secretPrototypeModel.CommonName = core.StringPtr(d.Get("common_name").(string))
+	if v, ok := d.GetOk("alt_names"); ok {
+		secretPrototypeModel.AltNames = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("ip_sans"); ok {
+		secretPrototypeModel.IpSans = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("uri_sans"); ok {
+		secretPrototypeModel.UriSans = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("other_sans"); ok {
+		secretPrototypeModel.OtherSans = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("csr"); ok {
+		secretPrototypeModel.Csr = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		secretPrototypeModel.TTL = core.StringPtr(v.(string))
+	}
+	secretPrototypeModel.Format = core.StringPtr(d.Get("format").(string))
+	secretPrototypeModel.PrivateKeyFormat = core.StringPtr(d.Get("private_key_format").(string))
+	secretPrototypeModel.ExcludeCnFromSans = core.BoolPtr(d.Get("exclude_cn_from_sans").(bool))
+
+	createSecretOptions := &secretsmanagerv2.CreateSecretOptions{}
+	createSecretOptions.SetSecretPrototype(secretPrototypeModel)
+
+	secretIntf, response, err := secretsManagerClient.CreateSecretWithContext(context, createSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateSecretWithContext failed %s\n%s", err, response))
+	}
+
+	secret, ok := secretIntf.(*secretsmanagerv2.PrivateCertificate)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.SecretIntf subtype encountered"))
+	}
+	d.SetId(*secret.ID)
+
+	return resourceIbmSmPrivateCertificateRead(context, d, meta)
+}
+
+func resourceIbmSmPrivateCertificateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+	getSecretOptions.SetID(d.Id())
+
+	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+	}
+
+	secret, ok := secretIntf.(*secretsmanagerv2.PrivateCertificate)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.SecretIntf subtype encountered"))
+	}
+
+	if err = d.Set("name", secret.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("description", secret.Description); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
+	}
+	if err = d.Set("secret_group_id", secret.SecretGroupID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_group_id: %s", err))
+	}
+	if secret.Labels != nil {
+		if err = d.Set("labels", secret.Labels); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting labels: %s", err))
+		}
+	}
+	if err = d.Set("certificate_template", secret.CertificateTemplate); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting certificate_template: %s", err))
+	}
+	if err = d.Set("common_name", secret.CommonName); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting common_name: %s", err))
+	}
+	if secret.AltNames != nil {
+		if err = d.Set("alt_names", secret.AltNames); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting alt_names: %s", err))
+		}
+	}
+	if err = d.Set("certificate", secret.Certificate); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting certificate: %s", err))
+	}
+	if err = d.Set("private_key", secret.PrivateKey); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting private_key: %s", err))
+	}
+	if err = d.Set("issuing_ca", secret.IssuingCa); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting issuing_ca: %s", err))
+	}
+	if err = d.Set("ca_chain", secret.CaChain); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ca_chain: %s", err))
+	}
+	if err = d.Set("serial_number", secret.SerialNumber); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting serial_number: %s", err))
+	}
+	if err = d.Set("expiration_date", flex.DateTimeToString(secret.ExpirationDate)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting expiration_date: %s", err))
+	}
+	if err = d.Set("rotation", flattenSmRotationPolicy(secret.Rotation)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rotation: %s", err))
+	}
+	if err = d.Set("next_rotation_date", flex.DateTimeToString(secret.NextRotationDate)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting next_rotation_date: %s", err))
+	}
+	if err = d.Set("type", secret.SecretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
+	}
+	if err = d.Set("created_by", secret.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("creation_date", flex.DateTimeToString(secret.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting creation_date: %s", err))
+	}
+	if err = d.Set("last_update_date", flex.DateTimeToString(secret.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting last_update_date: %s", err))
+	}
+	versionID, err := getSmSecretCurrentVersionID(context, d.Id(), secretsManagerClient)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	if err = d.Set("version_id", versionID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
+	}
+	if err = d.Set("versions_total", flex.IntValue(secret.VersionsTotal)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting versions_total: %s", err))
+	}
+	if err = d.Set("locks_total", flex.IntValue(secret.LocksTotal)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting locks_total: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmPrivateCertificateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// The certificate is issued by the service, so only the metadata and rotation policy can change in place
+	if d.HasChanges("name", "description", "labels", "rotation") {
+		if err = updateSmSecretMetadata(context, d, secretsManagerClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSmPrivateCertificateRead(context, d, meta)
+}
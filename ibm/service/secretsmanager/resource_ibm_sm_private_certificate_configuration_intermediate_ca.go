@@ -0,0 +1,585 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmPrivateCertificateConfigurationIntermediateCA() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmPrivateCertificateConfigurationIntermediateCACreate,
+		ReadContext:   resourceIbmSmPrivateCertificateConfigurationIntermediateCARead,
+		UpdateContext: resourceIbmSmPrivateCertificateConfigurationIntermediateCAUpdate,
+		DeleteContext: resourceIbmSmConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A human-readable unique name to assign to your configuration.",
+			},
+			"common_name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Common Name (AKA CN) represents the server name that is protected by the SSL certificate.",
+			},
+			"alt_names": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "With the Subject Alternative Name field, you can specify additional host names to be protected by a single SSL certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ip_sans": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The IP Subject Alternative Names (SANs) to define for the CA certificate, in a comma-delimited list.",
+			},
+			"uri_sans": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The URI Subject Alternative Names (SANs) to define for the CA certificate, in a comma-delimited list.",
+			},
+			"other_sans": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The custom Object Identifier (OID) or UTF8-string Subject Alternative Names (SANs) to define for the CA certificate. The format is the same as OpenSSL: `<oid>:<type>:<value>` where the current valid type is `UTF8`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"signing_method": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{PrivateCertSigningMethodInternal, PrivateCertSigningMethodExternal}),
+				Description:  "The signing method to use with this certificate authority to generate private certificates.You can choose between `internal` or `external` options. If you choose `internal`, the intermediate certificate authority is signed by the certificate authority that is set in `issuer` after it is created.",
+			},
+			"issuer": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The name of the parent certificate authority that signs this intermediate certificate authority. Required when `signing_method` is `internal`.",
+			},
+			"max_ttl": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The maximum time-to-live (TTL) for certificates that are created by this CA. The value can be supplied as a string representation of a duration in hours, for example '8760h'. Changing the value updates the CA in place.",
+			},
+			"max_ttl_seconds": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum time-to-live (TTL) in seconds that the service applies, as derived from `max_ttl`.",
+			},
+			"format": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "pem",
+				Description: "The format of the returned data.",
+			},
+			"private_key_format": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "der",
+				Description: "The format of the generated private key.",
+			},
+			"key_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      "rsa",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"rsa", "ec"}),
+				Description:  "The type of private key to generate.",
+			},
+			"key_bits": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The number of bits to use to generate the private key.Allowable values for RSA keys are: `2048` and `4096`. Allowable values for EC keys are: `224`, `256`, `384`, and `521`. The default for RSA keys is `2048`. The default for EC keys is `256`.",
+			},
+			"exclude_cn_from_sans": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Controls whether the common name is excluded from Subject Alternative Names (SANs).",
+			},
+			"ou": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Organizational Unit (OU) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"organization": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Organization (O) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"country": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Country (C) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"locality": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Locality (L) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"province": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The Province (ST) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"street_address": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The street address values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"postal_code": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The postal code values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"crl_expiry": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The time until the certificate revocation list (CRL) expires.The value can be supplied as a string representation of a duration in hours, such as `48h`. The default is 72 hours.",
+			},
+			"crl_expiry_seconds": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time until the certificate revocation list (CRL) expires, in seconds.",
+			},
+			"crl_disable": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Disables or enables certificate revocation list (CRL) building.If CRL building is disabled, a signed but zero-length CRL is returned when downloading the CRL. If CRL building is enabled, it will rebuild the CRL.",
+			},
+			"crl_distribution_points_encoded": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether to encode the certificate revocation list (CRL) distribution points in the certificates that are issued by this certificate authority.",
+			},
+			"issuing_certificates_urls_encoded": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether to encode the URL of the issuing certificate in the certificates that are issued by this certificate authority.",
+			},
+			"status": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The status of the certificate authority. The status of a root certificate authority is either `configured` or `expired`. For intermediate certificate authorities, possible statuses include `signing_required`,`signed_certificate_required`, `certificate_template_required`, `configured`, `expired` or `revoked`.",
+			},
+			"expiration_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date when the certificate authority expires. The date format follows RFC 3339.",
+			},
+			"data": &schema.Schema{
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The data that is associated with the certificate authority.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"certificate": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The PEM-encoded contents of your certificate.",
+						},
+						"issuing_ca": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The PEM-encoded certificate of the certificate authority that signed and issued this certificate.",
+						},
+						"ca_chain": &schema.Schema{
+							Type:        schema.TypeList,
+							Computed:    true,
+							Description: "The chain of Certificate Authority certificates that are associated with the certificate.",
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+						"csr": &schema.Schema{
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "The certificate signing request of an externally signed intermediate certificate authority.",
+						},
+						"expiration": &schema.Schema{
+							Type:        schema.TypeInt,
+							Computed:    true,
+							Description: "The certificate expiration time, in seconds since the Unix epoch.",
+						},
+					},
+				},
+			},
+			"config_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The configuration type.",
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for the entity that created the configuration.",
+			},
+			"creation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was recently modified. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmPrivateCertificateConfigurationIntermediateCACreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configurationPrototypeModel := &secretsmanagerv2.PrivateCertificateConfigurationIntermediateCAPrototype{}
+	configurationPrototypeModel.ConfigType = core.StringPtr(PrivateCertConfigTypeIntermediateCA)
+	configurationPrototypeModel.Name = core.StringPtr(d.Get("name").(string))
+	configurationPrototypeModel.CommonName = core.StringPtr(d.Get("common_name").(string))
+	if v, ok := d.GetOk("ip_sans"); ok {
+		configurationPrototypeModel.IpSans = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("uri_sans"); ok {
+		configurationPrototypeModel.UriSans = core.StringPtr(v.(string))
+	}
+	configurationPrototypeModel.SigningMethod = core.StringPtr(d.Get("signing_method").(string))
+	if v, ok := d.GetOk("issuer"); ok {
+		configurationPrototypeModel.Issuer = core.StringPtr(v.(string))
+	} else if *configurationPrototypeModel.SigningMethod == PrivateCertSigningMethodInternal {
+		return diag.FromErr(fmt.Errorf("issuer must be set when signing_method is %s", PrivateCertSigningMethodInternal))
+	}
+	configurationPrototypeModel.MaxTTL = core.StringPtr(d.Get("max_ttl").(string))
+	configurationPrototypeModel.Format = core.StringPtr(d.Get("format").(string))
+	configurationPrototypeModel.PrivateKeyFormat = core.StringPtr(d.Get("private_key_format").(string))
+	configurationPrototypeModel.KeyType = core.StringPtr(d.Get("key_type").(string))
+	if v, ok := d.GetOk("key_bits"); ok {
+		configurationPrototypeModel.KeyBits = core.Int64Ptr(int64(v.(int)))
+	}
+	configurationPrototypeModel.ExcludeCnFromSans = core.BoolPtr(d.Get("exclude_cn_from_sans").(bool))
+	if v, ok := d.GetOk("alt_names"); ok {
+		configurationPrototypeModel.AltNames = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("other_sans"); ok {
+		configurationPrototypeModel.OtherSans = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("ou"); ok {
+		configurationPrototypeModel.Ou = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("organization"); ok {
+		configurationPrototypeModel.Organization = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("country"); ok {
+		configurationPrototypeModel.Country = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("locality"); ok {
+		configurationPrototypeModel.Locality = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("province"); ok {
+		configurationPrototypeModel.Province = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("street_address"); ok {
+		configurationPrototypeModel.StreetAddress = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("postal_code"); ok {
+		configurationPrototypeModel.PostalCode = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("crl_expiry"); ok {
+		configurationPrototypeModel.CrlExpiry = core.StringPtr(v.(string))
+	}
+	configurationPrototypeModel.CrlDisable = core.BoolPtr(d.Get("crl_disable").(bool))
+	configurationPrototypeModel.CrlDistributionPointsEncoded = core.BoolPtr(d.Get("crl_distribution_points_encoded").(bool))
+	configurationPrototypeModel.IssuingCertificatesUrlsEncoded = core.BoolPtr(d.Get("issuing_certificates_urls_encoded").(bool))
+
+	createConfigurationOptions := &secretsmanagerv2.CreateConfigurationOptions{}
+	createConfigurationOptions.SetConfigurationPrototype(configurationPrototypeModel)
+
+	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	configuration, ok := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.ConfigurationIntf subtype encountered"))
+	}
+	d.SetId(*configuration.Name)
+
+	// An internally signed intermediate CA is unusable until its parent CA signs it
+	if *configurationPrototypeModel.SigningMethod == PrivateCertSigningMethodInternal {
+		err = signSmIntermediateCA(context, *configurationPrototypeModel.Issuer, d.Id(), secretsManagerClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSmPrivateCertificateConfigurationIntermediateCARead(context, d, meta)
+}
+
+func resourceIbmSmPrivateCertificateConfigurationIntermediateCARead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+	getConfigurationOptions.SetName(d.Id())
+
+	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	configuration, ok := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationIntermediateCA)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.ConfigurationIntf subtype encountered"))
+	}
+
+	if err = d.Set("name", configuration.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("common_name", configuration.CommonName); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting common_name: %s", err))
+	}
+	if configuration.AltNames != nil {
+		if err = d.Set("alt_names", configuration.AltNames); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting alt_names: %s", err))
+		}
+	}
+	if err = d.Set("ip_sans", configuration.IpSans); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ip_sans: %s", err))
+	}
+	if err = d.Set("uri_sans", configuration.UriSans); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting uri_sans: %s", err))
+	}
+	if configuration.OtherSans != nil {
+		if err = d.Set("other_sans", configuration.OtherSans); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting other_sans: %s", err))
+		}
+	}
+	if err = d.Set("signing_method", configuration.SigningMethod); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting signing_method: %s", err))
+	}
+	if err = d.Set("issuer", configuration.Issuer); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting issuer: %s", err))
+	}
+	if err = d.Set("max_ttl_seconds", flex.IntValue(configuration.MaxTtlSeconds)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting max_ttl_seconds: %s", err))
+	}
+	if err = d.Set("format", configuration.Format); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting format: %s", err))
+	}
+	if err = d.Set("private_key_format", configuration.PrivateKeyFormat); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting private_key_format: %s", err))
+	}
+	if err = d.Set("key_type", configuration.KeyType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting key_type: %s", err))
+	}
+	if err = d.Set("key_bits", flex.IntValue(configuration.KeyBits)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting key_bits: %s", err))
+	}
+	if err = d.Set("exclude_cn_from_sans", configuration.ExcludeCnFromSans); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting exclude_cn_from_sans: %s", err))
+	}
+	if configuration.Ou != nil {
+		if err = d.Set("ou", configuration.Ou); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting ou: %s", err))
+		}
+	}
+	if configuration.Organization != nil {
+		if err = d.Set("organization", configuration.Organization); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting organization: %s", err))
+		}
+	}
+	if configuration.Country != nil {
+		if err = d.Set("country", configuration.Country); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting country: %s", err))
+		}
+	}
+	if configuration.Locality != nil {
+		if err = d.Set("locality", configuration.Locality); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting locality: %s", err))
+		}
+	}
+	if configuration.Province != nil {
+		if err = d.Set("province", configuration.Province); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting province: %s", err))
+		}
+	}
+	if configuration.StreetAddress != nil {
+		if err = d.Set("street_address", configuration.StreetAddress); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting street_address: %s", err))
+		}
+	}
+	if configuration.PostalCode != nil {
+		if err = d.Set("postal_code", configuration.PostalCode); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting postal_code: %s", err))
+		}
+	}
+	if err = d.Set("crl_expiry_seconds", flex.IntValue(configuration.CrlExpirySeconds)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crl_expiry_seconds: %s", err))
+	}
+	if err = d.Set("crl_disable", configuration.CrlDisable); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crl_disable: %s", err))
+	}
+	if err = d.Set("crl_distribution_points_encoded", configuration.CrlDistributionPointsEncoded); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting crl_distribution_points_encoded: %s", err))
+	}
+	if err = d.Set("issuing_certificates_urls_encoded", configuration.IssuingCertificatesUrlsEncoded); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting issuing_certificates_urls_encoded: %s", err))
+	}
+	if err = d.Set("status", configuration.Status); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting status: %s", err))
+	}
+	if err = d.Set("config_type", configuration.ConfigType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting config_type: %s", err))
+	}
+	if err = d.Set("secret_type", configuration.SecretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
+	}
+	if err = d.Set("created_by", configuration.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("creation_date", flex.DateTimeToString(configuration.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting creation_date: %s", err))
+	}
+	if err = d.Set("last_update_date", flex.DateTimeToString(configuration.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting last_update_date: %s", err))
+	}
+	if err = d.Set("expiration_date", flex.DateTimeToString(configuration.ExpirationDate)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting expiration_date: %s", err))
+	}
+	if data, ok := configuration.Data.(*secretsmanagerv2.PrivateCertificateCAData); ok && data != nil {
+		if err = d.Set("data", []map[string]interface{}{resourceIbmSmPrivateCertificateConfigurationIntermediateCADataToMap(data)}); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting data: %s", err))
+		}
+	}
+
+	return nil
+}
+
+func resourceIbmSmPrivateCertificateConfigurationIntermediateCAUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges(smCAConfigurationMutableFields...) {
+		patchVals := &secretsmanagerv2.PrivateCertificateConfigurationIntermediateCAPatch{}
+		if d.HasChange("max_ttl") {
+			patchVals.MaxTTL = core.StringPtr(d.Get("max_ttl").(string))
+		}
+		if d.HasChange("crl_expiry") {
+			patchVals.CrlExpiry = core.StringPtr(d.Get("crl_expiry").(string))
+		}
+		if d.HasChange("crl_disable") {
+			patchVals.CrlDisable = core.BoolPtr(d.Get("crl_disable").(bool))
+		}
+		if d.HasChange("crl_distribution_points_encoded") {
+			patchVals.CrlDistributionPointsEncoded = core.BoolPtr(d.Get("crl_distribution_points_encoded").(bool))
+		}
+		if d.HasChange("issuing_certificates_urls_encoded") {
+			patchVals.IssuingCertificatesUrlsEncoded = core.BoolPtr(d.Get("issuing_certificates_urls_encoded").(bool))
+		}
+		if err = updateSmConfiguration(context, d.Id(), patchVals, secretsManagerClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSmPrivateCertificateConfigurationIntermediateCARead(context, d, meta)
+}
+
+func resourceIbmSmPrivateCertificateConfigurationIntermediateCADataToMap(model *secretsmanagerv2.PrivateCertificateCAData) map[string]interface{} {
+	modelMap := make(map[string]interface{})
+	if model.Certificate != nil {
+		modelMap["certificate"] = model.Certificate
+	}
+	if model.IssuingCa != nil {
+		modelMap["issuing_ca"] = model.IssuingCa
+	}
+	if model.CaChain != nil {
+		modelMap["ca_chain"] = model.CaChain
+	}
+	if model.Csr != nil {
+		modelMap["csr"] = model.Csr
+	}
+	if model.Expiration != nil {
+		modelMap["expiration"] = flex.IntValue(model.Expiration)
+	}
+	return modelMap
+}
+
+// signSmIntermediateCA runs the sign_intermediate action of the parent CA against the named intermediate CA
+func signSmIntermediateCA(context context.Context, issuer, intermediate string, secretsManagerClient *secretsmanagerv2.SecretsManagerV2) error {
+	actionPrototypeModel := &secretsmanagerv2.PrivateCertificateConfigurationActionSignIntermediatePrototype{
+		ActionType:                       core.StringPtr(PrivateCertActionSignIntermediate),
+		IntermediateCertificateAuthority: core.StringPtr(intermediate),
+	}
+
+	createConfigurationActionOptions := &secretsmanagerv2.CreateConfigurationActionOptions{}
+	createConfigurationActionOptions.SetName(issuer)
+	createConfigurationActionOptions.SetConfigActionPrototype(actionPrototypeModel)
+
+	_, response, err := secretsManagerClient.CreateConfigurationActionWithContext(context, createConfigurationActionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateConfigurationActionWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("CreateConfigurationActionWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
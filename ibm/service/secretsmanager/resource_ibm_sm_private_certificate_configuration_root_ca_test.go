@@ -0,0 +1,114 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmPrivateCertificateConfigurationRootCABasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmPrivateCertificateConfigurationRootCADestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmPrivateCertificateConfigurationRootCAConfig("87600h", "72h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmPrivateCertificateConfigurationRootCAExists("ibm_sm_private_certificate_configuration_root_ca.sm_private_certificate_configuration_root_ca"),
+					resource.TestCheckResourceAttr("ibm_sm_private_certificate_configuration_root_ca.sm_private_certificate_configuration_root_ca", "status", "configured"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSmPrivateCertificateConfigurationRootCAConfig("43800h", "48h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmPrivateCertificateConfigurationRootCAExists("ibm_sm_private_certificate_configuration_root_ca.sm_private_certificate_configuration_root_ca"),
+					resource.TestCheckResourceAttr("ibm_sm_private_certificate_configuration_root_ca.sm_private_certificate_configuration_root_ca", "max_ttl", "43800h"),
+					resource.TestCheckResourceAttr("ibm_sm_private_certificate_configuration_root_ca.sm_private_certificate_configuration_root_ca", "crl_expiry", "48h"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_sm_private_certificate_configuration_root_ca.sm_private_certificate_configuration_root_ca",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"format", "private_key_format", "max_path_length"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmPrivateCertificateConfigurationRootCAConfig(maxTTL string, crlExpiry string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_private_certificate_configuration_root_ca" "sm_private_certificate_configuration_root_ca" {
+			name = "tf-test-root-ca"
+			common_name = "example.com"
+			max_ttl = "%s"
+			crl_expiry = "%s"
+		}
+	`, maxTTL, crlExpiry)
+}
+
+func testAccCheckIbmSmPrivateCertificateConfigurationRootCAExists(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+		getConfigurationOptions.SetName(rs.Primary.ID)
+
+		configurationIntf, _, err := secretsManagerClient.GetConfiguration(getConfigurationOptions)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationRootCA); !ok {
+			return fmt.Errorf("Unexpected configuration type for %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIbmSmPrivateCertificateConfigurationRootCADestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_private_certificate_configuration_root_ca" {
+			continue
+		}
+
+		getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+		getConfigurationOptions.SetName(rs.Primary.ID)
+
+		// Try to find the key
+		_, response, err := secretsManagerClient.GetConfiguration(getConfigurationOptions)
+
+		if err == nil {
+			return fmt.Errorf("sm_private_certificate_configuration_root_ca still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for sm_private_certificate_configuration_root_ca (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,561 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+// The template settings that can be changed in place
+var smTemplateConfigurationMutableFields = []string{"allowed_secret_groups", "max_ttl", "ttl", "allow_localhost", "allowed_domains", "allow_bare_domains", "allow_subdomains", "allow_glob_domains", "allow_any_name", "enforce_hostnames", "allow_ip_sans", "allowed_uri_sans", "allowed_other_sans", "server_flag", "client_flag", "code_signing_flag", "email_protection_flag", "key_type", "key_bits", "key_usage", "ext_key_usage", "use_csr_common_name", "use_csr_sans", "require_cn", "ou", "organization", "country"}
+
+func ResourceIbmSmPrivateCertificateConfigurationTemplate() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmPrivateCertificateConfigurationTemplateCreate,
+		ReadContext:   resourceIbmSmPrivateCertificateConfigurationTemplateRead,
+		UpdateContext: resourceIbmSmPrivateCertificateConfigurationTemplateUpdate,
+		DeleteContext: resourceIbmSmConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A human-readable unique name to assign to your configuration.",
+			},
+			"certificate_authority": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the intermediate certificate authority.",
+			},
+			"allowed_secret_groups": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Scopes the creation of private certificates to only the secret groups that you specify.This field can be supplied as a comma-delimited list of secret group IDs.",
+			},
+			"max_ttl": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The maximum time-to-live (TTL) for certificates that are created by this template. The value can be supplied as a string representation of a duration in hours, for example `8760h`.",
+			},
+			"max_ttl_seconds": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The maximum time-to-live (TTL) in seconds that the service applies, as derived from `max_ttl`.",
+			},
+			"ttl": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The requested time-to-live (TTL) for certificates that are created by this template. The value can't exceed the `max_ttl` that is defined.",
+			},
+			"ttl_seconds": &schema.Schema{
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The requested time-to-live (TTL) in seconds that the service applies, as derived from `ttl`.",
+			},
+			"allow_localhost": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Determines whether to allow `localhost` to be included as one of the requested common names.",
+			},
+			"allowed_domains": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The domains to define for the certificate template. This property is used along with the `allow_bare_domains` and `allow_subdomains` options.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allow_bare_domains": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether to allow clients to request private certificates that match the value of the actual domains on the final certificate.",
+			},
+			"allow_subdomains": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether to allow clients to request private certificates with common names (CN) that are subdomains of the CNs that are allowed by the other certificate template options. This includes wildcard subdomains.",
+			},
+			"allow_glob_domains": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether to allow glob patterns, for example, `ftp*.example.com`, in the names that are specified in the `allowed_domains` field.",
+			},
+			"allow_any_name": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether to allow clients to request a private certificate that matches any common name.",
+			},
+			"enforce_hostnames": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Determines whether to enforce only valid host names for common names, DNS Subject Alternative Names, and the host section of email addresses.",
+			},
+			"allow_ip_sans": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Determines whether to allow clients to request a private certificate with IP Subject Alternative Names.",
+			},
+			"allowed_uri_sans": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The URI Subject Alternative Names to allow for private certificates.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"allowed_other_sans": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The custom Object Identifier (OID) or UTF8-string Subject Alternative Names (SANs) to allow for private certificates. The format is the same as OpenSSL: `<oid>:<type>:<value>` where the current valid type is `UTF8`.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"server_flag": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Determines whether private certificates are flagged for server use.",
+			},
+			"client_flag": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Determines whether private certificates are flagged for client use.",
+			},
+			"code_signing_flag": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether private certificates are flagged for code signing use.",
+			},
+			"email_protection_flag": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Determines whether private certificates are flagged for email protection use.",
+			},
+			"key_type": &schema.Schema{
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "rsa",
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"rsa", "ec"}),
+				Description:  "The type of private key to generate.",
+			},
+			"key_bits": &schema.Schema{
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Computed:    true,
+				Description: "The number of bits to use to generate the private key.Allowable values for RSA keys are: `2048` and `4096`. Allowable values for EC keys are: `224`, `256`, `384`, and `521`. The default for RSA keys is `2048`. The default for EC keys is `256`.",
+			},
+			"key_usage": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The allowed key usage constraint to define for private certificates.You can find valid values in the [Go x509 package documentation](https://pkg.go.dev/crypto/x509#KeyUsage).  Omit the `KeyUsage` part of the value. Values are not case-sensitive. To specify no key usage constraints, set this field to an empty list.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"ext_key_usage": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The allowed extended key usage constraint on private certificates.You can find valid values in the [Go x509 package documentation](https://golang.org/pkg/crypto/x509/#ExtKeyUsage). Omit the `ExtKeyUsage` part of the value. Values are not case-sensitive. To specify no key usage constraints, set this field to an empty list.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"use_csr_common_name": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When used with the `private_cert_configuration_action_sign_csr` action, this field determines whether to use the common name (CN) from a certificate signing request (CSR) instead of the CN that's included in the data of the certificate.",
+			},
+			"use_csr_sans": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "When used with the `private_cert_configuration_action_sign_csr` action, this field determines whether to use the Subject Alternative Names(SANs) from a certificate signing request (CSR) instead of the SANs that are included in the data of the certificate.",
+			},
+			"require_cn": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Determines whether to require a common name to create a private certificate.",
+			},
+			"ou": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Organizational Unit (OU) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"organization": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Organization (O) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"country": &schema.Schema{
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The Country (C) values to define in the subject field of the resulting certificate.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"config_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The configuration type.",
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for the entity that created the configuration.",
+			},
+			"creation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was recently modified. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmPrivateCertificateConfigurationTemplateCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configurationPrototypeModel := &secretsmanagerv2.PrivateCertificateConfigurationTemplatePrototype{}
+	configurationPrototypeModel.ConfigType = core.StringPtr(PrivateCertConfigTypeTemplate)
+	configurationPrototypeModel.Name = core.StringPtr(d.Get("name").(string))
+	configurationPrototypeModel.CertificateAuthority = core.StringPtr(d.Get("certificate_authority").(string))
+	if v, ok := d.GetOk("allowed_secret_groups"); ok {
+		configurationPrototypeModel.AllowedSecretGroups = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("max_ttl"); ok {
+		configurationPrototypeModel.MaxTTL = core.StringPtr(v.(string))
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		configurationPrototypeModel.TTL = core.StringPtr(v.(string))
+	}
+	configurationPrototypeModel.AllowLocalhost = core.BoolPtr(d.Get("allow_localhost").(bool))
+	if v, ok := d.GetOk("allowed_domains"); ok {
+		configurationPrototypeModel.AllowedDomains = flex.ExpandStringList(v.([]interface{}))
+	}
+	configurationPrototypeModel.AllowBareDomains = core.BoolPtr(d.Get("allow_bare_domains").(bool))
+	configurationPrototypeModel.AllowSubdomains = core.BoolPtr(d.Get("allow_subdomains").(bool))
+	configurationPrototypeModel.AllowGlobDomains = core.BoolPtr(d.Get("allow_glob_domains").(bool))
+	configurationPrototypeModel.AllowAnyName = core.BoolPtr(d.Get("allow_any_name").(bool))
+	configurationPrototypeModel.EnforceHostnames = core.BoolPtr(d.Get("enforce_hostnames").(bool))
+	configurationPrototypeModel.AllowIpSans = core.BoolPtr(d.Get("allow_ip_sans").(bool))
+	if v, ok := d.GetOk("allowed_uri_sans"); ok {
+		configurationPrototypeModel.AllowedUriSans = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("allowed_other_sans"); ok {
+		configurationPrototypeModel.AllowedOtherSans = flex.ExpandStringList(v.([]interface{}))
+	}
+	configurationPrototypeModel.ServerFlag = core.BoolPtr(d.Get("server_flag").(bool))
+	configurationPrototypeModel.ClientFlag = core.BoolPtr(d.Get("client_flag").(bool))
+	configurationPrototypeModel.CodeSigningFlag = core.BoolPtr(d.Get("code_signing_flag").(bool))
+	configurationPrototypeModel.EmailProtectionFlag = core.BoolPtr(d.Get("email_protection_flag").(bool))
+	configurationPrototypeModel.KeyType = core.StringPtr(d.Get("key_type").(string))
+	if v, ok := d.GetOk("key_bits"); ok {
+		configurationPrototypeModel.KeyBits = core.Int64Ptr(int64(v.(int)))
+	}
+	if v, ok := d.GetOk("key_usage"); ok {
+		configurationPrototypeModel.KeyUsage = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("ext_key_usage"); ok {
+		configurationPrototypeModel.ExtKeyUsage = flex.ExpandStringList(v.([]interface{}))
+	}
+	configurationPrototypeModel.UseCsrCommonName = core.BoolPtr(d.Get("use_csr_common_name").(bool))
+	configurationPrototypeModel.UseCsrSans = core.BoolPtr(d.Get("use_csr_sans").(bool))
+	configurationPrototypeModel.RequireCn = core.BoolPtr(d.Get("require_cn").(bool))
+	if v, ok := d.GetOk("ou"); ok {
+		configurationPrototypeModel.Ou = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("organization"); ok {
+		configurationPrototypeModel.Organization = flex.ExpandStringList(v.([]interface{}))
+	}
+	if v, ok := d.GetOk("country"); ok {
+		configurationPrototypeModel.Country = flex.ExpandStringList(v.([]interface{}))
+	}
+
+	createConfigurationOptions := &secretsmanagerv2.CreateConfigurationOptions{}
+	createConfigurationOptions.SetConfigurationPrototype(configurationPrototypeModel)
+
+	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	configuration, ok := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationTemplate)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.ConfigurationIntf subtype encountered"))
+	}
+	d.SetId(*configuration.Name)
+
+	return resourceIbmSmPrivateCertificateConfigurationTemplateRead(context, d, meta)
+}
+
+func resourceIbmSmPrivateCertificateConfigurationTemplateRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+	getConfigurationOptions.SetName(d.Id())
+
+	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	configuration, ok := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationTemplate)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.ConfigurationIntf subtype encountered"))
+	}
+
+	if err = d.Set("name", configuration.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("certificate_authority", configuration.CertificateAuthority); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting certificate_authority: %s", err))
+	}
+	if err = d.Set("allowed_secret_groups", configuration.AllowedSecretGroups); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allowed_secret_groups: %s", err))
+	}
+	if err = d.Set("max_ttl_seconds", flex.IntValue(configuration.MaxTtlSeconds)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting max_ttl_seconds: %s", err))
+	}
+	if err = d.Set("ttl_seconds", flex.IntValue(configuration.TtlSeconds)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ttl_seconds: %s", err))
+	}
+	if err = d.Set("allow_localhost", configuration.AllowLocalhost); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allow_localhost: %s", err))
+	}
+	if err = d.Set("allowed_domains", configuration.AllowedDomains); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allowed_domains: %s", err))
+	}
+	if err = d.Set("allow_bare_domains", configuration.AllowBareDomains); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allow_bare_domains: %s", err))
+	}
+	if err = d.Set("allow_subdomains", configuration.AllowSubdomains); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allow_subdomains: %s", err))
+	}
+	if err = d.Set("allow_glob_domains", configuration.AllowGlobDomains); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allow_glob_domains: %s", err))
+	}
+	if err = d.Set("allow_any_name", configuration.AllowAnyName); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allow_any_name: %s", err))
+	}
+	if err = d.Set("enforce_hostnames", configuration.EnforceHostnames); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting enforce_hostnames: %s", err))
+	}
+	if err = d.Set("allow_ip_sans", configuration.AllowIpSans); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allow_ip_sans: %s", err))
+	}
+	if err = d.Set("allowed_uri_sans", configuration.AllowedUriSans); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allowed_uri_sans: %s", err))
+	}
+	if err = d.Set("allowed_other_sans", configuration.AllowedOtherSans); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting allowed_other_sans: %s", err))
+	}
+	if err = d.Set("server_flag", configuration.ServerFlag); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting server_flag: %s", err))
+	}
+	if err = d.Set("client_flag", configuration.ClientFlag); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting client_flag: %s", err))
+	}
+	if err = d.Set("code_signing_flag", configuration.CodeSigningFlag); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting code_signing_flag: %s", err))
+	}
+	if err = d.Set("email_protection_flag", configuration.EmailProtectionFlag); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting email_protection_flag: %s", err))
+	}
+	if err = d.Set("key_type", configuration.KeyType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting key_type: %s", err))
+	}
+	if err = d.Set("key_bits", flex.IntValue(configuration.KeyBits)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting key_bits: %s", err))
+	}
+	if err = d.Set("key_usage", configuration.KeyUsage); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting key_usage: %s", err))
+	}
+	if err = d.Set("ext_key_usage", configuration.ExtKeyUsage); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ext_key_usage: %s", err))
+	}
+	if err = d.Set("use_csr_common_name", configuration.UseCsrCommonName); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting use_csr_common_name: %s", err))
+	}
+	if err = d.Set("use_csr_sans", configuration.UseCsrSans); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting use_csr_sans: %s", err))
+	}
+	if err = d.Set("require_cn", configuration.RequireCn); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting require_cn: %s", err))
+	}
+	if err = d.Set("ou", configuration.Ou); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting ou: %s", err))
+	}
+	if err = d.Set("organization", configuration.Organization); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting organization: %s", err))
+	}
+	if err = d.Set("country", configuration.Country); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting country: %s", err))
+	}
+	if err = d.Set("config_type", configuration.ConfigType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting config_type: %s", err))
+	}
+	if err = d.Set("secret_type", configuration.SecretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
+	}
+	if err = d.Set("created_by", configuration.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("creation_date", flex.DateTimeToString(configuration.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting creation_date: %s", err))
+	}
+	if err = d.Set("last_update_date", flex.DateTimeToString(configuration.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting last_update_date: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmPrivateCertificateConfigurationTemplateUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges(smTemplateConfigurationMutableFields...) {
+		patchVals := &secretsmanagerv2.PrivateCertificateConfigurationTemplatePatch{}
+		if d.HasChange("allowed_secret_groups") {
+			patchVals.AllowedSecretGroups = core.StringPtr(d.Get("allowed_secret_groups").(string))
+		}
+		if d.HasChange("max_ttl") {
+			patchVals.MaxTTL = core.StringPtr(d.Get("max_ttl").(string))
+		}
+		if d.HasChange("ttl") {
+			patchVals.TTL = core.StringPtr(d.Get("ttl").(string))
+		}
+		if d.HasChange("allow_localhost") {
+			patchVals.AllowLocalhost = core.BoolPtr(d.Get("allow_localhost").(bool))
+		}
+		if d.HasChange("allowed_domains") {
+			patchVals.AllowedDomains = flex.ExpandStringList(d.Get("allowed_domains").([]interface{}))
+		}
+		if d.HasChange("allow_bare_domains") {
+			patchVals.AllowBareDomains = core.BoolPtr(d.Get("allow_bare_domains").(bool))
+		}
+		if d.HasChange("allow_subdomains") {
+			patchVals.AllowSubdomains = core.BoolPtr(d.Get("allow_subdomains").(bool))
+		}
+		if d.HasChange("allow_glob_domains") {
+			patchVals.AllowGlobDomains = core.BoolPtr(d.Get("allow_glob_domains").(bool))
+		}
+		if d.HasChange("allow_any_name") {
+			patchVals.AllowAnyName = core.BoolPtr(d.Get("allow_any_name").(bool))
+		}
+		if d.HasChange("enforce_hostnames") {
+			patchVals.EnforceHostnames = core.BoolPtr(d.Get("enforce_hostnames").(bool))
+		}
+		if d.HasChange("allow_ip_sans") {
+			patchVals.AllowIpSans = core.BoolPtr(d.Get("allow_ip_sans").(bool))
+		}
+		if d.HasChange("allowed_uri_sans") {
+			patchVals.AllowedUriSans = flex.ExpandStringList(d.Get("allowed_uri_sans").([]interface{}))
+		}
+		if d.HasChange("allowed_other_sans") {
+			patchVals.AllowedOtherSans = flex.ExpandStringList(d.Get("allowed_other_sans").([]interface{}))
+		}
+		if d.HasChange("server_flag") {
+			patchVals.ServerFlag = core.BoolPtr(d.Get("server_flag").(bool))
+		}
+		if d.HasChange("client_flag") {
+			patchVals.ClientFlag = core.BoolPtr(d.Get("client_flag").(bool))
+		}
+		if d.HasChange("code_signing_flag") {
+			patchVals.CodeSigningFlag = core.BoolPtr(d.Get("code_signing_flag").(bool))
+		}
+		if d.HasChange("email_protection_flag") {
+			patchVals.EmailProtectionFlag = core.BoolPtr(d.Get("email_protection_flag").(bool))
+		}
+		if d.HasChange("key_type") {
+			patchVals.KeyType = core.StringPtr(d.Get("key_type").(string))
+		}
+		if d.HasChange("key_bits") {
+			patchVals.KeyBits = core.Int64Ptr(int64(d.Get("key_bits").(int)))
+		}
+		if d.HasChange("key_usage") {
+			patchVals.KeyUsage = flex.ExpandStringList(d.Get("key_usage").([]interface{}))
+		}
+		if d.HasChange("ext_key_usage") {
+			patchVals.ExtKeyUsage = flex.ExpandStringList(d.Get("ext_key_usage").([]interface{}))
+		}
+		if d.HasChange("use_csr_common_name") {
+			patchVals.UseCsrCommonName = core.BoolPtr(d.Get("use_csr_common_name").(bool))
+		}
+		if d.HasChange("use_csr_sans") {
+			patchVals.UseCsrSans = core.BoolPtr(d.Get("use_csr_sans").(bool))
+		}
+		if d.HasChange("require_cn") {
+			patchVals.RequireCn = core.BoolPtr(d.Get("require_cn").(bool))
+		}
+		if d.HasChange("ou") {
+			patchVals.Ou = flex.ExpandStringList(d.Get("ou").([]interface{}))
+		}
+		if d.HasChange("organization") {
+			patchVals.Organization = flex.ExpandStringList(d.Get("organization").([]interface{}))
+		}
+		if d.HasChange("country") {
+			patchVals.Country = flex.ExpandStringList(d.Get("country").([]interface{}))
+		}
+		if err = updateSmConfiguration(context, d.Id(), patchVals, secretsManagerClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSmPrivateCertificateConfigurationTemplateRead(context, d, meta)
+}
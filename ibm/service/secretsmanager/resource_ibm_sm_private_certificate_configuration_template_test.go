@@ -0,0 +1,129 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmPrivateCertificateConfigurationTemplateBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmPrivateCertificateConfigurationTemplateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmPrivateCertificateConfigurationTemplateConfig("720h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmPrivateCertificateConfigurationTemplateExists("ibm_sm_private_certificate_configuration_template.sm_private_certificate_configuration_template"),
+					resource.TestCheckResourceAttr("ibm_sm_private_certificate_configuration_template.sm_private_certificate_configuration_template", "ttl", "720h"),
+				),
+			},
+			resource.TestStep{
+				Config: testAccCheckIbmSmPrivateCertificateConfigurationTemplateConfig("360h"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmPrivateCertificateConfigurationTemplateExists("ibm_sm_private_certificate_configuration_template.sm_private_certificate_configuration_template"),
+					resource.TestCheckResourceAttr("ibm_sm_private_certificate_configuration_template.sm_private_certificate_configuration_template", "ttl", "360h"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_sm_private_certificate_configuration_template.sm_private_certificate_configuration_template",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmPrivateCertificateConfigurationTemplateConfig(ttl string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_private_certificate_configuration_root_ca" "sm_private_certificate_configuration_root_ca" {
+			name = "tf-test-root-ca"
+			common_name = "example.com"
+			max_ttl = "87600h"
+		}
+
+		resource "ibm_sm_private_certificate_configuration_intermediate_ca" "sm_private_certificate_configuration_intermediate_ca" {
+			name = "tf-test-intermediate-ca"
+			common_name = "example.com"
+			max_ttl = "43800h"
+			signing_method = "internal"
+			issuer = ibm_sm_private_certificate_configuration_root_ca.sm_private_certificate_configuration_root_ca.name
+			crl_disable = false
+		}
+
+		resource "ibm_sm_private_certificate_configuration_template" "sm_private_certificate_configuration_template" {
+			name = "tf-test-template"
+			certificate_authority = ibm_sm_private_certificate_configuration_intermediate_ca.sm_private_certificate_configuration_intermediate_ca.name
+			allowed_domains = [ "example.com" ]
+			allow_subdomains = true
+			max_ttl = "8760h"
+			ttl = "%s"
+		}
+	`, ttl)
+}
+
+func testAccCheckIbmSmPrivateCertificateConfigurationTemplateExists(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+		getConfigurationOptions.SetName(rs.Primary.ID)
+
+		configurationIntf, _, err := secretsManagerClient.GetConfiguration(getConfigurationOptions)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := configurationIntf.(*secretsmanagerv2.PrivateCertificateConfigurationTemplate); !ok {
+			return fmt.Errorf("Unexpected configuration type for %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIbmSmPrivateCertificateConfigurationTemplateDestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_private_certificate_configuration_template" {
+			continue
+		}
+
+		getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+		getConfigurationOptions.SetName(rs.Primary.ID)
+
+		// Try to find the key
+		_, response, err := secretsManagerClient.GetConfiguration(getConfigurationOptions)
+
+		if err == nil {
+			return fmt.Errorf("sm_private_certificate_configuration_template still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for sm_private_certificate_configuration_template (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,118 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmPrivateCertificateBasic(t *testing.T) {
+	var secretID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmPrivateCertificateDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmPrivateCertificateConfig("my-secret"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmPrivateCertificateExists("ibm_sm_private_certificate.sm_private_certificate", &secretID),
+					resource.TestCheckResourceAttr("ibm_sm_private_certificate.sm_private_certificate", "name", "my-secret"),
+				),
+			},
+			resource.TestStep{
+				// Metadata changes are applied in place, so the secret ID is preserved
+				Config: testAccCheckIbmSmPrivateCertificateConfig("my-secret-updated"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("ibm_sm_private_certificate.sm_private_certificate", "id", &secretID),
+					resource.TestCheckResourceAttr("ibm_sm_private_certificate.sm_private_certificate", "name", "my-secret-updated"),
+					resource.TestCheckResourceAttrSet("ibm_sm_private_certificate.sm_private_certificate", "certificate"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_sm_private_certificate.sm_private_certificate",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"format", "private_key_format", "exclude_cn_from_sans"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmPrivateCertificateConfig(name string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_private_certificate" "sm_private_certificate" {
+			name = "%s"
+			description = "Extended description for this secret."
+			labels = [ "my-label" ]
+			certificate_template = "%s"
+			common_name = "example.com"
+		}
+	`, name, acc.SecretsManagerPrivateCertificateTemplate)
+}
+
+func testAccCheckIbmSmPrivateCertificateExists(n string, secretID *string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+		getSecretOptions.SetID(rs.Primary.ID)
+
+		secretIntf, _, err := secretsManagerClient.GetSecret(getSecretOptions)
+		if err != nil {
+			return err
+		}
+
+		secret := secretIntf.(*secretsmanagerv2.PrivateCertificate)
+		*secretID = *secret.ID
+		return nil
+	}
+}
+
+func testAccCheckIbmSmPrivateCertificateDestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_private_certificate" {
+			continue
+		}
+
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+		getSecretOptions.SetID(rs.Primary.ID)
+
+		// Try to find the key
+		_, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+
+		if err == nil {
+			return fmt.Errorf("sm_private_certificate still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for sm_private_certificate (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,197 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmPublicCertificateConfigurationCALetsEncrypt() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmPublicCertificateConfigurationCALetsEncryptCreate,
+		ReadContext:   resourceIbmSmPublicCertificateConfigurationCALetsEncryptRead,
+		UpdateContext: resourceIbmSmPublicCertificateConfigurationCALetsEncryptUpdate,
+		DeleteContext: resourceIbmSmConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A human-readable unique name to assign to your configuration.",
+			},
+			"lets_encrypt_environment": &schema.Schema{
+				Type:         schema.TypeString,
+				Required:     true,
+				ValidateFunc: validate.ValidateAllowedStringValues([]string{"production", "staging"}),
+				Description:  "The configuration of the Let's Encrypt CA environment.",
+			},
+			"lets_encrypt_private_key": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "The PEM encoded private key of your Lets Encrypt account.",
+			},
+			"lets_encrypt_preferred_chain": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Prefer the chain with an issuer matching this Subject Common Name.",
+			},
+			"config_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The configuration type.",
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for the entity that created the configuration.",
+			},
+			"creation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was recently modified. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmPublicCertificateConfigurationCALetsEncryptCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configurationPrototypeModel := &secretsmanagerv2.PublicCertificateConfigurationCALetsEncryptPrototype{}
+	configurationPrototypeModel.ConfigType = core.StringPtr(PublicCertConfigTypeCALetsEncrypt)
+	configurationPrototypeModel.Name = core.StringPtr(d.Get("name").(string))
+	configurationPrototypeModel.LetsEncryptEnvironment = core.StringPtr(d.Get("lets_encrypt_environment").(string))
+	configurationPrototypeModel.LetsEncryptPrivateKey = core.StringPtr(d.Get("lets_encrypt_private_key").(string))
+	if v, ok := d.GetOk("lets_encrypt_preferred_chain"); ok {
+		configurationPrototypeModel.LetsEncryptPreferredChain = core.StringPtr(v.(string))
+	}
+
+	createConfigurationOptions := &secretsmanagerv2.CreateConfigurationOptions{}
+	createConfigurationOptions.SetConfigurationPrototype(configurationPrototypeModel)
+
+	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	configuration, ok := configurationIntf.(*secretsmanagerv2.PublicCertificateConfigurationCALetsEncrypt)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.ConfigurationIntf subtype encountered"))
+	}
+	d.SetId(*configuration.Name)
+
+	return resourceIbmSmPublicCertificateConfigurationCALetsEncryptRead(context, d, meta)
+}
+
+func resourceIbmSmPublicCertificateConfigurationCALetsEncryptRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+	getConfigurationOptions.SetName(d.Id())
+
+	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	configuration, ok := configurationIntf.(*secretsmanagerv2.PublicCertificateConfigurationCALetsEncrypt)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.ConfigurationIntf subtype encountered"))
+	}
+
+	if err = d.Set("name", configuration.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("lets_encrypt_environment", configuration.LetsEncryptEnvironment); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lets_encrypt_environment: %s", err))
+	}
+	// The account key is write-only; keep the configured value when it is not returned
+	if configuration.LetsEncryptPrivateKey != nil {
+		if err = d.Set("lets_encrypt_private_key", configuration.LetsEncryptPrivateKey); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting lets_encrypt_private_key: %s", err))
+		}
+	}
+	if err = d.Set("lets_encrypt_preferred_chain", configuration.LetsEncryptPreferredChain); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting lets_encrypt_preferred_chain: %s", err))
+	}
+	if err = d.Set("config_type", configuration.ConfigType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting config_type: %s", err))
+	}
+	if err = d.Set("secret_type", configuration.SecretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
+	}
+	if err = d.Set("created_by", configuration.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("creation_date", flex.DateTimeToString(configuration.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting creation_date: %s", err))
+	}
+	if err = d.Set("last_update_date", flex.DateTimeToString(configuration.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting last_update_date: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmPublicCertificateConfigurationCALetsEncryptUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("lets_encrypt_environment", "lets_encrypt_private_key", "lets_encrypt_preferred_chain") {
+		patchVals := &secretsmanagerv2.PublicCertificateConfigurationCALetsEncryptPatch{}
+		if d.HasChange("lets_encrypt_environment") {
+			patchVals.LetsEncryptEnvironment = core.StringPtr(d.Get("lets_encrypt_environment").(string))
+		}
+		if d.HasChange("lets_encrypt_private_key") {
+			patchVals.LetsEncryptPrivateKey = core.StringPtr(d.Get("lets_encrypt_private_key").(string))
+		}
+		if d.HasChange("lets_encrypt_preferred_chain") {
+			patchVals.LetsEncryptPreferredChain = core.StringPtr(d.Get("lets_encrypt_preferred_chain").(string))
+		}
+		if err = updateSmConfiguration(context, d.Id(), patchVals, secretsManagerClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSmPublicCertificateConfigurationCALetsEncryptRead(context, d, meta)
+}
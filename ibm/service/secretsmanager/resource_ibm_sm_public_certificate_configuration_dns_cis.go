@@ -0,0 +1,183 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmPublicCertificateConfigurationDNSCIS() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmPublicCertificateConfigurationDNSCISCreate,
+		ReadContext:   resourceIbmSmPublicCertificateConfigurationDNSCISRead,
+		UpdateContext: resourceIbmSmPublicCertificateConfigurationDNSCISUpdate,
+		DeleteContext: resourceIbmSmConfigurationDelete,
+		Importer:      &schema.ResourceImporter{},
+
+		Schema: map[string]*schema.Schema{
+			"name": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "A human-readable unique name to assign to your configuration.",
+			},
+			"cloud_internet_services_crn": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "A CRN that uniquely identifies an IBM Cloud resource.",
+			},
+			"cloud_internet_services_apikey": &schema.Schema{
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "An IBM Cloud API key that can to list domains in your Cloud Internet Services instance.To grant Secrets Manager the ability to view the Cloud Internet Services instance and all of its domains, the API key must be assigned the Reader service role on Internet Services (`internet-svcs`).If you need to manage specific domains, you can assign the Manager role. For production environments, it is recommended that you assign the Reader access role, and then use the[IAM Policy Management API](https://cloud.ibm.com/apidocs/iam-policy-management#create-policy) to control specific domains. If no API key is provided, the service uses service-to-service authorization.",
+			},
+			"config_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The configuration type.",
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The secret type.",
+			},
+			"created_by": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The unique identifier for the entity that created the configuration.",
+			},
+			"creation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was created. The date format follows RFC 3339.",
+			},
+			"last_update_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date a resource was recently modified. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmPublicCertificateConfigurationDNSCISCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	configurationPrototypeModel := &secretsmanagerv2.PublicCertificateConfigurationDNSCloudInternetServicesPrototype{}
+	configurationPrototypeModel.ConfigType = core.StringPtr(PublicCertConfigTypeDNSCIS)
+	configurationPrototypeModel.Name = core.StringPtr(d.Get("name").(string))
+	configurationPrototypeModel.CloudInternetServicesCrn = core.StringPtr(d.Get("cloud_internet_services_crn").(string))
+	if v, ok := d.GetOk("cloud_internet_services_apikey"); ok {
+		configurationPrototypeModel.CloudInternetServicesApikey = core.StringPtr(v.(string))
+	}
+
+	createConfigurationOptions := &secretsmanagerv2.CreateConfigurationOptions{}
+	createConfigurationOptions.SetConfigurationPrototype(configurationPrototypeModel)
+
+	configurationIntf, response, err := secretsManagerClient.CreateConfigurationWithContext(context, createConfigurationOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	configuration, ok := configurationIntf.(*secretsmanagerv2.PublicCertificateConfigurationDNSCloudInternetServices)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.ConfigurationIntf subtype encountered"))
+	}
+	d.SetId(*configuration.Name)
+
+	return resourceIbmSmPublicCertificateConfigurationDNSCISRead(context, d, meta)
+}
+
+func resourceIbmSmPublicCertificateConfigurationDNSCISRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+	getConfigurationOptions.SetName(d.Id())
+
+	configurationIntf, response, err := secretsManagerClient.GetConfigurationWithContext(context, getConfigurationOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	configuration, ok := configurationIntf.(*secretsmanagerv2.PublicCertificateConfigurationDNSCloudInternetServices)
+	if !ok {
+		return diag.FromErr(fmt.Errorf("Unrecognized secretsmanagerv2.ConfigurationIntf subtype encountered"))
+	}
+
+	if err = d.Set("name", configuration.Name); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting name: %s", err))
+	}
+	if err = d.Set("cloud_internet_services_crn", configuration.CloudInternetServicesCrn); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting cloud_internet_services_crn: %s", err))
+	}
+	// The API key is omitted from responses when service-to-service authorization is used
+	if configuration.CloudInternetServicesApikey != nil {
+		if err = d.Set("cloud_internet_services_apikey", configuration.CloudInternetServicesApikey); err != nil {
+			return diag.FromErr(fmt.Errorf("Error setting cloud_internet_services_apikey: %s", err))
+		}
+	}
+	if err = d.Set("config_type", configuration.ConfigType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting config_type: %s", err))
+	}
+	if err = d.Set("secret_type", configuration.SecretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
+	}
+	if err = d.Set("created_by", configuration.CreatedBy); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
+	}
+	if err = d.Set("creation_date", flex.DateTimeToString(configuration.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting creation_date: %s", err))
+	}
+	if err = d.Set("last_update_date", flex.DateTimeToString(configuration.UpdatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting last_update_date: %s", err))
+	}
+
+	return nil
+}
+
+func resourceIbmSmPublicCertificateConfigurationDNSCISUpdate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("cloud_internet_services_crn", "cloud_internet_services_apikey") {
+		patchVals := &secretsmanagerv2.PublicCertificateConfigurationDNSCloudInternetServicesPatch{}
+		if d.HasChange("cloud_internet_services_crn") {
+			patchVals.CloudInternetServicesCrn = core.StringPtr(d.Get("cloud_internet_services_crn").(string))
+		}
+		if d.HasChange("cloud_internet_services_apikey") {
+			patchVals.CloudInternetServicesApikey = core.StringPtr(d.Get("cloud_internet_services_apikey").(string))
+		}
+		if err = updateSmConfiguration(context, d.Id(), patchVals, secretsManagerClient); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceIbmSmPublicCertificateConfigurationDNSCISRead(context, d, meta)
+}
@@ -0,0 +1,103 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmPublicCertificateConfigurationDNSCISBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmPublicCertificateConfigurationDNSCISDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmPublicCertificateConfigurationDNSCISConfig("tf-test-cis"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmPublicCertificateConfigurationDNSCISExists("ibm_sm_public_certificate_configuration_dns_cis.sm_public_certificate_configuration_dns_cis"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:            "ibm_sm_public_certificate_configuration_dns_cis.sm_public_certificate_configuration_dns_cis",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"cloud_internet_services_apikey"},
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmPublicCertificateConfigurationDNSCISConfig(name string) string {
+	return fmt.Sprintf(`
+		resource "ibm_sm_public_certificate_configuration_dns_cis" "sm_public_certificate_configuration_dns_cis" {
+			name = "%s"
+			cloud_internet_services_crn = "%s"
+		}
+	`, name, acc.SecretsManagerPublicCertificateCisCrn)
+}
+
+func testAccCheckIbmSmPublicCertificateConfigurationDNSCISExists(n string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+		getConfigurationOptions.SetName(rs.Primary.ID)
+
+		configurationIntf, _, err := secretsManagerClient.GetConfiguration(getConfigurationOptions)
+		if err != nil {
+			return err
+		}
+
+		if _, ok := configurationIntf.(*secretsmanagerv2.PublicCertificateConfigurationDNSCloudInternetServices); !ok {
+			return fmt.Errorf("Unexpected configuration type for %s", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccCheckIbmSmPublicCertificateConfigurationDNSCISDestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_public_certificate_configuration_dns_cis" {
+			continue
+		}
+
+		getConfigurationOptions := &secretsmanagerv2.GetConfigurationOptions{}
+
+		getConfigurationOptions.SetName(rs.Primary.ID)
+
+		// Try to find the key
+		_, response, err := secretsManagerClient.GetConfiguration(getConfigurationOptions)
+
+		if err == nil {
+			return fmt.Errorf("sm_public_certificate_configuration_dns_cis still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for sm_public_certificate_configuration_dns_cis (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -11,10 +11,10 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
-	"github.com/IBM-Cloud/secrets-manager-mt-go-sdk/secretsmanagerv2"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
 func ResourceIbmSmSecret() *schema.Resource {
@@ -329,7 +329,7 @@ func resourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, me
 
 	if _, ok := secretIntf.(*secretsmanagerv2.ImportedCertificate); ok {
 		secret := secretIntf.(*secretsmanagerv2.ImportedCertificate)
-		if err = d.Set("type", secret.Type); err != nil {
+		if err = d.Set("type", secret.SecretType); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
 		}
 		if err = d.Set("name", secret.Name); err != nil {
@@ -349,13 +349,17 @@ func resourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, me
 		if err = d.Set("created_by", secret.CreatedBy); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
 		}
-		if err = d.Set("creation_date", flex.DateTimeToString(secret.CreationDate)); err != nil {
+		if err = d.Set("creation_date", flex.DateTimeToString(secret.CreatedAt)); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting creation_date: %s", err))
 		}
-		if err = d.Set("last_update_date", flex.DateTimeToString(secret.LastUpdateDate)); err != nil {
+		if err = d.Set("last_update_date", flex.DateTimeToString(secret.UpdatedAt)); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting last_update_date: %s", err))
 		}
-		if err = d.Set("version_id", secret.VersionID); err != nil {
+		versionID, err := getSmSecretCurrentVersionID(context, d.Id(), secretsManagerClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("version_id", versionID); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
 		}
 		if err = d.Set("versions_total", flex.IntValue(secret.VersionsTotal)); err != nil {
@@ -376,7 +380,7 @@ func resourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, me
 		if err = d.Set("serial_number", secret.SerialNumber); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting serial_number: %s", err))
 		}
-		if err = d.Set("algorithm", secret.Algorithm); err != nil {
+		if err = d.Set("algorithm", secret.SigningAlgorithm); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting algorithm: %s", err))
 		}
 		if err = d.Set("key_algorithm", secret.KeyAlgorithm); err != nil {
@@ -394,18 +398,15 @@ func resourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, me
 				return diag.FromErr(fmt.Errorf("Error setting validity: %s", err))
 			}
 		}
-		if secret.SecretData != nil {
-			secretDataMap, err := resourceIbmSmSecretSecretDataToMap(secret.SecretData)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		if secret.Certificate != nil {
+			secretDataMap := resourceIbmSmSecretCertificateDataToMap(secret.Certificate, secret.Intermediate, secret.PrivateKey)
 			if err = d.Set("secret_data", []map[string]interface{}{secretDataMap}); err != nil {
 				return diag.FromErr(fmt.Errorf("Error setting secret_data: %s", err))
 			}
 		}
 	} else if _, ok := secretIntf.(*secretsmanagerv2.PublicCertificate); ok {
 		secret := secretIntf.(*secretsmanagerv2.PublicCertificate)
-		if err = d.Set("type", secret.Type); err != nil {
+		if err = d.Set("type", secret.SecretType); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
 		}
 		if err = d.Set("name", secret.Name); err != nil {
@@ -425,13 +426,17 @@ func resourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, me
 		if err = d.Set("created_by", secret.CreatedBy); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
 		}
-		if err = d.Set("creation_date", flex.DateTimeToString(secret.CreationDate)); err != nil {
+		if err = d.Set("creation_date", flex.DateTimeToString(secret.CreatedAt)); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting creation_date: %s", err))
 		}
-		if err = d.Set("last_update_date", flex.DateTimeToString(secret.LastUpdateDate)); err != nil {
+		if err = d.Set("last_update_date", flex.DateTimeToString(secret.UpdatedAt)); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting last_update_date: %s", err))
 		}
-		if err = d.Set("version_id", secret.VersionID); err != nil {
+		versionID, err := getSmSecretCurrentVersionID(context, d.Id(), secretsManagerClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("version_id", versionID); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
 		}
 		if err = d.Set("versions_total", flex.IntValue(secret.VersionsTotal)); err != nil {
@@ -446,7 +451,7 @@ func resourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, me
 		if err = d.Set("serial_number", secret.SerialNumber); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting serial_number: %s", err))
 		}
-		if err = d.Set("algorithm", secret.Algorithm); err != nil {
+		if err = d.Set("algorithm", secret.SigningAlgorithm); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting algorithm: %s", err))
 		}
 		if err = d.Set("key_algorithm", secret.KeyAlgorithm); err != nil {
@@ -464,11 +469,8 @@ func resourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, me
 				return diag.FromErr(fmt.Errorf("Error setting validity: %s", err))
 			}
 		}
-		if secret.SecretData != nil {
-			secretDataMap, err := resourceIbmSmSecretSecretDataToMap(secret.SecretData)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		if secret.Certificate != nil {
+			secretDataMap := resourceIbmSmSecretCertificateDataToMap(secret.Certificate, secret.Intermediate, secret.PrivateKey)
 			if err = d.Set("secret_data", []map[string]interface{}{secretDataMap}); err != nil {
 				return diag.FromErr(fmt.Errorf("Error setting secret_data: %s", err))
 			}
@@ -488,7 +490,7 @@ func resourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, me
 	} else if _, ok := secretIntf.(*secretsmanagerv2.Secret); ok {
 		secret := secretIntf.(*secretsmanagerv2.Secret)
 		// TODO: handle argument of type SecretPrototype
-		if err = d.Set("type", secret.Type); err != nil {
+		if err = d.Set("type", secret.SecretType); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting type: %s", err))
 		}
 		if err = d.Set("name", secret.Name); err != nil {
@@ -508,13 +510,17 @@ func resourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, me
 		if err = d.Set("created_by", secret.CreatedBy); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting created_by: %s", err))
 		}
-		if err = d.Set("creation_date", flex.DateTimeToString(secret.CreationDate)); err != nil {
+		if err = d.Set("creation_date", flex.DateTimeToString(secret.CreatedAt)); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting creation_date: %s", err))
 		}
-		if err = d.Set("last_update_date", flex.DateTimeToString(secret.LastUpdateDate)); err != nil {
+		if err = d.Set("last_update_date", flex.DateTimeToString(secret.UpdatedAt)); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting last_update_date: %s", err))
 		}
-		if err = d.Set("version_id", secret.VersionID); err != nil {
+		versionID, err := getSmSecretCurrentVersionID(context, d.Id(), secretsManagerClient)
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		if err = d.Set("version_id", versionID); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
 		}
 		if err = d.Set("versions_total", flex.IntValue(secret.VersionsTotal)); err != nil {
@@ -535,7 +541,7 @@ func resourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, me
 		if err = d.Set("serial_number", secret.SerialNumber); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting serial_number: %s", err))
 		}
-		if err = d.Set("algorithm", secret.Algorithm); err != nil {
+		if err = d.Set("algorithm", secret.SigningAlgorithm); err != nil {
 			return diag.FromErr(fmt.Errorf("Error setting algorithm: %s", err))
 		}
 		if err = d.Set("key_algorithm", secret.KeyAlgorithm); err != nil {
@@ -553,11 +559,8 @@ func resourceIbmSmSecretRead(context context.Context, d *schema.ResourceData, me
 				return diag.FromErr(fmt.Errorf("Error setting validity: %s", err))
 			}
 		}
-		if secret.SecretData != nil {
-			secretDataMap, err := resourceIbmSmSecretSecretDataToMap(secret.SecretData)
-			if err != nil {
-				return diag.FromErr(err)
-			}
+		if secret.Certificate != nil {
+			secretDataMap := resourceIbmSmSecretCertificateDataToMap(secret.Certificate, secret.Intermediate, secret.PrivateKey)
 			if err = d.Set("secret_data", []map[string]interface{}{secretDataMap}); err != nil {
 				return diag.FromErr(fmt.Errorf("Error setting secret_data: %s", err))
 			}
@@ -630,7 +633,7 @@ func resourceIbmSmSecretMapToPublicCertificateRotationPolicy(modelMap map[string
 
 func resourceIbmSmSecretMapToPublicCertificatePrototype(modelMap map[string]interface{}) (*secretsmanagerv2.PublicCertificatePrototype, error) {
 	model := &secretsmanagerv2.PublicCertificatePrototype{}
-	model.Type = core.StringPtr(modelMap["type"].(string))
+	model.SecretType = core.StringPtr(modelMap["type"].(string))
 	model.Name = core.StringPtr(modelMap["name"].(string))
 	if modelMap["description"] != nil && modelMap["description"].(string) != "" {
 		model.Description = core.StringPtr(modelMap["description"].(string))
@@ -660,7 +663,7 @@ func resourceIbmSmSecretMapToPublicCertificatePrototype(modelMap map[string]inte
 
 func resourceIbmSmSecretMapToImportedCertificatePrototype(modelMap map[string]interface{}) (*secretsmanagerv2.ImportedCertificatePrototype, error) {
 	model := &secretsmanagerv2.ImportedCertificatePrototype{}
-	model.Type = core.StringPtr(modelMap["type"].(string))
+	model.SecretType = core.StringPtr(modelMap["type"].(string))
 	model.Name = core.StringPtr(modelMap["name"].(string))
 	if modelMap["description"] != nil && modelMap["description"].(string) != "" {
 		model.Description = core.StringPtr(modelMap["description"].(string))
@@ -693,8 +696,8 @@ func resourceIbmSmSecretSecretPrototypeToMap(model secretsmanagerv2.SecretProtot
 	} else if _, ok := model.(*secretsmanagerv2.SecretPrototype); ok {
 		modelMap := make(map[string]interface{})
 		model := model.(*secretsmanagerv2.SecretPrototype)
-		if model.Type != nil {
-			modelMap["type"] = model.Type
+		if model.SecretType != nil {
+			modelMap["type"] = model.SecretType
 		}
 		if model.Name != nil {
 			modelMap["name"] = model.Name
@@ -733,20 +736,28 @@ func resourceIbmSmSecretSecretPrototypeToMap(model secretsmanagerv2.SecretProtot
 	}
 }
 
-func resourceIbmSmSecretPublicCertificateRotationPolicyToMap(model *secretsmanagerv2.PublicCertificateRotationPolicy) (map[string]interface{}, error) {
+func resourceIbmSmSecretPublicCertificateRotationPolicyToMap(model secretsmanagerv2.RotationPolicyIntf) (map[string]interface{}, error) {
+	var autoRotate, rotateKeys *bool
+	if rotation, ok := model.(*secretsmanagerv2.RotationPolicy); ok {
+		autoRotate, rotateKeys = rotation.AutoRotate, rotation.RotateKeys
+	} else if rotation, ok := model.(*secretsmanagerv2.PublicCertificateRotationPolicy); ok {
+		autoRotate, rotateKeys = rotation.AutoRotate, rotation.RotateKeys
+	} else {
+		return nil, fmt.Errorf("Unrecognized secretsmanagerv2.RotationPolicyIntf subtype encountered")
+	}
 	modelMap := make(map[string]interface{})
-	if model.AutoRotate != nil {
-		modelMap["auto_rotate"] = model.AutoRotate
+	if autoRotate != nil {
+		modelMap["auto_rotate"] = autoRotate
 	}
-	if model.RotateKeys != nil {
-		modelMap["rotate_keys"] = model.RotateKeys
+	if rotateKeys != nil {
+		modelMap["rotate_keys"] = rotateKeys
 	}
 	return modelMap, nil
 }
 
 func resourceIbmSmSecretPublicCertificatePrototypeToMap(model *secretsmanagerv2.PublicCertificatePrototype) (map[string]interface{}, error) {
 	modelMap := make(map[string]interface{})
-	modelMap["type"] = model.Type
+	modelMap["type"] = model.SecretType
 	modelMap["name"] = model.Name
 	if model.Description != nil {
 		modelMap["description"] = model.Description
@@ -772,7 +783,7 @@ func resourceIbmSmSecretPublicCertificatePrototypeToMap(model *secretsmanagerv2.
 
 func resourceIbmSmSecretImportedCertificatePrototypeToMap(model *secretsmanagerv2.ImportedCertificatePrototype) (map[string]interface{}, error) {
 	modelMap := make(map[string]interface{})
-	modelMap["type"] = model.Type
+	modelMap["type"] = model.SecretType
 	modelMap["name"] = model.Name
 	if model.Description != nil {
 		modelMap["description"] = model.Description
@@ -800,45 +811,14 @@ func resourceIbmSmSecretCertificateValidityToMap(model *secretsmanagerv2.Certifi
 	return modelMap, nil
 }
 
-func resourceIbmSmSecretSecretDataToMap(model secretsmanagerv2.SecretDataIntf) (map[string]interface{}, error) {
-	if _, ok := model.(*secretsmanagerv2.ImportedCertificatePayload); ok {
-		return resourceIbmSmSecretImportedCertificatePayloadToMap(model.(*secretsmanagerv2.ImportedCertificatePayload))
-	} else if _, ok := model.(*secretsmanagerv2.PublicCertificatePayload); ok {
-		return resourceIbmSmSecretPublicCertificatePayloadToMap(model.(*secretsmanagerv2.PublicCertificatePayload))
-	} else if _, ok := model.(*secretsmanagerv2.SecretData); ok {
-		modelMap := make(map[string]interface{})
-		model := model.(*secretsmanagerv2.SecretData)
-		if model.Certificate != nil {
-			modelMap["certificate"] = model.Certificate
-		}
-		if model.Intermediate != nil {
-			modelMap["intermediate"] = model.Intermediate
-		}
-		if model.PrivateKey != nil {
-			modelMap["private_key"] = model.PrivateKey
-		}
-		return modelMap, nil
-	} else {
-		return nil, fmt.Errorf("Unrecognized secretsmanagerv2.SecretDataIntf subtype encountered")
-	}
-}
-
-func resourceIbmSmSecretImportedCertificatePayloadToMap(model *secretsmanagerv2.ImportedCertificatePayload) (map[string]interface{}, error) {
+func resourceIbmSmSecretCertificateDataToMap(certificate, intermediate, privateKey *string) map[string]interface{} {
 	modelMap := make(map[string]interface{})
-	modelMap["certificate"] = model.Certificate
-	if model.Intermediate != nil {
-		modelMap["intermediate"] = model.Intermediate
+	modelMap["certificate"] = certificate
+	if intermediate != nil {
+		modelMap["intermediate"] = intermediate
 	}
-	if model.PrivateKey != nil {
-		modelMap["private_key"] = model.PrivateKey
+	if privateKey != nil {
+		modelMap["private_key"] = privateKey
 	}
-	return modelMap, nil
-}
-
-func resourceIbmSmSecretPublicCertificatePayloadToMap(model *secretsmanagerv2.PublicCertificatePayload) (map[string]interface{}, error) {
-	modelMap := make(map[string]interface{})
-	modelMap["certificate"] = model.Certificate
-	modelMap["intermediate"] = model.Intermediate
-	modelMap["private_key"] = model.PrivateKey
-	return modelMap, nil
+	return modelMap
 }
@@ -11,10 +11,11 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 
-	"github.com/IBM-Cloud/secrets-manager-mt-go-sdk/secretsmanagerv2"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
 	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
 )
 
 func ResourceIbmSmSecretGroup() *schema.Resource {
@@ -129,10 +130,10 @@ func resourceIbmSmSecretGroupRead(context context.Context, d *schema.ResourceDat
 	if err = d.Set("description", secretGroup.Description); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting description: %s", err))
 	}
-	if err = d.Set("creation_date", flex.DateTimeToString(secretGroup.CreationDate)); err != nil {
+	if err = d.Set("creation_date", flex.DateTimeToString(secretGroup.CreatedAt)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting creation_date: %s", err))
 	}
-	if err = d.Set("last_update_date", flex.DateTimeToString(secretGroup.LastUpdateDate)); err != nil {
+	if err = d.Set("last_update_date", flex.DateTimeToString(secretGroup.UpdatedAt)); err != nil {
 		return diag.FromErr(fmt.Errorf("Error setting last_update_date: %s", err))
 	}
 
@@ -150,17 +151,23 @@ func resourceIbmSmSecretGroupUpdate(context context.Context, d *schema.ResourceD
 	updateSecretGroupOptions.SetID(d.Id())
 
 	hasChange := false
+	patchVals := &secretsmanagerv2.SecretGroupPatch{}
 
 	if d.HasChange("name") {
-		updateSecretGroupOptions.SetName(d.Get("name").(string))
+		patchVals.Name = core.StringPtr(d.Get("name").(string))
 		hasChange = true
 	}
 	if d.HasChange("description") {
-		updateSecretGroupOptions.SetDescription(d.Get("description").(string))
+		patchVals.Description = core.StringPtr(d.Get("description").(string))
 		hasChange = true
 	}
 
 	if hasChange {
+		patch, err := patchVals.AsPatch()
+		if err != nil {
+			return diag.FromErr(err)
+		}
+		updateSecretGroupOptions.SetSecretGroupPatch(patch)
 		_, response, err := secretsManagerClient.UpdateSecretGroupWithContext(context, updateSecretGroupOptions)
 		if err != nil {
 			log.Printf("[DEBUG] UpdateSecretGroupWithContext failed %s\n%s", err, response)
@@ -0,0 +1,168 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func ResourceIbmSmSecretRotation() *schema.Resource {
+	return &schema.Resource{
+		CreateContext: resourceIbmSmSecretRotationCreate,
+		ReadContext:   resourceIbmSmSecretRotationRead,
+		DeleteContext: resourceIbmSmSecretRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"secret_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the secret to rotate.",
+			},
+			"rotate_keys": &schema.Schema{
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     false,
+				Description: "Determines whether a new private key is generated when a public certificate is rotated.",
+			},
+			"triggers": &schema.Schema{
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, rotates the secret again.",
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+			"secret_type": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The type of the rotated secret.",
+			},
+			"version_id": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the secret version that the rotation created.",
+			},
+			"rotation_date": &schema.Schema{
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The date the secret was rotated. The date format follows RFC 3339.",
+			},
+		},
+	}
+}
+
+func resourceIbmSmSecretRotationCreate(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	secretID := d.Get("secret_id").(string)
+
+	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+	getSecretOptions.SetID(secretID)
+
+	secretIntf, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+	}
+
+	// Only secrets whose payload is generated by the service can be rotated without a new payload
+	var secretType string
+	var versionPrototype secretsmanagerv2.SecretVersionPrototypeIntf
+	switch secretIntf.(type) {
+	case *secretsmanagerv2.UsernamePasswordSecret:
+		secretType = UsernamePasswordSecretType
+		versionPrototype = &secretsmanagerv2.UsernamePasswordSecretVersionPrototype{}
+	case *secretsmanagerv2.IAMCredentialsSecret:
+		secretType = IAMCredentialsSecretType
+		versionPrototype = &secretsmanagerv2.IAMCredentialsSecretVersionPrototype{}
+	case *secretsmanagerv2.PrivateCertificate:
+		secretType = PrivateCertSecretType
+		versionPrototype = &secretsmanagerv2.PrivateCertificateVersionPrototype{}
+	case *secretsmanagerv2.PublicCertificate:
+		secretType = PublicCertSecretType
+		versionPrototype = &secretsmanagerv2.PublicCertificateVersionPrototype{
+			Rotation: &secretsmanagerv2.PublicCertificateRotationObject{
+				RotateKeys: core.BoolPtr(d.Get("rotate_keys").(bool)),
+			},
+		}
+	default:
+		return diag.FromErr(fmt.Errorf("Secret %s cannot be rotated by the service, update its payload to create a new version instead", secretID))
+	}
+
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(secretID)
+	createSecretVersionOptions.SetSecretVersionPrototype(versionPrototype)
+
+	secretVersionIntf, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretVersionWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("CreateSecretVersionWithContext failed %s\n%s", err, response))
+	}
+
+	// The version exists from here on, so it has to end up in state even if its details cannot be read
+	secretVersion, err := smSecretVersion(secretVersionIntf)
+	if err != nil || secretVersion.ID == nil {
+		versionID, lookupErr := getSmSecretCurrentVersionID(context, secretID, secretsManagerClient)
+		if lookupErr != nil {
+			return diag.FromErr(fmt.Errorf("Secret %s was rotated but the new version could not be read: %s", secretID, lookupErr))
+		}
+		secretVersion = &secretsmanagerv2.SecretVersion{ID: core.StringPtr(versionID)}
+	}
+	d.SetId(fmt.Sprintf("%s/%s", secretID, *secretVersion.ID))
+
+	if err = d.Set("secret_type", secretType); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting secret_type: %s", err))
+	}
+	if err = d.Set("version_id", secretVersion.ID); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting version_id: %s", err))
+	}
+	if err = d.Set("rotation_date", flex.DateTimeToString(secretVersion.CreatedAt)); err != nil {
+		return diag.FromErr(fmt.Errorf("Error setting rotation_date: %s", err))
+	}
+
+	return resourceIbmSmSecretRotationRead(context, d, meta)
+}
+
+func resourceIbmSmSecretRotationRead(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+	getSecretOptions.SetID(d.Get("secret_id").(string))
+
+	_, response, err := secretsManagerClient.GetSecretWithContext(context, getSecretOptions)
+	if err != nil {
+		if response != nil && response.StatusCode == 404 {
+			d.SetId("")
+			return nil
+		}
+		log.Printf("[DEBUG] GetSecretWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("GetSecretWithContext failed %s\n%s", err, response))
+	}
+
+	return nil
+}
+
+// A rotation cannot be undone; removing the resource only drops it from state
+func resourceIbmSmSecretRotationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	d.SetId("")
+	return nil
+}
@@ -0,0 +1,59 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+)
+
+func TestAccIbmSmSecretRotationBasic(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { acc.TestAccPreCheck(t) },
+		Providers: acc.TestAccProviders,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmSecretRotationConfigBasic("1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_secret_rotation.sm_secret_rotation", "version_id"),
+					resource.TestCheckResourceAttr("ibm_sm_secret_rotation.sm_secret_rotation", "secret_type", "username_password"),
+				),
+			},
+			resource.TestStep{
+				// Changing a trigger rotates the secret again
+				Config: testAccCheckIbmSmSecretRotationConfigBasic("2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("ibm_sm_secret_rotation.sm_secret_rotation", "version_id"),
+					resource.TestCheckResourceAttr("data.ibm_sm_secret_versions.sm_secret_versions", "total_count", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmSecretRotationConfigBasic(trigger string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret" {
+			name = "my-rotated-secret"
+			username = "my-user"
+			password = "my-password"
+		}
+
+		resource "ibm_sm_secret_rotation" "sm_secret_rotation" {
+			secret_id = ibm_sm_username_password_secret.sm_username_password_secret.id
+			triggers = {
+				rotation = "%s"
+			}
+		}
+
+		data "ibm_sm_secret_versions" "sm_secret_versions" {
+			secret_id = ibm_sm_secret_rotation.sm_secret_rotation.secret_id
+		}
+	`, trigger)
+}
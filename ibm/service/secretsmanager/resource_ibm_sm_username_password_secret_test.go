@@ -0,0 +1,124 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
+
+	acc "github.com/IBM-Cloud/terraform-provider-ibm/ibm/acctest"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+func TestAccIbmSmUsernamePasswordSecretBasic(t *testing.T) {
+	var secretID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { acc.TestAccPreCheck(t) },
+		Providers:    acc.TestAccProviders,
+		CheckDestroy: testAccCheckIbmSmUsernamePasswordSecretDestroy,
+		Steps: []resource.TestStep{
+			resource.TestStep{
+				Config: testAccCheckIbmSmUsernamePasswordSecretConfig("my-secret", "password-1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckIbmSmUsernamePasswordSecretExists("ibm_sm_username_password_secret.sm_username_password_secret", &secretID),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.sm_username_password_secret", "name", "my-secret"),
+				),
+			},
+			resource.TestStep{
+				// Metadata and password changes are applied in place, so the secret ID is preserved
+				Config: testAccCheckIbmSmUsernamePasswordSecretConfig("my-secret-updated", "password-2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPtr("ibm_sm_username_password_secret.sm_username_password_secret", "id", &secretID),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.sm_username_password_secret", "name", "my-secret-updated"),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.sm_username_password_secret", "versions_total", "2"),
+					resource.TestCheckResourceAttr("ibm_sm_username_password_secret.sm_username_password_secret", "rotation.0.interval", "30"),
+					resource.TestCheckResourceAttrSet("ibm_sm_username_password_secret.sm_username_password_secret", "next_rotation_date"),
+				),
+			},
+			resource.TestStep{
+				ResourceName:      "ibm_sm_username_password_secret.sm_username_password_secret",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckIbmSmUsernamePasswordSecretConfig(name string, password string) string {
+	return fmt.Sprintf(`
+
+		resource "ibm_sm_username_password_secret" "sm_username_password_secret" {
+			name = "%s"
+			description = "Extended description for this secret."
+			labels = [ "my-label" ]
+			username = "my-user"
+			password = "%s"
+			rotation {
+				auto_rotate = true
+				interval = 30
+				unit = "day"
+			}
+		}
+	`, name, password)
+}
+
+func testAccCheckIbmSmUsernamePasswordSecretExists(n string, secretID *string) resource.TestCheckFunc {
+
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+		if err != nil {
+			return err
+		}
+
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+		getSecretOptions.SetID(rs.Primary.ID)
+
+		secretIntf, _, err := secretsManagerClient.GetSecret(getSecretOptions)
+		if err != nil {
+			return err
+		}
+
+		secret := secretIntf.(*secretsmanagerv2.UsernamePasswordSecret)
+		*secretID = *secret.ID
+		return nil
+	}
+}
+
+func testAccCheckIbmSmUsernamePasswordSecretDestroy(s *terraform.State) error {
+	secretsManagerClient, err := acc.TestAccProvider.Meta().(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return err
+	}
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "ibm_sm_username_password_secret" {
+			continue
+		}
+
+		getSecretOptions := &secretsmanagerv2.GetSecretOptions{}
+
+		getSecretOptions.SetID(rs.Primary.ID)
+
+		// Try to find the key
+		_, response, err := secretsManagerClient.GetSecret(getSecretOptions)
+
+		if err == nil {
+			return fmt.Errorf("sm_username_password_secret still exists: %s", rs.Primary.ID)
+		} else if response.StatusCode != 404 {
+			return fmt.Errorf("Error checking for sm_username_password_secret (%s) has been destroyed: %s", rs.Primary.ID, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,344 @@
+// Copyright IBM Corp. 2022 All Rights Reserved.
+// Licensed under the Mozilla Public License v2.0
+
+package secretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/conns"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/flex"
+	"github.com/IBM-Cloud/terraform-provider-ibm/ibm/validate"
+	"github.com/IBM/go-sdk-core/v5/core"
+	"github.com/IBM/secrets-manager-go-sdk/v2/secretsmanagerv2"
+)
+
+const (
+	ArbitrarySecretType        = "arbitrary"
+	UsernamePasswordSecretType = "username_password"
+	KvSecretType               = "kv"
+	IAMCredentialsSecretType   = "iam_credentials"
+	PrivateCertSecretType      = "private_cert"
+	PublicCertSecretType       = "public_cert"
+)
+
+const (
+	PrivateCertConfigTypeRootCA         = "private_cert_configuration_root_ca"
+	PrivateCertConfigTypeIntermediateCA = "private_cert_configuration_intermediate_ca"
+	PrivateCertConfigTypeTemplate       = "private_cert_configuration_template"
+	PublicCertConfigTypeCALetsEncrypt   = "public_cert_configuration_ca_lets_encrypt"
+	PublicCertConfigTypeDNSCIS          = "public_cert_configuration_dns_cloud_internet_services"
+	PublicCertConfigTypeDNSClassicInfra = "public_cert_configuration_dns_classic_infrastructure"
+	PrivateCertActionSignIntermediate   = "private_cert_action_sign_intermediate"
+	PrivateCertSigningMethodInternal    = "internal"
+	PrivateCertSigningMethodExternal    = "external"
+)
+
+// The CA settings that can be changed without recreating the certificate authority
+var smCAConfigurationMutableFields = []string{"max_ttl", "crl_expiry", "crl_disable", "crl_distribution_points_encoded", "issuing_certificates_urls_encoded"}
+
+// The metadata of a secret that can be changed without creating a new secret or secret version
+var smSecretMetadataFields = []string{"name", "description", "labels", "expiration_date"}
+
+// ExpandSmSecretMetadataPatch builds the metadata patch from the changed name, description, labels, expiration date, TTL and rotation policy
+func ExpandSmSecretMetadataPatch(d *schema.ResourceData) (map[string]interface{}, error) {
+	patchVals := &secretsmanagerv2.SecretMetadataPatch{}
+	if d.HasChange("name") {
+		patchVals.Name = core.StringPtr(d.Get("name").(string))
+	}
+	if d.HasChange("description") {
+		patchVals.Description = core.StringPtr(d.Get("description").(string))
+	}
+	if d.HasChange("labels") {
+		labels := []string{}
+		for _, label := range d.Get("labels").([]interface{}) {
+			labels = append(labels, label.(string))
+		}
+		patchVals.Labels = labels
+	}
+	if d.HasChange("expiration_date") {
+		expirationDate, err := expandSmSecretExpirationDate(d.Get("expiration_date").(string))
+		if err != nil {
+			return nil, err
+		}
+		patchVals.ExpirationDate = expirationDate
+	}
+	if d.HasChange("ttl") {
+		patchVals.TTL = core.StringPtr(d.Get("ttl").(string))
+	}
+	if d.HasChange("rotation") {
+		rotation, err := expandSmRotationPolicy(d)
+		if err != nil {
+			return nil, err
+		}
+		patchVals.Rotation = rotation
+	}
+	patch, err := patchVals.AsPatch()
+	if err != nil {
+		return nil, err
+	}
+	// Removed labels and expiration dates have to be sent explicitly, the patch model omits empty values
+	if d.HasChange("labels") && len(patchVals.Labels) == 0 {
+		patch["labels"] = []string{}
+	}
+	if d.HasChange("expiration_date") && patchVals.ExpirationDate == nil {
+		patch["expiration_date"] = nil
+	}
+	return patch, nil
+}
+
+func expandSmSecretExpirationDate(value string) (*strfmt.DateTime, error) {
+	if value == "" {
+		return nil, nil
+	}
+	expirationDate, err := strfmt.ParseDateTime(value)
+	if err != nil {
+		return nil, fmt.Errorf("Error parsing expiration_date %s: %s", value, err)
+	}
+	return &expirationDate, nil
+}
+
+func updateSmSecretMetadata(context context.Context, d *schema.ResourceData, secretsManagerClient *secretsmanagerv2.SecretsManagerV2) error {
+	patch, err := ExpandSmSecretMetadataPatch(d)
+	if err != nil {
+		return err
+	}
+
+	updateSecretMetadataOptions := &secretsmanagerv2.UpdateSecretMetadataOptions{}
+	updateSecretMetadataOptions.SetID(d.Id())
+	updateSecretMetadataOptions.SetSecretMetadataPatch(patch)
+
+	_, response, err := secretsManagerClient.UpdateSecretMetadataWithContext(context, updateSecretMetadataOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateSecretMetadataWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("UpdateSecretMetadataWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// createSmSecretVersion stores a new payload as a new version of the secret, keeping the secret ID
+func createSmSecretVersion(context context.Context, d *schema.ResourceData, secretsManagerClient *secretsmanagerv2.SecretsManagerV2, versionPrototype secretsmanagerv2.SecretVersionPrototypeIntf) error {
+	createSecretVersionOptions := &secretsmanagerv2.CreateSecretVersionOptions{}
+	createSecretVersionOptions.SetSecretID(d.Id())
+	createSecretVersionOptions.SetSecretVersionPrototype(versionPrototype)
+
+	_, response, err := secretsManagerClient.CreateSecretVersionWithContext(context, createSecretVersionOptions)
+	if err != nil {
+		log.Printf("[DEBUG] CreateSecretVersionWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("CreateSecretVersionWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+// getSmSecretCurrentVersionID looks up the current version of a secret, which the secret itself does not report
+func getSmSecretCurrentVersionID(context context.Context, id string, secretsManagerClient *secretsmanagerv2.SecretsManagerV2) (string, error) {
+	getSecretVersionMetadataOptions := &secretsmanagerv2.GetSecretVersionMetadataOptions{}
+	getSecretVersionMetadataOptions.SetSecretID(id)
+	getSecretVersionMetadataOptions.SetID("current")
+
+	versionIntf, response, err := secretsManagerClient.GetSecretVersionMetadataWithContext(context, getSecretVersionMetadataOptions)
+	if err != nil {
+		log.Printf("[DEBUG] GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+		return "", fmt.Errorf("GetSecretVersionMetadataWithContext failed %s\n%s", err, response)
+	}
+	version, err := SmSecretVersionMetadata(versionIntf)
+	if err != nil {
+		return "", err
+	}
+	if version.ID == nil {
+		return "", nil
+	}
+	return *version.ID, nil
+}
+
+// SmSecretVersionMetadata converts the version metadata of any secret type to the model that all secret types share
+func SmSecretVersionMetadata(model secretsmanagerv2.SecretVersionMetadataIntf) (*secretsmanagerv2.SecretVersionMetadata, error) {
+	if version, ok := model.(*secretsmanagerv2.SecretVersionMetadata); ok {
+		return version, nil
+	}
+	b, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	version := &secretsmanagerv2.SecretVersionMetadata{}
+	if err = json.Unmarshal(b, version); err != nil {
+		return nil, fmt.Errorf("Error reading secret version metadata: %s", err)
+	}
+	return version, nil
+}
+
+// smSecretVersion converts the version of any secret type to the model that all secret types share
+func smSecretVersion(model secretsmanagerv2.SecretVersionIntf) (*secretsmanagerv2.SecretVersion, error) {
+	if version, ok := model.(*secretsmanagerv2.SecretVersion); ok {
+		return version, nil
+	}
+	b, err := json.Marshal(model)
+	if err != nil {
+		return nil, err
+	}
+	version := &secretsmanagerv2.SecretVersion{}
+	if err = json.Unmarshal(b, version); err != nil {
+		return nil, fmt.Errorf("Error reading secret version: %s", err)
+	}
+	return version, nil
+}
+
+// smRotationPolicySchema is the rotation block of the secret types that the service can rotate
+func smRotationPolicySchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeList,
+		MaxItems:    1,
+		Optional:    true,
+		Computed:    true,
+		Description: "Determines whether Secrets Manager rotates your secrets automatically. Changing the policy updates the secret in place.",
+		Elem: &schema.Resource{
+			Schema: map[string]*schema.Schema{
+				"auto_rotate": &schema.Schema{
+					Type:        schema.TypeBool,
+					Optional:    true,
+					Default:     false,
+					Description: "Determines whether Secrets Manager rotates your secret automatically.Default is `false`. If `auto_rotate` is set to `true` the service rotates your secret based on the defined interval.",
+				},
+				"interval": &schema.Schema{
+					Type:        schema.TypeInt,
+					Optional:    true,
+					Computed:    true,
+					Description: "The length of the secret rotation time interval.",
+				},
+				"unit": &schema.Schema{
+					Type:         schema.TypeString,
+					Optional:     true,
+					Computed:     true,
+					ValidateFunc: validate.ValidateAllowedStringValues([]string{"day", "month"}),
+					Description:  "The units for the secret rotation time interval.",
+				},
+			},
+		},
+	}
+}
+
+func expandSmRotationPolicy(d *schema.ResourceData) (secretsmanagerv2.RotationPolicyIntf, error) {
+	rotationList := d.Get("rotation").([]interface{})
+	if len(rotationList) == 0 || rotationList[0] == nil {
+		return nil, nil
+	}
+	rotationMap := rotationList[0].(map[string]interface{})
+
+	rotation := &secretsmanagerv2.CommonRotationPolicy{
+		AutoRotate: core.BoolPtr(rotationMap["auto_rotate"].(bool)),
+	}
+	interval, unit := rotationMap["interval"].(int), rotationMap["unit"].(string)
+	if *rotation.AutoRotate && (interval == 0 || unit == "") {
+		return nil, fmt.Errorf("rotation.0.interval and rotation.0.unit must be set when rotation.0.auto_rotate is true")
+	}
+	if interval != 0 {
+		rotation.Interval = core.Int64Ptr(int64(interval))
+	}
+	if unit != "" {
+		rotation.Unit = core.StringPtr(unit)
+	}
+	return rotation, nil
+}
+
+// flattenSmRotationPolicy reads the rotation policy, which the service returns as the generic policy model
+func flattenSmRotationPolicy(model secretsmanagerv2.RotationPolicyIntf) []map[string]interface{} {
+	var autoRotate *bool
+	var interval *int64
+	var unit *string
+	if rotation, ok := model.(*secretsmanagerv2.RotationPolicy); ok && rotation != nil {
+		autoRotate, interval, unit = rotation.AutoRotate, rotation.Interval, rotation.Unit
+	} else if rotation, ok := model.(*secretsmanagerv2.CommonRotationPolicy); ok && rotation != nil {
+		autoRotate, interval, unit = rotation.AutoRotate, rotation.Interval, rotation.Unit
+	} else {
+		return []map[string]interface{}{}
+	}
+	modelMap := make(map[string]interface{})
+	if autoRotate != nil {
+		modelMap["auto_rotate"] = *autoRotate
+	}
+	if interval != nil {
+		modelMap["interval"] = flex.IntValue(interval)
+	}
+	if unit != nil {
+		modelMap["unit"] = *unit
+	}
+	return []map[string]interface{}{modelMap}
+}
+
+func expandSmSecretLabels(d *schema.ResourceData) []string {
+	labels := []string{}
+	if v, ok := d.GetOk("labels"); ok {
+		for _, label := range v.([]interface{}) {
+			labels = append(labels, label.(string))
+		}
+	}
+	return labels
+}
+
+// suppressSmExpirationDateDiff ignores differences in how the same expiration date is formatted
+func suppressSmExpirationDateDiff(k, old, new string, d *schema.ResourceData) bool {
+	if old == "" || new == "" {
+		return old == new
+	}
+	oldDate, err := strfmt.ParseDateTime(old)
+	if err != nil {
+		return false
+	}
+	newDate, err := strfmt.ParseDateTime(new)
+	if err != nil {
+		return false
+	}
+	return oldDate.Equal(newDate)
+}
+
+// smConfigurationPatch is implemented by the typed configuration patch models
+type smConfigurationPatch interface {
+	AsPatch() (map[string]interface{}, error)
+}
+
+// updateSmConfiguration applies a patch to the configuration with the given name
+func updateSmConfiguration(context context.Context, name string, patchModel smConfigurationPatch, secretsManagerClient *secretsmanagerv2.SecretsManagerV2) error {
+	patch, err := patchModel.AsPatch()
+	if err != nil {
+		return err
+	}
+
+	updateConfigurationOptions := &secretsmanagerv2.UpdateConfigurationOptions{}
+	updateConfigurationOptions.SetName(name)
+	updateConfigurationOptions.SetConfigurationPatch(patch)
+
+	_, response, err := secretsManagerClient.UpdateConfigurationWithContext(context, updateConfigurationOptions)
+	if err != nil {
+		log.Printf("[DEBUG] UpdateConfigurationWithContext failed %s\n%s", err, response)
+		return fmt.Errorf("UpdateConfigurationWithContext failed %s\n%s", err, response)
+	}
+	return nil
+}
+
+func resourceIbmSmConfigurationDelete(context context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	secretsManagerClient, err := meta.(conns.ClientSession).SecretsManagerV2()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	deleteConfigurationOptions := &secretsmanagerv2.DeleteConfigurationOptions{}
+
+	deleteConfigurationOptions.SetName(d.Id())
+
+	response, err := secretsManagerClient.DeleteConfigurationWithContext(context, deleteConfigurationOptions)
+	if err != nil {
+		log.Printf("[DEBUG] DeleteConfigurationWithContext failed %s\n%s", err, response)
+		return diag.FromErr(fmt.Errorf("DeleteConfigurationWithContext failed %s\n%s", err, response))
+	}
+
+	d.SetId("")
+
+	return nil
+}